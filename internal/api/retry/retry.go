@@ -0,0 +1,166 @@
+// Package retry provides the HTTP retry behavior shared by all clients that talk to remote flag services.
+//
+// Requests are retried with exponential backoff and jitter on network errors, 5xx responses
+// and 429 Too Many Requests. When the server sends a Retry-After header, the retry waits
+// for the requested time instead of the backoff delay.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	goretry "github.com/kriscoleman/GoRetry"
+	"github.com/open-feature/cli/internal/logger"
+)
+
+const (
+	// DefaultMaxAttempts is the number of attempts made, including the first one
+	DefaultMaxAttempts = 3
+	// DefaultTimeout is the timeout of a single HTTP request
+	DefaultTimeout = 30 * time.Second
+	// MaxRetryAfter caps the delay requested by a Retry-After header
+	MaxRetryAfter = 60 * time.Second
+
+	baseDelay = 100 * time.Millisecond
+	maxDelay  = 5 * time.Second
+)
+
+// HTTPError is an unsuccessful HTTP response
+type HTTPError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// RetryAfter is the delay requested by the Retry-After header, or 0 if not set
+	RetryAfter time.Duration
+	// Message describes the error
+	Message string
+}
+
+func (e *HTTPError) Error() string {
+	return e.Message
+}
+
+// NewHTTPError creates an HTTPError from the given response
+func NewHTTPError(resp *http.Response, message string) *HTTPError {
+	return &HTTPError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		Message:    message,
+	}
+}
+
+// IsTransient determines if an error should trigger a retry.
+// Returns true for:
+// - 5xx server errors (transient)
+// - 429 Too Many Requests (rate limited)
+// - Network errors (timeouts, temporary failures)
+// Returns false for:
+// - Other 4xx client errors (permanent)
+// - Successful responses (2xx, 3xx)
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// Check if it's an HTTP error with a status code
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests ||
+			(httpErr.StatusCode >= 500 && httpErr.StatusCode < 600)
+	}
+
+	// For non-HTTP errors, use default transient error detection
+	// This catches network errors, timeouts, etc.
+	return goretry.DefaultTransientErrorFunc(err)
+}
+
+// ParseRetryAfter parses the value of a Retry-After header, which is either a number
+// of seconds or an HTTP date. Returns 0 if the value is empty, invalid or in the past.
+// The result is capped at MaxRetryAfter.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		delay = date.Sub(now)
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+	return min(delay, MaxRetryAfter)
+}
+
+// Do calls fn until it succeeds, returns an error that is not transient,
+// or DefaultMaxAttempts is reached.
+func Do(ctx context.Context, fn func(context.Context) error) error {
+	policy := goretry.NewExponentialBackoffPolicy(baseDelay, maxDelay)
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if err == nil || !IsTransient(err) {
+			return err
+		}
+		if attempt >= DefaultMaxAttempts {
+			return fmt.Errorf("retry failed after %d attempts: %w", attempt, err)
+		}
+
+		delay, _ := policy.NextDelay(attempt)
+		var httpErr *HTTPError
+		if errors.As(err, &httpErr) && httpErr.RetryAfter > 0 {
+			delay = httpErr.RetryAfter
+		}
+		logger.Default.Debug(fmt.Sprintf("Attempt %d failed, retrying in %s: %v", attempt, delay, err))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// NewHTTPClient creates an HTTP client with the default request timeout
+func NewHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: DefaultTimeout,
+	}
+}
+
+// Get performs a GET request, retrying network errors, 5xx responses and 429 Too Many Requests.
+// Other responses, including 4xx errors, are returned to the caller, which must close the body.
+func Get(ctx context.Context, client *http.Client, url string, header http.Header) (*http.Response, error) {
+	var resp *http.Response
+	err := Do(ctx, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		for key, values := range header {
+			req.Header[key] = values
+		}
+
+		r, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		if r.StatusCode == http.StatusTooManyRequests || r.StatusCode >= 500 {
+			r.Body.Close()
+			return NewHTTPError(r, fmt.Sprintf("GET %s failed: %s", url, r.Status))
+		}
+
+		resp = r
+		return nil
+	})
+	return resp, err
+}
@@ -0,0 +1,183 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		shouldRetry bool
+	}{
+		{
+			name:        "nil error is not transient",
+			err:         nil,
+			shouldRetry: false,
+		},
+		{
+			name: "500 error is transient",
+			err: &HTTPError{
+				StatusCode: 500,
+				Message:    "Internal Server Error",
+			},
+			shouldRetry: true,
+		},
+		{
+			name: "502 error is transient",
+			err: &HTTPError{
+				StatusCode: 502,
+				Message:    "Bad Gateway",
+			},
+			shouldRetry: true,
+		},
+		{
+			name: "503 error is transient",
+			err: &HTTPError{
+				StatusCode: 503,
+				Message:    "Service Unavailable",
+			},
+			shouldRetry: true,
+		},
+		{
+			name: "504 error is transient",
+			err: &HTTPError{
+				StatusCode: 504,
+				Message:    "Gateway Timeout",
+			},
+			shouldRetry: true,
+		},
+		{
+			name: "429 error is transient",
+			err: &HTTPError{
+				StatusCode: 429,
+				Message:    "Too Many Requests",
+			},
+			shouldRetry: true,
+		},
+		{
+			name: "400 error is not transient",
+			err: &HTTPError{
+				StatusCode: 400,
+				Message:    "Bad Request",
+			},
+			shouldRetry: false,
+		},
+		{
+			name: "401 error is not transient",
+			err: &HTTPError{
+				StatusCode: 401,
+				Message:    "Unauthorized",
+			},
+			shouldRetry: false,
+		},
+		{
+			name: "404 error is not transient",
+			err: &HTTPError{
+				StatusCode: 404,
+				Message:    "Not Found",
+			},
+			shouldRetry: false,
+		},
+		{
+			name: "409 error is not transient",
+			err: &HTTPError{
+				StatusCode: 409,
+				Message:    "Conflict",
+			},
+			shouldRetry: false,
+		},
+		{
+			name: "200 success is not transient",
+			err: &HTTPError{
+				StatusCode: 200,
+				Message:    "OK",
+			},
+			shouldRetry: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsTransient(tt.err)
+			assert.Equal(t, tt.shouldRetry, result)
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 3, 2, 9, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{name: "empty", value: "", expected: 0},
+		{name: "seconds", value: "5", expected: 5 * time.Second},
+		{name: "http date", value: now.Add(10 * time.Second).Format(http.TimeFormat), expected: 10 * time.Second},
+		{name: "date in the past", value: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0},
+		{name: "capped", value: "3600", expected: MaxRetryAfter},
+		{name: "invalid", value: "soon", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ParseRetryAfter(tt.value, now))
+		})
+	}
+}
+
+func TestDo(t *testing.T) {
+	t.Run("stops on permanent errors", func(t *testing.T) {
+		attempts := 0
+		err := Do(t.Context(), func(ctx context.Context) error {
+			attempts++
+			return &HTTPError{StatusCode: 400, Message: "Bad Request"}
+		})
+		require.Error(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("exhausts attempts on transient errors", func(t *testing.T) {
+		attempts := 0
+		err := Do(t.Context(), func(ctx context.Context) error {
+			attempts++
+			return &HTTPError{StatusCode: 503, Message: "Service Unavailable"}
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Service Unavailable")
+		assert.Equal(t, DefaultMaxAttempts, attempts)
+	})
+
+	t.Run("waits for the requested Retry-After delay", func(t *testing.T) {
+		attempts := 0
+		start := time.Now()
+		err := Do(t.Context(), func(ctx context.Context) error {
+			attempts++
+			if attempts == 1 {
+				return &HTTPError{StatusCode: 429, RetryAfter: 300 * time.Millisecond, Message: "Too Many Requests"}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+		assert.GreaterOrEqual(t, time.Since(start), 300*time.Millisecond)
+	})
+
+	t.Run("stops when the context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		err := Do(ctx, func(ctx context.Context) error {
+			cancel()
+			return &HTTPError{StatusCode: 503, RetryAfter: time.Minute, Message: "Service Unavailable"}
+		})
+		assert.True(t, errors.Is(err, context.Canceled))
+	})
+}
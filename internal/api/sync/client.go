@@ -4,13 +4,12 @@ package sync
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
-	"time"
+	"sort"
 
-	goretry "github.com/kriscoleman/GoRetry"
 	syncclient "github.com/open-feature/cli/internal/api/client"
+	"github.com/open-feature/cli/internal/api/retry"
 	"github.com/open-feature/cli/internal/flagset"
 	"github.com/open-feature/cli/internal/logger"
 )
@@ -19,52 +18,28 @@ import (
 type Client struct {
 	apiClient *syncclient.ClientWithResponses
 	authToken string
+	progress  ProgressFunc
+	metrics   metrics
 }
 
-// httpError wraps an HTTP response status code for retry logic
-type httpError struct {
-	statusCode int
-	message    string
+// ProgressEvent reports that a single flag was written to the remote
+type ProgressEvent struct {
+	// Operation is the change applied to the flag: create, update or delete
+	Operation string
+	// Flag is the flag that was written
+	Flag flagset.Flag
+	// Done is the number of flags written in the current step, including this one
+	Done int
+	// Total is the number of flags to write in the current step
+	Total int
 }
 
-func (e *httpError) Error() string {
-	return e.message
-}
-
-// isTransientHTTPError determines if an error should trigger a retry.
-// Returns true for:
-// - 5xx server errors (transient)
-// - Network errors (timeouts, temporary failures)
-// Returns false for:
-// - 4xx client errors (permanent)
-// - Successful responses (2xx, 3xx)
-func isTransientHTTPError(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	// Check if it's an HTTP error with a status code
-	var httpErr *httpError
-	if errors.As(err, &httpErr) {
-		// Retry on 5xx server errors
-		if httpErr.statusCode >= 500 && httpErr.statusCode < 600 {
-			return true
-		}
-		// Don't retry on 4xx client errors or successful responses
-		return false
-	}
-
-	// For non-HTTP errors, use default transient error detection
-	// This catches network errors, timeouts, etc.
-	return goretry.DefaultTransientErrorFunc(err)
-}
+// ProgressFunc is called after each flag is written to the remote
+type ProgressFunc func(ProgressEvent)
 
 // NewClient creates a new sync client
 func NewClient(baseURL string, authToken string) (*Client, error) {
-	// Create a custom HTTP client with timeout
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
-	}
+	httpClient := retry.NewHTTPClient()
 
 	// Add authentication if provided
 	var opts []syncclient.ClientOption
@@ -95,20 +70,51 @@ func NewClient(baseURL string, authToken string) (*Client, error) {
 	}, nil
 }
 
+// OnProgress registers fn to be called after each flag is created, updated or deleted.
+// PushFlags and DeleteFlags are separate steps, each counting its own progress.
+func (c *Client) OnProgress(fn ProgressFunc) {
+	c.progress = fn
+}
+
+// reportProgress calls the registered progress function, if any
+func (c *Client) reportProgress(operation string, flag flagset.Flag, done int, total int) {
+	if c.progress != nil {
+		c.progress(ProgressEvent{Operation: operation, Flag: flag, Done: done, Total: total})
+	}
+}
+
 // PushResult contains the results of a push operation
 type PushResult struct {
 	Created   []flagset.Flag
 	Updated   []flagset.Flag
 	Unchanged []flagset.Flag
+	Deleted   []flagset.Flag
+	// Metrics summarizes the API calls made during the push, per operation
+	Metrics []OperationMetrics
 }
 
 // PullFlags fetches flags from the remote API
 func (c *Client) PullFlags(ctx context.Context) (*flagset.Flagset, error) {
 	logger.Default.Debug("Fetching flags using sync API client")
 
-	resp, err := c.apiClient.GetOpenfeatureV0ManifestWithResponse(ctx)
+	var resp *syncclient.GetOpenfeatureV0ManifestResponse
+	err := c.do(ctx, "fetch", func(ctx context.Context) error {
+		var err error
+		resp, err = c.apiClient.GetOpenfeatureV0ManifestWithResponse(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch manifest: %w", err)
+		}
+		// Surface rate limiting and server errors to the retry logic
+		if resp.HTTPResponse != nil && (resp.HTTPResponse.StatusCode == http.StatusTooManyRequests || resp.HTTPResponse.StatusCode >= 500) {
+			if resp.JSON500 != nil {
+				return retry.NewHTTPError(resp.HTTPResponse, fmt.Sprintf("server error: %s", resp.JSON500.Error.Message))
+			}
+			return retry.NewHTTPError(resp.HTTPResponse, fmt.Sprintf("unexpected status code %d: %s", resp.HTTPResponse.StatusCode, string(resp.Body)))
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch manifest: %w", err)
+		return nil, err
 	}
 
 	// Debug: log HTTP response details
@@ -141,9 +147,17 @@ func (c *Client) PullFlags(ctx context.Context) (*flagset.Flagset, error) {
 		return nil, fmt.Errorf("expected manifest data but got none")
 	}
 
+	// The API model only has the documented fields, so read the others from the raw response
+	var raw struct {
+		Flags []map[string]any `json:"flags"`
+	}
+	if err := json.Unmarshal(resp.Body, &raw); err != nil || len(raw.Flags) != len(resp.JSON200.Flags) {
+		raw.Flags = nil
+	}
+
 	// Convert from API model to internal flagset model
 	flags := make([]flagset.Flag, 0, len(resp.JSON200.Flags))
-	for _, apiFlag := range resp.JSON200.Flags {
+	for i, apiFlag := range resp.JSON200.Flags {
 		// Parse flag type from string
 		flagType, err := flagset.ParseFlagType(string(apiFlag.Type))
 		if err != nil {
@@ -154,6 +168,9 @@ func (c *Client) PullFlags(ctx context.Context) (*flagset.Flagset, error) {
 			Key:  apiFlag.Key,
 			Type: flagType,
 		}
+		if raw.Flags != nil {
+			flag.Metadata = flagset.ProviderMetadata(raw.Flags[i])
+		}
 
 		// Set optional fields
 		if apiFlag.Description != nil {
@@ -165,7 +182,8 @@ func (c *Client) PullFlags(ctx context.Context) (*flagset.Flagset, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal defaultValue for flag %s: %w", flag.Key, err)
 		}
-		if err := json.Unmarshal(defaultValueJSON, &flag.DefaultValue); err != nil {
+		flag.DefaultValue, err = flagset.DecodeDefaultValue(flagType, defaultValueJSON)
+		if err != nil {
 			return nil, fmt.Errorf("failed to parse defaultValue for flag %s: %w", flag.Key, err)
 		}
 
@@ -211,10 +229,12 @@ func (c *Client) PushFlags(ctx context.Context, localFlags *flagset.Flagset, rem
 		return result, nil
 	}
 
+	total := len(toCreate) + len(toUpdate)
+
 	// Create new flags with retry logic
 	for _, flag := range toCreate {
 		flagKey := flag.Key // Capture for closure
-		err := goretry.IfNeededWithContext(ctx, func(ctx context.Context) error {
+		err := c.do(ctx, "create", func(ctx context.Context) error {
 			body, err := c.convertFlagToAPIBody(flag)
 			if err != nil {
 				return fmt.Errorf("failed to convert flag %s: %w", flagKey, err)
@@ -237,17 +257,18 @@ func (c *Client) PushFlags(ctx context.Context, localFlags *flagset.Flagset, rem
 			}
 
 			return c.handleFlagResponse(resp.HTTPResponse, resp.Body, flagKey, "create")
-		}, goretry.WithTransientErrorFunc(isTransientHTTPError))
+		})
 		if err != nil {
 			return nil, err
 		}
 		result.Created = append(result.Created, flag)
+		c.reportProgress("create", flag, len(result.Created), total)
 	}
 
 	// Update existing flags with retry logic
 	for _, flag := range toUpdate {
 		flagKey := flag.Key // Capture for closure
-		err := goretry.IfNeededWithContext(ctx, func(ctx context.Context) error {
+		err := c.do(ctx, "update", func(ctx context.Context) error {
 			body, err := c.convertFlagToPutBody(flag)
 			if err != nil {
 				return fmt.Errorf("failed to convert flag %s: %w", flagKey, err)
@@ -270,16 +291,110 @@ func (c *Client) PushFlags(ctx context.Context, localFlags *flagset.Flagset, rem
 			}
 
 			return c.handleFlagResponse(resp.HTTPResponse, resp.Body, flagKey, "update")
-		}, goretry.WithTransientErrorFunc(isTransientHTTPError))
+		})
 		if err != nil {
 			return nil, err
 		}
 		result.Updated = append(result.Updated, flag)
+		c.reportProgress("update", flag, len(result.Created)+len(result.Updated), total)
 	}
 
 	return result, nil
 }
 
+// FlagsToPrune returns the remote flags that are not present in the local flags,
+// sorted by key. These are the flags a prune would delete from the remote.
+func FlagsToPrune(localFlags *flagset.Flagset, remoteFlags *flagset.Flagset) []flagset.Flag {
+	localKeys := make(map[string]bool, len(localFlags.Flags))
+	for _, flag := range localFlags.Flags {
+		localKeys[flag.Key] = true
+	}
+
+	var toDelete []flagset.Flag
+	for _, flag := range remoteFlags.Flags {
+		if !localKeys[flag.Key] {
+			toDelete = append(toDelete, flag)
+		}
+	}
+
+	sort.Slice(toDelete, func(i, j int) bool {
+		return toDelete[i].Key < toDelete[j].Key
+	})
+
+	return toDelete
+}
+
+// DefaultChange is a local flag whose default value differs from the remote flag with the same key
+type DefaultChange struct {
+	Flag flagset.Flag
+	// Previous is the default value of the remote flag
+	Previous any
+}
+
+// DefaultChanges returns the changes a push would make to the default values of the remote flags,
+// sorted by key. Flags that don't exist on the remote yet are not included.
+func DefaultChanges(localFlags *flagset.Flagset, remoteFlags *flagset.Flagset) []DefaultChange {
+	remoteByKey := make(map[string]flagset.Flag, len(remoteFlags.Flags))
+	for _, flag := range remoteFlags.Flags {
+		remoteByKey[flag.Key] = flag
+	}
+
+	var changes []DefaultChange
+	for _, flag := range localFlags.Flags {
+		remote, ok := remoteByKey[flag.Key]
+		if !ok || defaultValuesEqual(flag.DefaultValue, remote.DefaultValue) {
+			continue
+		}
+		changes = append(changes, DefaultChange{Flag: flag, Previous: remote.DefaultValue})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Flag.Key < changes[j].Flag.Key
+	})
+
+	return changes
+}
+
+// defaultValuesEqual reports whether two default values have the same JSON representation
+func defaultValuesEqual(a, b any) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return string(aJSON) == string(bJSON)
+}
+
+// DeleteFlags deletes (or archives, depending on the provider) the given flags from the remote.
+// Returns the flags that were deleted. On error, flags deleted before the failure are not rolled back.
+func (c *Client) DeleteFlags(ctx context.Context, flags []flagset.Flag) ([]flagset.Flag, error) {
+	var deleted []flagset.Flag
+
+	for _, flag := range flags {
+		flagKey := flag.Key // Capture for closure
+		err := c.do(ctx, "delete", func(ctx context.Context) error {
+			logger.Default.Debug(fmt.Sprintf("Sending DELETE for %s", flagKey))
+
+			resp, err := c.apiClient.DeleteOpenfeatureV0ManifestFlagsKeyWithResponse(ctx, flagKey)
+			if err != nil {
+				return fmt.Errorf("failed to delete flag %s: %w", flagKey, err)
+			}
+
+			return c.handleFlagResponse(resp.HTTPResponse, resp.Body, flagKey, "delete")
+		})
+		if err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, flag)
+		c.reportProgress("delete", flag, len(deleted), len(flags))
+	}
+
+	return deleted, nil
+}
+
 // convertFlagToAPIBody converts internal flag to POST API body format
 func (c *Client) convertFlagToAPIBody(flag flagset.Flag) (syncclient.PostOpenfeatureV0ManifestFlagsJSONRequestBody, error) {
 	// Convert flag type to API enum
@@ -370,11 +485,8 @@ func (c *Client) handleFlagResponse(resp *http.Response, body []byte, flagKey st
 		message = fmt.Sprintf("failed to %s flag %s (status %d): %s", operation, flagKey, resp.StatusCode, string(body))
 	}
 
-	// Return httpError so retry logic can determine if it's transient
-	return &httpError{
-		statusCode: resp.StatusCode,
-		message:    message,
-	}
+	// Return an HTTPError so retry logic can determine if it's transient
+	return retry.NewHTTPError(resp, message)
 }
 
 // flagsEqual compares two flags to determine if they are effectively identical
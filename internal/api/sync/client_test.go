@@ -0,0 +1,167 @@
+package sync
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagsToPrune(t *testing.T) {
+	local := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "kept", Type: flagset.BoolType},
+		},
+	}
+	remote := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "zeta", Type: flagset.BoolType},
+			{Key: "kept", Type: flagset.BoolType},
+			{Key: "alpha", Type: flagset.StringType},
+		},
+	}
+
+	toDelete := FlagsToPrune(local, remote)
+
+	assert.Len(t, toDelete, 2)
+	assert.Equal(t, "alpha", toDelete[0].Key)
+	assert.Equal(t, "zeta", toDelete[1].Key)
+	assert.Empty(t, FlagsToPrune(remote, local))
+}
+
+func TestDefaultChanges(t *testing.T) {
+	local := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "zeta", Type: flagset.IntType, DefaultValue: 2},
+			{Key: "same", Type: flagset.ObjectType, DefaultValue: map[string]any{"a": 1}},
+			{Key: "alpha", Type: flagset.BoolType, DefaultValue: true},
+			{Key: "new", Type: flagset.BoolType, DefaultValue: true},
+		},
+	}
+	remote := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "zeta", Type: flagset.IntType, DefaultValue: 1},
+			{Key: "same", Type: flagset.ObjectType, DefaultValue: map[string]any{"a": 1}},
+			{Key: "alpha", Type: flagset.BoolType, DefaultValue: false},
+		},
+	}
+
+	changes := DefaultChanges(local, remote)
+
+	require.Len(t, changes, 2)
+	assert.Equal(t, "alpha", changes[0].Flag.Key)
+	assert.Equal(t, false, changes[0].Previous)
+	assert.Equal(t, "zeta", changes[1].Flag.Key)
+	assert.Equal(t, 1, changes[1].Previous)
+}
+
+func TestPushFlagsReportsProgress(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.example.com").
+		Post("/openfeature/v0/manifest/flags").
+		Reply(201).
+		JSON(map[string]any{"flag": map[string]any{"key": "new-flag"}, "updatedAt": "2024-03-02T09:45:03.000Z"})
+	gock.New("https://api.example.com").
+		Put("/openfeature/v0/manifest/flags/changed-flag").
+		Reply(200).
+		JSON(map[string]any{"flag": map[string]any{"key": "changed-flag"}, "updatedAt": "2024-03-02T09:45:03.000Z"})
+	gock.New("https://api.example.com").
+		Delete("/openfeature/v0/manifest/flags/old-flag").
+		Reply(204)
+
+	client, err := NewClient("https://api.example.com", "")
+	require.NoError(t, err)
+
+	var events []ProgressEvent
+	client.OnProgress(func(event ProgressEvent) {
+		events = append(events, event)
+	})
+
+	localFlags := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "new-flag", Type: flagset.BoolType, DefaultValue: true},
+			{Key: "changed-flag", Type: flagset.BoolType, DefaultValue: true},
+		},
+	}
+	remoteFlags := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "changed-flag", Type: flagset.BoolType, DefaultValue: false},
+		},
+	}
+
+	_, err = client.PushFlags(t.Context(), localFlags, remoteFlags, false)
+	require.NoError(t, err)
+	_, err = client.DeleteFlags(t.Context(), []flagset.Flag{{Key: "old-flag", Type: flagset.BoolType}})
+	require.NoError(t, err)
+
+	require.Len(t, events, 3)
+	assert.Equal(t, ProgressEvent{Operation: "create", Flag: localFlags.Flags[0], Done: 1, Total: 2}, events[0])
+	assert.Equal(t, ProgressEvent{Operation: "update", Flag: localFlags.Flags[1], Done: 2, Total: 2}, events[1])
+	assert.Equal(t, "delete", events[2].Operation)
+	assert.Equal(t, 1, events[2].Total)
+	assert.True(t, gock.IsDone(), "Not all expected HTTP requests were made")
+}
+
+func TestClientRecordsMetrics(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.example.com").
+		Post("/openfeature/v0/manifest/flags").
+		Reply(503).
+		JSON(map[string]any{"error": map[string]any{"message": "unavailable"}})
+	gock.New("https://api.example.com").
+		Post("/openfeature/v0/manifest/flags").
+		Times(2).
+		Reply(201).
+		JSON(map[string]any{"flag": map[string]any{"key": "new-flag"}, "updatedAt": "2024-03-02T09:45:03.000Z"})
+
+	client, err := NewClient("https://api.example.com", "")
+	require.NoError(t, err)
+
+	localFlags := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "first-flag", Type: flagset.BoolType, DefaultValue: true},
+			{Key: "second-flag", Type: flagset.BoolType, DefaultValue: true},
+		},
+	}
+
+	_, err = client.PushFlags(t.Context(), localFlags, &flagset.Flagset{}, false)
+	require.NoError(t, err)
+
+	metrics := client.Metrics()
+	require.Len(t, metrics, 1)
+	assert.Equal(t, "create", metrics[0].Operation)
+	assert.Equal(t, 2, metrics[0].Calls)
+	assert.Equal(t, 3, metrics[0].Requests)
+	assert.Equal(t, 1, metrics[0].Retries)
+	assert.Positive(t, metrics[0].Duration)
+	assert.True(t, gock.IsDone(), "Not all expected HTTP requests were made")
+}
+
+func TestIntegerDefaultValuesArePreserved(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.example.com").
+		Get("/openfeature/v0/manifest").
+		Reply(200).
+		SetHeader("Content-Type", "application/json").
+		BodyString(`{"flags": [{"key": "big-int", "type": "integer", "defaultValue": 9007199254740993}]}`)
+
+	client, err := NewClient("https://api.example.com", "")
+	require.NoError(t, err)
+
+	flags, err := client.PullFlags(t.Context())
+	require.NoError(t, err)
+	require.Len(t, flags.Flags, 1)
+	assert.Equal(t, int64(9007199254740993), flags.Flags[0].DefaultValue)
+
+	body, err := client.convertFlagToAPIBody(flags.Flags[0])
+	require.NoError(t, err)
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+	assert.Contains(t, string(payload), `"defaultValue":9007199254740993`)
+}
@@ -0,0 +1,72 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/open-feature/cli/internal/api/retry"
+)
+
+// OperationMetrics summarizes the API calls made for one kind of operation
+type OperationMetrics struct {
+	// Operation is the kind of operation: fetch, create, update or delete
+	Operation string
+	// Calls is the number of operations performed (e.g., the number of flags created)
+	Calls int
+	// Requests is the number of HTTP requests sent, including retries
+	Requests int
+	// Retries is the number of requests that were retries of a failed request
+	Retries int
+	// Duration is the total time spent on the operations, including the delay between retries
+	Duration time.Duration
+}
+
+// String summarizes the metrics on a single line, for verbose output
+func (m OperationMetrics) String() string {
+	return fmt.Sprintf("%s: %d call(s), %d request(s), %d retry(s), %s",
+		m.Operation, m.Calls, m.Requests, m.Retries, m.Duration.Round(time.Millisecond))
+}
+
+// metrics collects operation metrics in the order the operations were first performed
+type metrics struct {
+	operations []OperationMetrics
+}
+
+// record adds a single call of the operation to the metrics
+func (m *metrics) record(operation string, requests int, duration time.Duration) {
+	for i := range m.operations {
+		if m.operations[i].Operation == operation {
+			m.operations[i].Calls++
+			m.operations[i].Requests += requests
+			m.operations[i].Retries += max(requests-1, 0)
+			m.operations[i].Duration += duration
+			return
+		}
+	}
+
+	m.operations = append(m.operations, OperationMetrics{
+		Operation: operation,
+		Calls:     1,
+		Requests:  requests,
+		Retries:   max(requests-1, 0),
+		Duration:  duration,
+	})
+}
+
+// Metrics returns the metrics of all operations performed by the client so far
+func (c *Client) Metrics() []OperationMetrics {
+	return append([]OperationMetrics(nil), c.metrics.operations...)
+}
+
+// do calls fn with retry logic and records the call under the given operation
+func (c *Client) do(ctx context.Context, operation string, fn func(context.Context) error) error {
+	start := time.Now()
+	requests := 0
+	err := retry.Do(ctx, func(ctx context.Context) error {
+		requests++
+		return fn(ctx)
+	})
+	c.metrics.record(operation, requests, time.Since(start))
+	return err
+}
@@ -295,6 +295,46 @@ func TestRetryLogic(t *testing.T) {
 		assert.True(t, gock.IsDone(), "All expected requests should be made")
 	})
 
+	t.Run("retries on 429 rate limiting", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New("https://api.example.com").
+			Post("/openfeature/v0/manifest/flags").
+			Reply(429).
+			SetHeader("Retry-After", "0").
+			JSON(map[string]any{
+				"error": map[string]any{
+					"message": "Too Many Requests",
+					"status":  429,
+				},
+			})
+
+		gock.New("https://api.example.com").
+			Post("/openfeature/v0/manifest/flags").
+			Reply(201).
+			JSON(map[string]any{
+				"flag": map[string]any{
+					"key": "test-flag",
+				},
+				"updatedAt": "2024-03-02T09:45:03.000Z",
+			})
+
+		client, err := NewClient("https://api.example.com", "")
+		require.NoError(t, err)
+
+		localFlags := &flagset.Flagset{
+			Flags: []flagset.Flag{
+				{Key: "test-flag", Type: flagset.BoolType, DefaultValue: true},
+			},
+		}
+		remoteFlags := &flagset.Flagset{Flags: []flagset.Flag{}}
+
+		result, err := client.PushFlags(t.Context(), localFlags, remoteFlags, false)
+		require.NoError(t, err)
+		assert.Len(t, result.Created, 1)
+		assert.True(t, gock.IsDone(), "Should retry after being rate limited")
+	})
+
 	t.Run("dry run mode does not make API calls", func(t *testing.T) {
 		// No gock mocks needed - dry run should not make any HTTP requests
 
@@ -330,96 +370,3 @@ func TestRetryLogic(t *testing.T) {
 		assert.Equal(t, "existing-flag", result.Updated[0].Key)
 	})
 }
-
-func TestIsTransientHTTPError(t *testing.T) {
-	tests := []struct {
-		name        string
-		err         error
-		shouldRetry bool
-	}{
-		{
-			name:        "nil error is not transient",
-			err:         nil,
-			shouldRetry: false,
-		},
-		{
-			name: "500 error is transient",
-			err: &httpError{
-				statusCode: 500,
-				message:    "Internal Server Error",
-			},
-			shouldRetry: true,
-		},
-		{
-			name: "502 error is transient",
-			err: &httpError{
-				statusCode: 502,
-				message:    "Bad Gateway",
-			},
-			shouldRetry: true,
-		},
-		{
-			name: "503 error is transient",
-			err: &httpError{
-				statusCode: 503,
-				message:    "Service Unavailable",
-			},
-			shouldRetry: true,
-		},
-		{
-			name: "504 error is transient",
-			err: &httpError{
-				statusCode: 504,
-				message:    "Gateway Timeout",
-			},
-			shouldRetry: true,
-		},
-		{
-			name: "400 error is not transient",
-			err: &httpError{
-				statusCode: 400,
-				message:    "Bad Request",
-			},
-			shouldRetry: false,
-		},
-		{
-			name: "401 error is not transient",
-			err: &httpError{
-				statusCode: 401,
-				message:    "Unauthorized",
-			},
-			shouldRetry: false,
-		},
-		{
-			name: "404 error is not transient",
-			err: &httpError{
-				statusCode: 404,
-				message:    "Not Found",
-			},
-			shouldRetry: false,
-		},
-		{
-			name: "409 error is not transient",
-			err: &httpError{
-				statusCode: 409,
-				message:    "Conflict",
-			},
-			shouldRetry: false,
-		},
-		{
-			name: "200 success is not transient",
-			err: &httpError{
-				statusCode: 200,
-				message:    "OK",
-			},
-			shouldRetry: false,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := isTransientHTTPError(tt.err)
-			assert.Equal(t, tt.shouldRetry, result)
-		})
-	}
-}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func GetBotCmd() *cobra.Command {
+	botCmd := &cobra.Command{
+		Use:   "bot",
+		Short: "Produce payloads for flag maintenance bots",
+		Long:  `Commands that produce machine-readable payloads for bots that automate flag maintenance, such as opening cleanup pull requests.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceErrors:              true,
+		SilenceUsage:               true,
+		DisableSuggestions:         false,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	botCmd.AddCommand(GetBotSuggestRemovalsCmd())
+
+	addStabilityInfo(botCmd)
+
+	return botCmd
+}
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// invalidBranchChars matches the characters of a flag key that are not used in branch names
+var invalidBranchChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// removalSuggestion is the pull request payload that removes an expired flag
+type removalSuggestion struct {
+	Flag       string            `json:"flag"`
+	ExpiredAt  time.Time         `json:"expiredAt"`
+	Branch     string            `json:"branch"`
+	Title      string            `json:"title"`
+	Body       string            `json:"body"`
+	References []usage.Reference `json:"references"`
+	// Patch is the unified diff that removes the flag from the manifest, for git apply
+	Patch string `json:"patch"`
+}
+
+func GetBotSuggestRemovalsCmd() *cobra.Command {
+	suggestRemovalsCmd := &cobra.Command{
+		Use:   "suggest-removals",
+		Short: "Suggest pull requests that remove expired flags",
+		Long: `Produce a pull request payload for each expired flag of the manifest (whose activeUntil time has passed).
+
+Each payload holds a branch name, a title, a Markdown body with the references to the flag found in
+the code, and a unified diff that removes the flag from the manifest, to apply with git apply.
+The references are found like the usage command does, and are listed for removal by hand.`,
+		Example: `  # List the expired flags and their references
+  openfeature bot suggest-removals
+
+  # Open a pull request per expired flag with the GitHub CLI
+  openfeature bot suggest-removals --output json | jq -c '.[]' | while read -r s; do
+    git switch -c "$(jq -r .branch <<< "$s")" main
+    jq -r .patch <<< "$s" | git apply
+    git commit -am "$(jq -r .title <<< "$s")"
+    gh pr create --title "$(jq -r .title <<< "$s")" --body "$(jq -r .body <<< "$s")"
+  done`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "bot.suggest-removals")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			dir := config.GetDir(cmd)
+			outputFormat := config.GetOutputPath(cmd)
+
+			if outputFormat != "table" && outputFormat != "json" {
+				return fmt.Errorf("invalid output format: %q. Valid formats are: table, json", outputFormat)
+			}
+
+			fs, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			now := time.Now()
+			var expired []flagset.Flag
+			for _, flag := range fs.Flags {
+				if flag.ActiveUntil != nil && !now.Before(*flag.ActiveUntil) {
+					expired = append(expired, flag)
+				}
+			}
+
+			suggestions := []removalSuggestion{}
+			if len(expired) > 0 {
+				keys := make([]string, 0, len(expired))
+				for _, flag := range expired {
+					keys = append(keys, flag.Key)
+				}
+
+				// Don't count the manifest itself as a reference
+				exclude := manifestExcludes(dir, manifestPath)
+				refs, err := usage.Scan(dir, keys, usage.Options{Exclude: exclude})
+				if err != nil {
+					return fmt.Errorf("error scanning %s: %w", dir, err)
+				}
+
+				data, err := manifest.ReadData(manifestPath)
+				if err != nil {
+					return fmt.Errorf("failed to read manifest: %w", err)
+				}
+				var doc any
+				if err := json.Unmarshal(data, &doc); err != nil {
+					return fmt.Errorf("failed to parse manifest %s: %w", manifestPath, err)
+				}
+
+				for _, flag := range expired {
+					suggestion, err := suggestRemoval(flag, refs, manifestPath, data, doc)
+					if err != nil {
+						return err
+					}
+					suggestions = append(suggestions, suggestion)
+				}
+			}
+
+			if outputFormat == "json" {
+				data, err := json.MarshalIndent(suggestions, "", "  ")
+				if err != nil {
+					return fmt.Errorf("error marshaling suggestions: %w", err)
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+				return err
+			}
+
+			displayRemovalSuggestions(suggestions)
+			return nil
+		},
+	}
+
+	config.AddBotSuggestRemovalsFlags(suggestRemovalsCmd)
+	addStabilityInfo(suggestRemovalsCmd)
+
+	return suggestRemovalsCmd
+}
+
+// suggestRemoval creates the pull request payload that removes the expired flag, given the
+// references to the expired flags and the contents of the manifest
+func suggestRemoval(flag flagset.Flag, refs []usage.Reference, manifestPath string, data []byte, doc any) (removalSuggestion, error) {
+	flagRefs := []usage.Reference{}
+	for _, ref := range refs {
+		if ref.Key == flag.Key {
+			flagRefs = append(flagRefs, ref)
+		}
+	}
+
+	patched, err := manifest.ApplyJSONPatch(doc, manifest.JSONPatch([]manifest.Change{{Type: "remove", Path: "flags." + flag.Key}}))
+	if err != nil {
+		return removalSuggestion{}, fmt.Errorf("error removing flag %q from the manifest: %w", flag.Key, err)
+	}
+	patchedData, err := marshalPatchedManifest(patched, data)
+	if err != nil {
+		return removalSuggestion{}, err
+	}
+	patch, err := unifiedDiff(data, patchedData, manifestPath)
+	if err != nil {
+		return removalSuggestion{}, err
+	}
+
+	return removalSuggestion{
+		Flag:       flag.Key,
+		ExpiredAt:  *flag.ActiveUntil,
+		Branch:     "openfeature/remove-" + strings.Trim(invalidBranchChars.ReplaceAllString(flag.Key, "-"), "-."),
+		Title:      fmt.Sprintf("Remove expired feature flag %s", flag.Key),
+		Body:       removalBody(flag, flagRefs, manifestPath),
+		References: flagRefs,
+		Patch:      patch,
+	}, nil
+}
+
+// removalBody returns the Markdown body of the pull request that removes the expired flag
+func removalBody(flag flagset.Flag, refs []usage.Reference, manifestPath string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "The feature flag `%s` expired on %s and can be removed.\n", flag.Key, flag.ActiveUntil.Format(time.DateOnly))
+	if flag.Description != "" {
+		fmt.Fprintf(&b, "\n> %s\n", flag.Description)
+	}
+
+	b.WriteString("\n### References\n\n")
+	if len(refs) == 0 {
+		b.WriteString("No references to the flag were found in the code.\n")
+	} else {
+		fmt.Fprintf(&b, "The flag is referenced %d time(s) in the code. Remove these references before merging:\n\n", len(refs))
+		for _, ref := range refs {
+			fmt.Fprintf(&b, "- `%s:%d`\n", ref.File, ref.Line)
+		}
+	}
+
+	fmt.Fprintf(&b, "\n### Changes\n\nThis pull request removes the flag from `%s`.\n", filepath.ToSlash(manifestPath))
+	return b.String()
+}
+
+// displayRemovalSuggestions prints the suggestions as a table
+func displayRemovalSuggestions(suggestions []removalSuggestion) {
+	if len(suggestions) == 0 {
+		pterm.Info.Println("No expired flags")
+		return
+	}
+
+	pterm.DefaultSection.Println(fmt.Sprintf("Expired flags (%s)", display.count(len(suggestions))))
+
+	tableData := pterm.TableData{{"Flag", "Expired", "References", "Branch"}}
+	for _, suggestion := range suggestions {
+		tableData = append(tableData, []string{
+			suggestion.Flag,
+			display.date(suggestion.ExpiredAt),
+			display.count(len(suggestion.References)),
+			suggestion.Branch,
+		})
+	}
+
+	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBotSuggestRemovalsCmd(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(`{
+  "flags": {
+    "new-checkout": {
+      "activeUntil": "2020-01-31",
+      "defaultValue": false,
+      "description": "Use the new checkout flow",
+      "flagType": "boolean"
+    },
+    "theme": {
+      "activeUntil": "2999-01-01",
+      "defaultValue": "light",
+      "flagType": "string"
+    }
+  }
+}`), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "src/checkout.ts", []byte("const a = 1;\nif (client.getBooleanValue('new-checkout', false)) {}\n"), 0o644))
+
+	cmd := GetBotCmd()
+	config.AddRootFlags(cmd)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"suggest-removals", "-m", "flags.json", "--output", "json"})
+	require.NoError(t, cmd.Execute())
+
+	var suggestions []removalSuggestion
+	require.NoError(t, json.Unmarshal(out.Bytes(), &suggestions))
+	require.Len(t, suggestions, 1, "Only the expired flag should be suggested for removal")
+
+	suggestion := suggestions[0]
+	assert.Equal(t, "new-checkout", suggestion.Flag)
+	assert.Equal(t, "openfeature/remove-new-checkout", suggestion.Branch)
+	assert.Equal(t, "Remove expired feature flag new-checkout", suggestion.Title)
+	assert.Equal(t, []usage.Reference{{Key: "new-checkout", File: "src/checkout.ts", Line: 2}}, suggestion.References)
+	assert.Contains(t, suggestion.Body, "expired on 2020-01-31")
+	assert.Contains(t, suggestion.Body, "- `src/checkout.ts:2`")
+	assert.Equal(t, `--- a/flags.json
++++ b/flags.json
+@@ -1,11 +1,5 @@
+ {
+   "flags": {
+-    "new-checkout": {
+-      "activeUntil": "2020-01-31",
+-      "defaultValue": false,
+-      "description": "Use the new checkout flow",
+-      "flagType": "boolean"
+-    },
+     "theme": {
+       "activeUntil": "2999-01-01",
+       "defaultValue": "light",
+`, suggestion.Patch)
+}
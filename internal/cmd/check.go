@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// checkFs keeps the files written while checking generated code in memory, on top of the files on
+// disk, and records their paths
+type checkFs struct {
+	afero.Fs
+	written []string
+}
+
+// newCheckFs returns a filesystem that reads from disk and writes to memory
+func newCheckFs(disk afero.Fs) *checkFs {
+	return &checkFs{Fs: afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(disk), afero.NewMemMapFs())}
+}
+
+func (c *checkFs) Create(name string) (afero.File, error) {
+	c.record(name)
+	return c.Fs.Create(name)
+}
+
+func (c *checkFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC) != 0 {
+		c.record(name)
+	}
+	return c.Fs.OpenFile(name, flag, perm)
+}
+
+func (c *checkFs) record(name string) {
+	if !slices.Contains(c.written, name) {
+		c.written = append(c.written, name)
+	}
+}
+
+// addCheckMode lets every generate command check the generated files on disk instead of writing them, with --check
+func addCheckMode(cmd *cobra.Command) {
+	for _, child := range cmd.Commands() {
+		if child.RunE != nil {
+			child.RunE = withCheck(child.RunE)
+		}
+	}
+}
+
+// withCheck wraps the RunE of a generate command so that, with --check, the files are generated in
+// memory and compared with the files on disk. The differences are printed as a unified diff, and
+// an error is returned if any generated file is missing or out of date, so CI can enforce
+// regenerating the code after the manifest changes.
+func withCheck(run func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if !config.GetCheck(cmd) {
+			return run(cmd, args)
+		}
+		if config.GetWatch(cmd) {
+			return fmt.Errorf("--%s is not supported with --%s", config.CheckFlagName, config.WatchFlagName)
+		}
+
+		disk := filesystem.FileSystem()
+		// The targets generated by generate without arguments are checked together
+		if _, ok := disk.(*checkFs); ok {
+			return run(cmd, args)
+		}
+
+		check := newCheckFs(disk)
+		filesystem.SetFileSystem(check)
+		// The files aren't created, so the progress of the generation is left out
+		output := logger.RedirectOutput(io.Discard)
+		err := run(cmd, args)
+		logger.RedirectOutput(output)
+		filesystem.SetFileSystem(disk)
+		if err != nil {
+			return err
+		}
+
+		return checkGeneratedFiles(cmd, disk, check)
+	}
+}
+
+// outdatedFilesError is the error of a check that found generated files missing or out of date
+type outdatedFilesError struct {
+	paths []string
+}
+
+func (e *outdatedFilesError) Error() string {
+	return fmt.Sprintf("generated files are out of date: %s. Run the command without --%s to regenerate them",
+		strings.Join(e.paths, ", "), config.CheckFlagName)
+}
+
+// checkGeneratedFiles compares the files written to the check filesystem with the files on disk
+func checkGeneratedFiles(cmd *cobra.Command, disk afero.Fs, check *checkFs) error {
+	// Remote templates are cached while generating, which isn't generated code
+	cacheDir, err := filesystem.CachePath()
+	if err != nil {
+		return err
+	}
+
+	var outdated []string
+	for _, path := range check.written {
+		if isWithinDir(path, cacheDir) {
+			continue
+		}
+
+		generated, err := afero.ReadFile(check, path)
+		if err != nil {
+			return fmt.Errorf("error reading generated file %q: %w", path, err)
+		}
+		committed, err := afero.ReadFile(disk, path)
+		if os.IsNotExist(err) {
+			outdated = append(outdated, path)
+			fmt.Fprintf(cmd.OutOrStdout(), "Missing file %s\n", path)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error reading file %q: %w", path, err)
+		}
+		if bytes.Equal(generated, committed) {
+			continue
+		}
+
+		outdated = append(outdated, path)
+		if !utf8.Valid(generated) || !utf8.Valid(committed) {
+			fmt.Fprintf(cmd.OutOrStdout(), "Binary file %s differs\n", path)
+			continue
+		}
+		patch, err := unifiedDiff(committed, generated, path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(cmd.OutOrStdout(), patch)
+	}
+
+	if len(outdated) > 0 {
+		return &outdatedFilesError{paths: outdated}
+	}
+	logger.Default.Success("Generated files are up to date")
+	return nil
+}
+
+// isWithinDir checks if the path is inside the directory
+func isWithinDir(path string, dir string) bool {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(dir, abs)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCheck(t *testing.T) {
+	check := func(t *testing.T, fs afero.Fs, args ...string) (string, error) {
+		cmd := GetRootCmd()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs(append([]string{"generate", "go", "-m", "flags.json", "-o", "output", "--check"}, args...))
+		err := cmd.Execute()
+		assert.Equal(t, fs, filesystem.FileSystem(), "the filesystem is restored after the check")
+		return out.String(), err
+	}
+	setup := func(t *testing.T) afero.Fs {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+		return fs
+	}
+
+	t.Run("up to date", func(t *testing.T) {
+		fs := setup(t)
+		cmd := GetRootCmd()
+		cmd.SetArgs([]string{"generate", "go", "-m", "flags.json", "-o", "output"})
+		require.NoError(t, cmd.Execute())
+
+		out, err := check(t, fs)
+		require.NoError(t, err)
+		assert.Empty(t, out)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		fs := setup(t)
+
+		out, err := check(t, fs)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "generated files are out of date: output/openfeature_gen.go")
+		assert.Contains(t, out, "Missing file output/openfeature_gen.go")
+
+		exists, err := afero.Exists(fs, "output/openfeature_gen.go")
+		require.NoError(t, err)
+		assert.False(t, exists, "the generated file is not written")
+	})
+
+	t.Run("out of date", func(t *testing.T) {
+		fs := setup(t)
+		cmd := GetRootCmd()
+		cmd.SetArgs([]string{"generate", "go", "-m", "flags.json", "-o", "output"})
+		require.NoError(t, cmd.Execute())
+		content, err := afero.ReadFile(fs, "output/openfeature_gen.go")
+		require.NoError(t, err)
+		stale := bytes.Replace(content, []byte(`"enableFeatureA", false`), []byte(`"enableFeatureA", true`), 1)
+		require.NoError(t, afero.WriteFile(fs, "output/openfeature_gen.go", stale, 0o644))
+
+		out, err := check(t, fs)
+		require.Error(t, err)
+		assert.Contains(t, out, "--- a/output/openfeature_gen.go\n+++ b/output/openfeature_gen.go\n")
+		assert.Contains(t, out, "\n-\t\treturn client.Boolean(ctx, \"enableFeatureA\", true, evalCtx)\n")
+		assert.Contains(t, out, "\n+\t\treturn client.Boolean(ctx, \"enableFeatureA\", false, evalCtx)\n")
+
+		current, err := afero.ReadFile(fs, "output/openfeature_gen.go")
+		require.NoError(t, err)
+		assert.Equal(t, stale, current, "the file on disk is left as it is")
+	})
+
+	t.Run("deprecations don't depend on the current date", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(`{
+			"flags": {
+				"expiringFlag": {"flagType": "boolean", "defaultValue": true, "activeUntil": "2026-01-11T00:00:00Z"},
+				"laterFlag": {"flagType": "boolean", "defaultValue": true, "activeUntil": "2026-06-01T00:00:00Z"}
+			}
+		}`), 0o644))
+		cmd := GetRootCmd()
+		cmd.SetArgs([]string{"generate", "go", "-m", "flags.json", "-o", "output", "--deprecate-as-of", "2026-01-01"})
+		require.NoError(t, cmd.Execute())
+
+		// laterFlag has expired by now, but not within 30 days of --deprecate-as-of
+		content, err := afero.ReadFile(fs, "output/openfeature_gen.go")
+		require.NoError(t, err)
+		assert.Equal(t, 1, strings.Count(string(content), "// Deprecated: The flag expires on 2026-01-11."))
+		assert.NotContains(t, string(content), "2026-06-01")
+
+		out, err := check(t, fs, "--deprecate-as-of", "2026-01-01")
+		require.NoError(t, err)
+		assert.Empty(t, out)
+	})
+
+	t.Run("not supported with watch", func(t *testing.T) {
+		fs := setup(t)
+
+		_, err := check(t, fs, "--watch")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--check is not supported with --watch")
+	})
+}
@@ -0,0 +1,517 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/report"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ciStatus is the outcome of a single ci step
+type ciStatus string
+
+const (
+	ciPassed  ciStatus = "passed"
+	ciFailed  ciStatus = "failed"
+	ciSkipped ciStatus = "skipped"
+)
+
+// ciStepResult holds the result of a single ci step
+type ciStepResult struct {
+	Name     string           `json:"name"`
+	Status   ciStatus         `json:"status"`
+	Message  string           `json:"message,omitempty"`
+	Findings []report.Finding `json:"findings,omitempty"`
+}
+
+// ciSummary is the machine-readable summary written by the ci command
+type ciSummary struct {
+	Manifest string         `json:"manifest"`
+	Passed   bool           `json:"passed"`
+	Steps    []ciStepResult `json:"steps"`
+}
+
+func GetCICmd() *cobra.Command {
+	ciCmd := &cobra.Command{
+		Use:   "ci",
+		Short: "Run the common manifest checks for a CI pipeline",
+		Long: `Run the common manifest checks for a CI pipeline in a single command.
+
+The following steps are run in order:
+
+1. validate - Validates the manifest and the files it includes against the flag
+               manifest schema and the organization policy, if one is configured in
+               the policy block, and scans descriptions and default values for
+               secret-looking values
+2. usage    - Reports the flags that are not referenced in the code of --dir, which
+               only fails the run with --fail-on-unused
+3. compare  - Compares the flags of the manifest and the files it includes against
+               another manifest (only when --against is set)
+4. generate - Checks that the code generated for the targets in the generate block
+               of .openfeature.yaml is up to date, like generate --check (only when
+               targets are configured)
+
+Every step is always run so that a single execution reports all problems.
+The command exits with a non-zero status if any step fails.
+
+Use --summary to write a machine-readable JSON summary of the run, for example
+to publish it as a pipeline artifact.
+
+Use --report-format and --report-file to write the findings in a format that
+CI platforms render natively in merge requests:
+
+- gitlab    - GitLab Code Quality report (artifacts:reports:codequality)
+- bitbucket - Bitbucket Code Insights report and annotations
+
+All options can be set in a 'ci' block of .openfeature.yaml:
+
+  ci:
+    against: "main-flags.json"
+    summary: "openfeature-ci.json"
+    fail-on-drift: true
+    fail-on-unused: true
+    report-format: "gitlab"
+    report-file: "gl-code-quality-report.json"`,
+		Example: `  # Validate the manifest
+  openfeature ci
+
+  # Validate and fail if the manifest differs from the one on the main branch
+  openfeature ci --against main-flags.json --fail-on-drift
+
+  # Produce a GitLab Code Quality report
+  openfeature ci --report-format gitlab --report-file gl-code-quality-report.json
+
+  # Run inside the published container image and keep a summary artifact
+  docker run -v $(pwd):/local -w /local ghcr.io/open-feature/cli:latest ci --summary openfeature-ci.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "ci")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			againstPath := config.GetAgainstPath(cmd)
+			summaryPath := config.GetSummaryPath(cmd)
+			failOnDrift := config.GetFailOnDrift(cmd)
+			failOnUnused := config.GetFailOnUnused(cmd)
+			reportFormat := config.GetReportFormat(cmd)
+			reportPath := config.GetReportPath(cmd)
+
+			if reportFormat != "" && !report.IsValidFormat(reportFormat) {
+				return fmt.Errorf("invalid report format: %s. Valid formats are: %s",
+					reportFormat, strings.Join(report.GetValidFormats(), ", "))
+			}
+			if reportFormat != "" && reportPath == "" {
+				return fmt.Errorf("--report-file is required when --report-format is set")
+			}
+
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+			policy, err := loadPolicy(v)
+			if err != nil {
+				return err
+			}
+			scanner, err := loadSecretScanner(v)
+			if err != nil {
+				return err
+			}
+
+			summary := ciSummary{
+				Manifest: manifestPath,
+				Steps: []ciStepResult{
+					runCIValidate(manifestPath, policy, scanner),
+					runCIUsage(manifestPath, config.GetDir(cmd), failOnUnused),
+					runCICompare(manifestPath, againstPath, failOnDrift),
+					runCIGenerate(manifestPath, v),
+				},
+			}
+
+			var failed []string
+			for _, step := range summary.Steps {
+				if step.Status == ciFailed {
+					failed = append(failed, step.Name)
+				}
+			}
+			summary.Passed = len(failed) == 0
+
+			displayCISummary(summary)
+
+			if summaryPath != "" {
+				if err := writeCISummary(summaryPath, summary); err != nil {
+					return err
+				}
+			}
+
+			if reportFormat != "" {
+				if err := writeCIReport(reportPath, report.Format(reportFormat), summary); err != nil {
+					return err
+				}
+			}
+
+			if !summary.Passed {
+				return fmt.Errorf("ci checks failed: %s", strings.Join(failed, ", "))
+			}
+
+			return nil
+		},
+	}
+
+	config.AddCIFlags(ciCmd)
+	addStabilityInfo(ciCmd)
+
+	return ciCmd
+}
+
+// runCIValidate validates the manifest and the files it includes against the flag manifest schema and
+// the policy, and scans their flags for secret-looking values. The policy and the scanner may be nil.
+func runCIValidate(manifestPath string, policy *manifest.Policy, scanner *manifest.SecretScanner) ciStepResult {
+	result := ciStepResult{Name: "validate"}
+
+	issues, err := manifest.ValidateFiles(manifestPath)
+	if err != nil {
+		result.Status = ciFailed
+		result.Message = err.Error()
+		return result
+	}
+
+	// The policy and the secrets can only be checked once the manifest is valid
+	if len(issues) == 0 && (policy != nil || scanner != nil) {
+		fs, err := manifest.LoadFlagSet(manifestPath)
+		if err != nil {
+			result.Status = ciFailed
+			result.Message = err.Error()
+			return result
+		}
+		for _, issue := range append(policy.Check(fs.Flags), scanner.Scan(fs.Flags)...) {
+			file := flagIssueFile(manifestPath, fs, issue.Path)
+			issue.Line, issue.Column = manifest.ValuePosition(file, issue.Path)
+			issues = append(issues, manifest.FileValidationError{File: file, ValidationError: issue})
+		}
+	}
+
+	if len(issues) == 0 {
+		result.Status = ciPassed
+		result.Message = "manifest is valid"
+		return result
+	}
+
+	result.Status = ciFailed
+	result.Message = fmt.Sprintf("%d validation issue(s) found", len(issues))
+	for _, issue := range issues {
+		result.Findings = append(result.Findings, report.Finding{
+			Check:    result.Name,
+			Severity: report.SeverityError,
+			File:     issue.File,
+			Line:     issue.Line,
+			Path:     issue.Path,
+			Message:  issue.Message,
+		})
+	}
+
+	return result
+}
+
+// flagIssueFile returns the file of the manifest defining the flag an issue at the path is about,
+// or the manifest itself if the issue is not about a flag
+func flagIssueFile(manifestPath string, fs *flagset.Flagset, path string) string {
+	for _, flag := range fs.Flags {
+		prefix := "flags." + flag.Key
+		if path != prefix && !strings.HasPrefix(path, prefix+".") {
+			continue
+		}
+		if file, err := manifest.FileOf(manifestPath, flag.Key); err == nil {
+			return file
+		}
+	}
+	return manifestPath
+}
+
+// runCIUsage reports the flags of the manifest that are not referenced in the code of the directory.
+// Generated code, which references every flag, doesn't count.
+func runCIUsage(manifestPath, dir string, failOnUnused bool) ciStepResult {
+	result := ciStepResult{Name: "usage"}
+
+	// An invalid manifest is reported by the validate step
+	fs, err := manifest.LoadFlagSet(manifestPath)
+	if err != nil {
+		result.Status = ciSkipped
+		result.Message = fmt.Sprintf("the manifest could not be loaded: %v", err)
+		return result
+	}
+
+	keys := make([]string, 0, len(fs.Flags))
+	for _, flag := range fs.Flags {
+		keys = append(keys, flag.Key)
+	}
+	refs, err := usage.Scan(dir, keys, usage.Options{Exclude: manifestExcludes(dir, manifestPath), SkipGenerated: true})
+	if err != nil {
+		result.Status = ciFailed
+		result.Message = fmt.Sprintf("error scanning %s: %v", dir, err)
+		return result
+	}
+
+	referenced := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		referenced[ref.Key] = true
+	}
+
+	severity := report.SeverityInfo
+	if failOnUnused {
+		severity = report.SeverityError
+	}
+
+	for _, key := range keys {
+		if referenced[key] {
+			continue
+		}
+		path := "flags." + key
+		file := flagIssueFile(manifestPath, fs, path)
+		line, _ := manifest.ValuePosition(file, path)
+		result.Findings = append(result.Findings, report.Finding{
+			Check:    result.Name,
+			Severity: severity,
+			File:     file,
+			Line:     line,
+			Path:     path,
+			Message:  fmt.Sprintf("flag is not referenced in %s", dir),
+		})
+	}
+
+	switch {
+	case len(result.Findings) == 0:
+		result.Status = ciPassed
+		result.Message = fmt.Sprintf("every flag is referenced in %s", dir)
+	case failOnUnused:
+		result.Status = ciFailed
+		result.Message = fmt.Sprintf("%d flag(s) not referenced in %s", len(result.Findings), dir)
+	default:
+		result.Status = ciPassed
+		result.Message = fmt.Sprintf("%d flag(s) not referenced in %s", len(result.Findings), dir)
+	}
+
+	return result
+}
+
+// runCICompare compares the manifest against the manifest at againstPath
+func runCICompare(manifestPath, againstPath string, failOnDrift bool) ciStepResult {
+	result := ciStepResult{Name: "compare"}
+
+	if againstPath == "" {
+		result.Status = ciSkipped
+		result.Message = "no manifest to compare against (set --against to enable)"
+		return result
+	}
+
+	source, err := readCIManifest(manifestPath)
+	if err != nil {
+		result.Status = ciFailed
+		result.Message = err.Error()
+		return result
+	}
+
+	target, err := readCIManifest(againstPath)
+	if err != nil {
+		result.Status = ciFailed
+		result.Message = err.Error()
+		return result
+	}
+
+	changes, err := manifest.Compare(target, source, manifest.CompareOptions{})
+	if err != nil {
+		result.Status = ciFailed
+		result.Message = fmt.Sprintf("error comparing manifests: %v", err)
+		return result
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	severity := report.SeverityInfo
+	if failOnDrift {
+		severity = report.SeverityError
+	}
+
+	for _, change := range changes {
+		// Removed flags are reported on the manifest, the others on the file that defines them
+		file, err := manifest.FileOf(manifestPath, strings.TrimPrefix(change.Path, "flags."))
+		if err != nil {
+			file = manifestPath
+		}
+		line, _ := manifest.ValuePosition(file, change.Path)
+		result.Findings = append(result.Findings, report.Finding{
+			Check:    result.Name,
+			Severity: severity,
+			File:     file,
+			Line:     line,
+			Path:     change.Path,
+			Message:  fmt.Sprintf("flag %s compared to %s", describeChangeType(change.Type), againstPath),
+		})
+	}
+
+	switch {
+	case len(changes) == 0:
+		result.Status = ciPassed
+		result.Message = fmt.Sprintf("no differences found against %s", againstPath)
+	case failOnDrift:
+		result.Status = ciFailed
+		result.Message = fmt.Sprintf("%d difference(s) found against %s", len(changes), againstPath)
+	default:
+		result.Status = ciPassed
+		result.Message = fmt.Sprintf("%d difference(s) found against %s", len(changes), againstPath)
+	}
+
+	return result
+}
+
+// runCIGenerate checks that the code generated for the generate targets of the config is up to date
+func runCIGenerate(manifestPath string, v *viper.Viper) ciStepResult {
+	result := ciStepResult{Name: "generate"}
+
+	if !v.IsSet(generateTargetsConfigKey) {
+		result.Status = ciSkipped
+		result.Message = fmt.Sprintf("no generate targets configured (set %s in .openfeature.yaml to enable)", generateTargetsConfigKey)
+		return result
+	}
+
+	// Run generate --check for the targets, without its output
+	generateCmd := GetGenerateCmd()
+	config.AddRootFlags(generateCmd)
+	generateCmd.SetArgs([]string{"--" + config.CheckFlagName, "--" + config.ManifestFlagName, manifestPath})
+	generateCmd.SetOut(io.Discard)
+	generateCmd.SetErr(io.Discard)
+	generateCmd.SilenceUsage = true
+	generateCmd.SilenceErrors = true
+	output := logger.RedirectOutput(io.Discard)
+	defer logger.RedirectOutput(output)
+	err := generateCmd.Execute()
+
+	var outdated *outdatedFilesError
+	switch {
+	case errors.As(err, &outdated):
+		result.Status = ciFailed
+		result.Message = fmt.Sprintf("%d generated file(s) missing or out of date", len(outdated.paths))
+		for _, path := range outdated.paths {
+			result.Findings = append(result.Findings, report.Finding{
+				Check:    result.Name,
+				Severity: report.SeverityError,
+				File:     path,
+				Message:  "generated file is missing or out of date. Run openfeature generate to regenerate it",
+			})
+		}
+	case err != nil:
+		result.Status = ciFailed
+		result.Message = err.Error()
+	default:
+		result.Status = ciPassed
+		result.Message = "generated files are up to date"
+	}
+
+	return result
+}
+
+// readCIManifest loads the flags of a manifest and of the files it includes, in the entry format of
+// the manifest
+func readCIManifest(path string) (*manifest.Manifest, error) {
+	fs, err := manifest.LoadFlagSet(path)
+	if err != nil {
+		return nil, fmt.Errorf("error loading manifest %q: %w", path, err)
+	}
+
+	flags := make(map[string]any, len(fs.Flags))
+	for _, flag := range fs.Flags {
+		flags[flag.Key] = manifest.Entry(flag)
+	}
+	// Compare the entries as they would be read from a manifest file
+	data, err := json.Marshal(flags)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling manifest %q: %w", path, err)
+	}
+
+	var m manifest.Manifest
+	if err := json.Unmarshal(data, &m.Flags); err != nil {
+		return nil, fmt.Errorf("error unmarshaling manifest %q: %w", path, err)
+	}
+
+	return &m, nil
+}
+
+// describeChangeType converts a change type into a past tense verb for display
+func describeChangeType(changeType string) string {
+	switch changeType {
+	case "add":
+		return "added"
+	case "remove":
+		return "removed"
+	default:
+		return "changed"
+	}
+}
+
+// displayCISummary prints the result of each ci step
+func displayCISummary(summary ciSummary) {
+	for _, step := range summary.Steps {
+		message := fmt.Sprintf("%s: %s", step.Name, step.Message)
+		switch step.Status {
+		case ciPassed:
+			pterm.Success.Println(message)
+		case ciFailed:
+			pterm.Error.Println(message)
+		default:
+			pterm.Info.Println(message)
+		}
+
+		for _, finding := range step.Findings {
+			pterm.Printf("  - %s: %s\n", finding.Path, finding.Message)
+		}
+	}
+}
+
+// writeCISummary writes the summary as indented JSON to the given path
+func writeCISummary(path string, summary ciSummary) error {
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling ci summary: %w", err)
+	}
+
+	if err := filesystem.WriteFile(path, data); err != nil {
+		return fmt.Errorf("error writing ci summary: %w", err)
+	}
+
+	logger.Default.FileCreated(path)
+	return nil
+}
+
+// writeCIReport writes the findings of all steps as a CI platform report to the given path
+func writeCIReport(path string, format report.Format, summary ciSummary) error {
+	var findings []report.Finding
+	for _, step := range summary.Steps {
+		findings = append(findings, step.Findings...)
+	}
+
+	data, err := report.Render(format, "OpenFeature manifest checks", summary.Passed, findings)
+	if err != nil {
+		return fmt.Errorf("error rendering %s report: %w", format, err)
+	}
+
+	if err := filesystem.WriteFile(path, data); err != nil {
+		return fmt.Errorf("error writing %s report: %w", format, err)
+	}
+
+	logger.Default.FileCreated(path)
+	return nil
+}
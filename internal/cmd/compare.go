@@ -4,12 +4,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
 
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 	yaml "gopkg.in/yaml.v3"
@@ -29,7 +31,21 @@ Examples:
   openfeature compare --manifest local.json --against main.json
 
   # Preview what will change when pushing to remote
-  openfeature compare --manifest local.json --against remote.json --reverse`,
+  openfeature compare --manifest local.json --against remote.json --reverse
+
+  # Write the differences as an RFC 6902 JSON Patch that turns main.json into local.json
+  openfeature compare --manifest local.json --against main.json --output json-patch > changes.json
+
+  # Write the differences as a unified patch of main.json, to apply with git apply or patch
+  openfeature compare --manifest local.json --against main.json --output patch > changes.patch
+
+  # Copy the differences to the clipboard as Markdown, to paste into a pull request
+  openfeature compare --manifest local.json --against main.json --copy
+
+The json-patch and patch outputs turn the old manifest (--against, or --manifest with --reverse)
+into the new one. Only the detected changes are included: fields matched by --ignore and fields
+that are not part of the manifest schema are left as they are. The unified patch is of the old
+manifest formatted with two-space indentation and sorted keys, as written by the CLI.`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initializeConfig(cmd, "compare")
 		},
@@ -80,23 +96,40 @@ Examples:
 				return fmt.Errorf("error comparing manifests: %w", err)
 			}
 
+			// Patches of manifests without differences are empty
+			oldPath := targetPath
+			if reverse {
+				oldPath = sourcePath
+			}
+			switch manifest.OutputFormat(outputFormat) {
+			case manifest.OutputFormatJSONPatch:
+				return renderJSONPatch(changes, cmd)
+			case manifest.OutputFormatPatch:
+				return renderUnifiedPatch(changes, oldPath, cmd)
+			}
+
 			// No changes
 			if len(changes) == 0 {
 				pterm.Success.Println("No differences found between the manifests.")
-				return nil
+				return copyResult(cmd, changesMarkdown(changes), "differences")
 			}
 
 			// Render differences based on the output format
 			switch manifest.OutputFormat(outputFormat) {
 			case manifest.OutputFormatFlat:
-				return renderFlatDiff(changes, cmd)
+				err = renderFlatDiff(changes, cmd)
 			case manifest.OutputFormatJSON:
 				return renderJSONDiff(changes, cmd)
 			case manifest.OutputFormatYAML:
 				return renderYAMLDiff(changes, cmd)
 			default:
-				return renderTreeDiff(changes, cmd)
+				err = renderTreeDiff(changes, cmd)
+			}
+			if err != nil {
+				return err
 			}
+			// The tree and flat outputs are copied as Markdown, which pastes well into pull requests and chats
+			return copyResult(cmd, changesMarkdown(changes), "differences")
 		},
 	}
 
@@ -107,6 +140,7 @@ Examples:
 	compareCmd.Flags().StringArrayP("ignore", "i", []string{},
 		"Field pattern to ignore during comparison (can be specified multiple times). "+
 			"Supports shorthand (e.g., 'description') and full paths with wildcards (e.g., 'flags.*.description', 'metadata.*')")
+	config.AddCopyFlag(compareCmd, "Copy the differences to the clipboard, as Markdown with the tree and flat outputs")
 	compareCmd.Flags().Bool("reverse", false,
 		"Reverse comparison direction. Shows what WILL change when manifest is pushed to target (sending perspective) "+
 			"instead of what HAS changed in manifest compared to target (receiving perspective)")
@@ -124,6 +158,10 @@ func loadManifest(path string) (*manifest.Manifest, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error reading file: %w", err)
 	}
+	data, err = manifest.ToJSON(path, data)
+	if err != nil {
+		return nil, err
+	}
 
 	// Unmarshal JSON
 	var m manifest.Manifest
@@ -208,6 +246,43 @@ func renderTreeDiff(changes []manifest.Change, cmd *cobra.Command) error {
 	return nil
 }
 
+// changesMarkdown formats the changes as Markdown, with the field-level differences of modified flags
+func changesMarkdown(changes []manifest.Change) string {
+	if len(changes) == 0 {
+		return "No differences found between the manifests.\n"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Differences (%d)\n", len(changes))
+	for _, section := range []struct {
+		title      string
+		changeType string
+	}{
+		{"Additions", "add"},
+		{"Removals", "remove"},
+		{"Modifications", "change"},
+	} {
+		header := false
+		for _, change := range changes {
+			if change.Type != section.changeType {
+				continue
+			}
+			if !header {
+				fmt.Fprintf(&b, "\n### %s\n\n", section.title)
+				header = true
+			}
+			flagName := strings.TrimPrefix(change.Path, "flags.")
+			fmt.Fprintf(&b, "- `%s`\n", flagName)
+			if change.Type == "change" {
+				for _, fc := range getFieldChanges(flagName, change.OldValue, change.NewValue) {
+					fmt.Fprintf(&b, "  - %s: `%s` → `%s`\n", fc.Field, fc.OldValue, fc.NewValue)
+				}
+			}
+		}
+	}
+	return b.String()
+}
+
 // fieldChange represents a change to a specific field
 type fieldChange struct {
 	Field    string
@@ -359,9 +434,7 @@ func renderJSONDiff(changes []manifest.Change, cmd *cobra.Command) error {
 		return fmt.Errorf("error marshaling JSON output: %w", err)
 	}
 
-	// Print the JSON
-	fmt.Println(string(jsonBytes))
-	return nil
+	return printResult(cmd, string(jsonBytes), "differences")
 }
 
 // renderYAMLDiff renders changes in YAML format
@@ -395,7 +468,72 @@ func renderYAMLDiff(changes []manifest.Change, cmd *cobra.Command) error {
 		return fmt.Errorf("error marshaling YAML output: %w", err)
 	}
 
-	// Print the YAML
-	fmt.Println(string(yamlBytes))
-	return nil
+	return printResult(cmd, string(yamlBytes), "differences")
+}
+
+// renderJSONPatch renders changes as an RFC 6902 JSON Patch of the old manifest
+func renderJSONPatch(changes []manifest.Change, cmd *cobra.Command) error {
+	jsonBytes, err := json.MarshalIndent(manifest.JSONPatch(changes), "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON patch: %w", err)
+	}
+
+	return printResult(cmd, string(jsonBytes), "JSON patch")
+}
+
+// renderUnifiedPatch renders changes as a unified diff of the old manifest, formatted as the CLI writes it
+func renderUnifiedPatch(changes []manifest.Change, oldPath string, cmd *cobra.Command) error {
+	data, err := os.ReadFile(oldPath)
+	if err != nil {
+		return fmt.Errorf("error reading file: %w", err)
+	}
+	data, err = manifest.ToJSON(oldPath, data)
+	if err != nil {
+		return err
+	}
+	var oldDoc any
+	if err := json.Unmarshal(data, &oldDoc); err != nil {
+		return fmt.Errorf("error unmarshaling JSON: %w", err)
+	}
+
+	newDoc, err := manifest.ApplyJSONPatch(oldDoc, manifest.JSONPatch(changes))
+	if err != nil {
+		return fmt.Errorf("error applying changes to %s: %w", oldPath, err)
+	}
+
+	oldJSON, err := json.MarshalIndent(oldDoc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %s: %w", oldPath, err)
+	}
+	newJSON, err := json.MarshalIndent(newDoc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling patched %s: %w", oldPath, err)
+	}
+
+	patch, err := unifiedDiff(append(oldJSON, '\n'), append(newJSON, '\n'), oldPath)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprint(cmd.OutOrStdout(), patch); err != nil {
+		return err
+	}
+	return copyResult(cmd, patch, "patch")
+}
+
+// unifiedDiff returns the unified diff that turns the old contents of the file at the path into
+// the new contents, with the a/ and b/ prefixes of git
+func unifiedDiff(oldData []byte, newData []byte, path string) (string, error) {
+	name := strings.TrimPrefix(filepath.ToSlash(path), "/")
+	patch, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(oldData)),
+		B:        difflib.SplitLines(string(newData)),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating patch: %w", err)
+	}
+	return patch, nil
 }
@@ -42,7 +42,7 @@ func TestCompareManifests(t *testing.T) {
 	// This test mainly verifies the command executes without errors
 	// with each of the supported output formats
 
-	formats := []string{"tree", "flat", "json", "yaml"}
+	formats := []string{"tree", "flat", "json", "yaml", "json-patch", "patch"}
 
 	for _, format := range formats {
 		t.Run(fmt.Sprintf("output_format_%s", format), func(t *testing.T) {
@@ -263,3 +263,58 @@ func TestCompareWithReverseFlag(t *testing.T) {
 			"welcomeMessage should NOT be in removals")
 	})
 }
+
+func TestCompareJSONPatch(t *testing.T) {
+	rootCmd := GetRootCmd()
+	out := &bytes.Buffer{}
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{
+		"compare",
+		"--manifest", "testdata/source_manifest.json",
+		"--against", "testdata/target_manifest.json",
+		"--output", "json-patch",
+	})
+	require.NoError(t, rootCmd.Execute())
+
+	var operations []manifest.PatchOperation
+	require.NoError(t, json.Unmarshal(out.Bytes(), &operations))
+	assert.Contains(t, operations, manifest.PatchOperation{Op: "remove", Path: "/flags/welcomeMessage"})
+	assert.Contains(t, operations, manifest.PatchOperation{Op: "replace", Path: "/flags/darkMode/defaultValue", Value: false})
+}
+
+func TestCompareUnifiedPatch(t *testing.T) {
+	rootCmd := GetRootCmd()
+	out := &bytes.Buffer{}
+	rootCmd.SetOut(out)
+	rootCmd.SetArgs([]string{
+		"compare",
+		"--manifest", "testdata/source_manifest.json",
+		"--against", "testdata/target_manifest.json",
+		"--output", "patch",
+	})
+	require.NoError(t, rootCmd.Execute())
+
+	patch := out.String()
+	assert.Contains(t, patch, "--- a/testdata/target_manifest.json\n+++ b/testdata/target_manifest.json\n")
+	assert.Contains(t, patch, "-      \"defaultValue\": true,\n")
+	assert.Contains(t, patch, "+      \"defaultValue\": false,\n")
+	assert.Contains(t, patch, "-    \"welcomeMessage\": {\n")
+}
+
+func TestChangesMarkdown(t *testing.T) {
+	changes := []manifest.Change{
+		{Type: "add", Path: "flags.newFlag", NewValue: map[string]any{"flagType": "boolean", "defaultValue": true}},
+		{Type: "change", Path: "flags.darkMode",
+			OldValue: map[string]any{"flagType": "boolean", "defaultValue": true},
+			NewValue: map[string]any{"flagType": "boolean", "defaultValue": false}},
+	}
+
+	assert.Equal(t, "## Differences (2)\n"+
+		"\n### Additions\n\n"+
+		"- `newFlag`\n"+
+		"\n### Modifications\n\n"+
+		"- `darkMode`\n"+
+		"  - defaultValue: `true` → `false`\n",
+		changesMarkdown(changes))
+	assert.Equal(t, "No differences found between the manifests.\n", changesMarkdown(nil))
+}
@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -13,23 +15,9 @@ import (
 // initializeConfig reads in config file and ENV variables if set.
 // It applies configuration values to command flags based on hierarchical priority.
 func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
-	v := viper.New()
-
-	// Set the config file name and path
-	v.SetConfigName(".openfeature")
-	v.AddConfigPath(".")
-
-	logger.Default.Debug("Looking for .openfeature config file in current directory")
-
-	// Read the config file
-	if err := v.ReadInConfig(); err != nil {
-		// It's okay if there isn't a config file
-		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			return err
-		}
-		logger.Default.Debug("No config file found, using defaults and environment variables")
-	} else {
-		logger.Default.Debug(fmt.Sprintf("Using config file: %s", v.ConfigFileUsed()))
+	v, err := readConfigFile()
+	if err != nil {
+		return err
 	}
 
 	// Track which flags were set directly via command line
@@ -39,6 +27,12 @@ func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
 		logger.Default.Debug(fmt.Sprintf("Flag set via command line: %s=%s", f.Name, f.Value.String()))
 	})
 
+	// Resolve the named target (connection profile), whose values take precedence over the rest of the config
+	target, err := resolveTarget(cmd, v, bindPrefix, cmdLineFlags)
+	if err != nil {
+		return err
+	}
+
 	// Apply the configuration values
 	cmd.Flags().VisitAll(func(f *pflag.Flag) {
 		// Skip if flag was set on command line
@@ -50,6 +44,11 @@ func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
 		// Build configuration paths from most specific to least specific
 		configPaths := []string{}
 
+		// Check the selected target first (e.g., targets.prod.provider-url)
+		if target != "" {
+			configPaths = append(configPaths, "targets."+target+"."+f.Name)
+		}
+
 		// Check the most specific path (e.g., generate.go.package-name)
 		if bindPrefix != "" {
 			configPaths = append(configPaths, bindPrefix+"."+f.Name)
@@ -87,3 +86,72 @@ func initializeConfig(cmd *cobra.Command, bindPrefix string) error {
 
 	return nil
 }
+
+// readConfigFile reads the .openfeature config file in the current directory.
+// An empty config is returned if there is no config file.
+func readConfigFile() (*viper.Viper, error) {
+	v := viper.New()
+
+	// Set the config file name and path
+	v.SetConfigName(".openfeature")
+	v.AddConfigPath(".")
+
+	logger.Default.Debug("Looking for .openfeature config file in current directory")
+
+	// Read the config file
+	if err := v.ReadInConfig(); err != nil {
+		// It's okay if there isn't a config file
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return nil, err
+		}
+		logger.Default.Debug("No config file found, using defaults and environment variables")
+	} else {
+		logger.Default.Debug(fmt.Sprintf("Using config file: %s", v.ConfigFileUsed()))
+	}
+
+	return v, nil
+}
+
+// resolveTarget returns the name of the target selected with --target, either on the
+// command line or in the config, and checks that the config defines it.
+// Returns an empty name if the command has no --target flag or no target is selected.
+func resolveTarget(cmd *cobra.Command, v *viper.Viper, bindPrefix string, cmdLineFlags map[string]bool) (string, error) {
+	if cmd.Flags().Lookup(config.TargetFlagName) == nil {
+		return "", nil
+	}
+
+	target := config.GetTarget(cmd)
+	if !cmdLineFlags[config.TargetFlagName] {
+		if bindPrefix != "" && v.IsSet(bindPrefix+"."+config.TargetFlagName) {
+			target = v.GetString(bindPrefix + "." + config.TargetFlagName)
+		} else if v.IsSet(config.TargetFlagName) {
+			target = v.GetString(config.TargetFlagName)
+		}
+	}
+
+	if target == "" {
+		return "", nil
+	}
+	if !v.IsSet("targets." + target) {
+		return "", fmt.Errorf("target %q is not defined in the targets block of .openfeature.yaml", target)
+	}
+
+	logger.Default.Debug(fmt.Sprintf("Using target %s", target))
+	return target, nil
+}
+
+// checkOperations returns an error if read-only mode is enabled, or if the selected target
+// doesn't allow one of the operations
+func checkOperations(cmd *cobra.Command, operations ...string) error {
+	v, err := readConfigFile()
+	if err != nil {
+		return err
+	}
+
+	for _, operation := range operations {
+		if err := config.CheckOperation(v, os.Getenv, config.GetTarget(cmd), operation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
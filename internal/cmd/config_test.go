@@ -131,3 +131,73 @@ generate:
 	assert.Equal(t, "output-from-cmdline", cmd.Flag("output").Value.String(),
 		"Command line value should override config file")
 }
+
+func setupTargetTestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "push",
+	}
+	cmd.Flags().String("provider-url", "", "provider url")
+	cmd.Flags().String("auth-token", "", "auth token")
+	cmd.Flags().String("target", "", "target")
+
+	return cmd
+}
+
+const targetsConfig = `
+provider-url: https://default.example.com
+auth-token: default-token
+push:
+  auth-token: push-token
+targets:
+  prod:
+    provider-url: https://prod.example.com
+    auth-token: prod-token
+  staging:
+    provider-url: https://staging.example.com
+`
+
+func TestTargetOverridesConfig(t *testing.T) {
+	setupConfigFileForTest(t, targetsConfig)
+
+	cmd := setupTargetTestCommand()
+	_ = cmd.Flags().Set("target", "prod")
+	err := initializeConfig(cmd, "push")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://prod.example.com", cmd.Flag("provider-url").Value.String())
+	assert.Equal(t, "prod-token", cmd.Flag("auth-token").Value.String())
+}
+
+func TestTargetFallsBackToConfigForMissingValues(t *testing.T) {
+	setupConfigFileForTest(t, targetsConfig)
+
+	cmd := setupTargetTestCommand()
+	_ = cmd.Flags().Set("target", "staging")
+	err := initializeConfig(cmd, "push")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://staging.example.com", cmd.Flag("provider-url").Value.String())
+	assert.Equal(t, "push-token", cmd.Flag("auth-token").Value.String())
+}
+
+func TestTargetSelectedInConfig(t *testing.T) {
+	setupConfigFileForTest(t, targetsConfig+`
+target: staging
+`)
+
+	cmd := setupTargetTestCommand()
+	err := initializeConfig(cmd, "push")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "https://staging.example.com", cmd.Flag("provider-url").Value.String())
+}
+
+func TestUnknownTarget(t *testing.T) {
+	setupConfigFileForTest(t, targetsConfig)
+
+	cmd := setupTargetTestCommand()
+	_ = cmd.Flags().Set("target", "qa")
+	err := initializeConfig(cmd, "push")
+
+	assert.EqualError(t, err, `target "qa" is not defined in the targets block of .openfeature.yaml`)
+}
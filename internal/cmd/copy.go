@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/terminal"
+	"github.com/spf13/cobra"
+)
+
+// copyResult copies the result of the command to the clipboard if --copy is set. The confirmation
+// is written to standard error, so that the result can still be piped.
+func copyResult(cmd *cobra.Command, result string, name string) error {
+	if !config.GetCopy(cmd) {
+		return nil
+	}
+	if err := terminal.Copy(result); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(cmd.ErrOrStderr(), "Copied the %s to the clipboard\n", name)
+	return err
+}
+
+// printResult prints the machine-readable result of the command, and copies it to the clipboard if --copy is set
+func printResult(cmd *cobra.Command, result string, name string) error {
+	if _, err := fmt.Fprintln(cmd.OutOrStdout(), result); err != nil {
+		return err
+	}
+	return copyResult(cmd, result, name)
+}
+
+// writeMarkdownTable writes the rows as a Markdown table, the first row being the header
+func writeMarkdownTable(b *strings.Builder, rows [][]string) {
+	for i, row := range rows {
+		cells := make([]string, len(row))
+		for j, cell := range row {
+			cells[j] = strings.ReplaceAll(strings.ReplaceAll(cell, "|", `\|`), "\n", " ")
+		}
+		fmt.Fprintf(b, "| %s |\n", strings.Join(cells, " | "))
+		if i == 0 {
+			fmt.Fprintf(b, "|%s\n", strings.Repeat(" --- |", len(row)))
+		}
+	}
+}
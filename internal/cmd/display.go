@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Date formats of --date-format, besides a Go time layout
+const (
+	dateFormatISO    = "iso"
+	dateFormatLocale = "locale"
+)
+
+// localeDateLayouts are the date layouts of the locales, by language and region or by language alone
+var localeDateLayouts = map[string]string{
+	"en-US": "01/02/2006",
+	"en-CA": "2006-01-02",
+	"en":    "02/01/2006",
+	"de":    "02.01.2006",
+	"fr":    "02/01/2006",
+	"es":    "02/01/2006",
+	"it":    "02/01/2006",
+	"pt":    "02/01/2006",
+	"nl":    "02-01-2006",
+	"pl":    "02.01.2006",
+	"ru":    "02.01.2006",
+	"sv":    "2006-01-02",
+	"ja":    "2006/01/02",
+	"zh":    "2006/01/02",
+	"ko":    "2006. 01. 02.",
+}
+
+// displayFormat formats the dates and counts of tables and summaries for humans.
+// Machine readable output (JSON, porcelain, reports) keeps ISO dates and plain numbers.
+type displayFormat struct {
+	dateLayout string
+	timeLayout string
+	numbers    *message.Printer
+}
+
+// display is the format shared by all commands, set from --date-format and the locale of the
+// environment before a command runs
+var display = displayFormat{
+	dateLayout: time.DateOnly,
+	timeLayout: time.RFC3339,
+	numbers:    message.NewPrinter(language.English),
+}
+
+// newDisplayFormat returns the display format for the --date-format value. Dates in the locale
+// format and thousands separators follow the locale of the environment, read with getenv.
+func newDisplayFormat(dateFormat string, getenv func(string) string) (displayFormat, error) {
+	format := displayFormat{
+		dateLayout: time.DateOnly,
+		timeLayout: time.RFC3339,
+		numbers:    message.NewPrinter(language.English),
+	}
+
+	if tag := environmentLocale(getenv, "LC_NUMERIC"); tag != language.Und {
+		format.numbers = message.NewPrinter(tag)
+	}
+
+	switch dateFormat {
+	case "", dateFormatISO:
+	case dateFormatLocale:
+		format.dateLayout = localeDateLayout(environmentLocale(getenv, "LC_TIME"))
+		format.timeLayout = format.dateLayout + " 15:04"
+	default:
+		// A layout without any element of the reference time formats every date as itself
+		if time.Date(2001, time.March, 4, 5, 6, 7, 0, time.UTC).Format(dateFormat) == dateFormat {
+			return format, fmt.Errorf("invalid date format %q. Use iso, locale or a Go time layout (e.g., '02 Jan 2006')", dateFormat)
+		}
+		format.dateLayout = dateFormat
+		format.timeLayout = dateFormat
+	}
+
+	return format, nil
+}
+
+// environmentLocale returns the locale of the category (e.g., LC_TIME) from the environment,
+// following the POSIX precedence of LC_ALL, the category and LANG. Returns language.Und if it is
+// not set or not a language, as with the C and POSIX locales.
+func environmentLocale(getenv func(string) string, category string) language.Tag {
+	for _, name := range []string{"LC_ALL", category, "LANG"} {
+		value := getenv(name)
+		if value == "" {
+			continue
+		}
+		// Strip the codeset and modifier, as in de_DE.UTF-8@euro
+		value, _, _ = strings.Cut(value, ".")
+		value, _, _ = strings.Cut(value, "@")
+		tag, err := language.Parse(strings.ReplaceAll(value, "_", "-"))
+		if err != nil {
+			return language.Und
+		}
+		return tag
+	}
+	return language.Und
+}
+
+// localeDateLayout returns the date layout of the locale, or the ISO layout if it is not known
+func localeDateLayout(tag language.Tag) string {
+	if tag == language.Und {
+		return time.DateOnly
+	}
+	base, _ := tag.Base()
+	if region, confidence := tag.Region(); confidence == language.Exact {
+		if layout, ok := localeDateLayouts[base.String()+"-"+region.String()]; ok {
+			return layout
+		}
+	}
+	if layout, ok := localeDateLayouts[base.String()]; ok {
+		return layout
+	}
+	return time.DateOnly
+}
+
+// date formats the date of t for display
+func (f displayFormat) date(t time.Time) string {
+	return t.Format(f.dateLayout)
+}
+
+// dateTime formats t, including the time of day, for display
+func (f displayFormat) dateTime(t time.Time) string {
+	return t.Format(f.timeLayout)
+}
+
+// count formats n with the thousands separator of the locale
+func (f displayFormat) count(n int) string {
+	return f.numbers.Sprintf("%d", n)
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDisplayFormat(t *testing.T) {
+	date := time.Date(2026, time.March, 4, 17, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		dateFormat string
+		env        map[string]string
+		wantDate   string
+		wantTime   string
+		wantCount  string
+	}{
+		{
+			name:       "iso by default",
+			dateFormat: "iso",
+			env:        map[string]string{"LANG": "de_DE.UTF-8"},
+			wantDate:   "2026-03-04",
+			wantTime:   "2026-03-04T17:30:00Z",
+			wantCount:  "1.234.567",
+		},
+		{
+			name:       "locale of LANG",
+			dateFormat: "locale",
+			env:        map[string]string{"LANG": "en_US.UTF-8"},
+			wantDate:   "03/04/2026",
+			wantTime:   "03/04/2026 17:30",
+			wantCount:  "1,234,567",
+		},
+		{
+			name:       "LC_TIME takes precedence over LANG",
+			dateFormat: "locale",
+			env:        map[string]string{"LANG": "en_US.UTF-8", "LC_TIME": "de_DE.UTF-8"},
+			wantDate:   "04.03.2026",
+			wantTime:   "04.03.2026 17:30",
+			wantCount:  "1,234,567",
+		},
+		{
+			name:       "LC_ALL takes precedence over all",
+			dateFormat: "locale",
+			env:        map[string]string{"LANG": "en_US.UTF-8", "LC_TIME": "de_DE", "LC_ALL": "ja_JP.UTF-8"},
+			wantDate:   "2026/03/04",
+			wantTime:   "2026/03/04 17:30",
+			wantCount:  "1,234,567",
+		},
+		{
+			name:       "unknown locale falls back to iso",
+			dateFormat: "locale",
+			env:        map[string]string{"LANG": "C"},
+			wantDate:   "2026-03-04",
+			wantTime:   "2026-03-04 17:30",
+			wantCount:  "1,234,567",
+		},
+		{
+			name:       "go time layout",
+			dateFormat: "02 Jan 2006",
+			wantDate:   "04 Mar 2026",
+			wantTime:   "04 Mar 2026",
+			wantCount:  "1,234,567",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := newDisplayFormat(tt.dateFormat, func(name string) string { return tt.env[name] })
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantDate, format.date(date))
+			assert.Equal(t, tt.wantTime, format.dateTime(date))
+			assert.Equal(t, tt.wantCount, format.count(1234567))
+		})
+	}
+
+	t.Run("fails for a layout without date elements", func(t *testing.T) {
+		_, err := newDisplayFormat("short", func(string) string { return "" })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid date format "short"`)
+	})
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// relativeExpiryPattern matches relative expiry values such as +90d, 12w, +3m, "in 3 months" or "in 1 year"
+var relativeExpiryPattern = regexp.MustCompile(`^(?:\+|in\s+)?(\d+)\s*(d|days?|w|weeks?|m|mo|months?|y|years?)$`)
+
+// parseExpiry parses the end of the activation window of a flag: an ISO date (2026-12-31), an RFC 3339
+// time, or a number of days, weeks, months or years from today (+90d, "in 3 months").
+// Dates and relative values are normalized to the start of the day in the given timezone.
+func parseExpiry(s string, now time.Time, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+
+	if t, err := flagset.ParseScheduleTime(s, loc); err == nil {
+		return t, nil
+	}
+
+	match := relativeExpiryPattern.FindStringSubmatch(strings.ToLower(s))
+	if match == nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %q: expected a date (e.g., 2026-12-31) or a time from today (e.g., +90d, \"in 3 months\")", s)
+	}
+	n, err := strconv.Atoi(match[1])
+	if err != nil || n <= 0 {
+		return time.Time{}, fmt.Errorf("invalid expiry %q: expected a positive amount of time from today", s)
+	}
+
+	year, month, day := now.In(loc).Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	switch match[2][0] {
+	case 'd':
+		return today.AddDate(0, 0, n), nil
+	case 'w':
+		return today.AddDate(0, 0, 7*n), nil
+	case 'm':
+		return today.AddDate(0, n, 0), nil
+	default:
+		return today.AddDate(n, 0, 0), nil
+	}
+}
+
+// formatRelativeSchedule converts the activation window of a flag to a short string relative to the
+// given time, such as "expires in 12 days" or "expired 40 days ago"
+func formatRelativeSchedule(flag flagset.Flag, now time.Time) string {
+	switch {
+	case flag.ActiveFrom != nil && now.Before(*flag.ActiveFrom):
+		return fmt.Sprintf("starts in %s", formatDays(daysBetween(now, *flag.ActiveFrom)))
+	case flag.ActiveUntil == nil && flag.ActiveFrom == nil:
+		return ""
+	case flag.ActiveUntil == nil:
+		return "active"
+	case now.Before(*flag.ActiveUntil):
+		if days := daysBetween(now, *flag.ActiveUntil); days > 0 {
+			return fmt.Sprintf("expires in %s", formatDays(days))
+		}
+		return "expires today"
+	default:
+		if days := daysBetween(*flag.ActiveUntil, now); days > 0 {
+			return fmt.Sprintf("expired %s ago", formatDays(days))
+		}
+		return "expired today"
+	}
+}
+
+// daysBetween returns the number of whole days from one time to a later one
+func daysBetween(from time.Time, to time.Time) int {
+	return int(to.Sub(from) / (24 * time.Hour))
+}
+
+// formatDays formats a number of days for display, such as "1 day" or "12 days"
+func formatDays(days int) string {
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%s days", display.count(days))
+}
@@ -0,0 +1,352 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/terminal"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// Remote states of a flag in a target, as known from the pull cache
+const (
+	explainInSync    = "in sync"
+	explainDiffers   = "differs"
+	explainMissing   = "missing"
+	explainNotCached = "not cached"
+)
+
+// explainDossier is everything known about a single flag
+type explainDossier struct {
+	Key        string            `json:"key"`
+	Manifest   string            `json:"manifest"`
+	Definition map[string]any    `json:"definition"`
+	Schedule   string            `json:"schedule,omitempty"`
+	Targets    []explainTarget   `json:"targets"`
+	Usage      []usage.Reference `json:"usage"`
+	Teams      []string          `json:"teams"`
+	History    []explainCommit   `json:"history"`
+}
+
+// explainTarget is the state of the flag in a target, as of the last cached pull
+type explainTarget struct {
+	Name         string     `json:"name"`
+	ProviderURL  string     `json:"providerUrl,omitempty"`
+	Status       string     `json:"status"`
+	FetchedAt    *time.Time `json:"fetchedAt,omitempty"`
+	DefaultValue any        `json:"defaultValue,omitempty"`
+}
+
+// explainCommit is a commit that added or removed the flag from the manifest
+type explainCommit struct {
+	Hash    string `json:"hash"`
+	Date    string `json:"date"`
+	Author  string `json:"author"`
+	Subject string `json:"subject"`
+}
+
+func GetExplainCmd() *cobra.Command {
+	explainCmd := &cobra.Command{
+		Use:   "explain <key>",
+		Short: "Show everything known about a flag",
+		Long: `Aggregate everything known about a flag into a single dossier:
+
+- Definition - The flag as defined in the manifest, including its activation window (expiry)
+- Targets    - The state of the flag in each named target of .openfeature.yaml, compared
+               with the manifest. Remote flags are read from the pull cache (see --cache-ttl
+               on pull), so no requests are made and targets that were never pulled are "not cached"
+- Usage      - Where the flag key is referenced in the codebase. In terminals that support
+               hyperlinks (OSC 8), the file:line references open the files when clicked
+- Teams      - The teams owning the code that references the flag (see usage.teams)
+- History    - The git commits that added or removed the flag from the manifest`,
+		Example: `  # Show the dossier of a flag
+  openfeature explain new-checkout
+
+  # Write the dossier as JSON
+  openfeature explain new-checkout --output json
+
+  # Copy the dossier to the clipboard as Markdown, to paste into an issue
+  openfeature explain new-checkout --copy`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "explain")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+			manifestPath := config.GetManifestPath(cmd)
+			dir := config.GetDir(cmd)
+			outputFormat := config.GetOutputPath(cmd)
+
+			if outputFormat != "table" && outputFormat != "json" {
+				return fmt.Errorf("invalid output format: %q. Valid formats are: table, json", outputFormat)
+			}
+
+			fs, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			var flag *flagset.Flag
+			for i := range fs.Flags {
+				if fs.Flags[i].Key == key {
+					flag = &fs.Flags[i]
+					break
+				}
+			}
+			if flag == nil {
+				return fmt.Errorf("flag %q not found in manifest", key)
+			}
+
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+
+			// Don't count the manifest itself as a reference
+			exclude := manifestExcludes(dir, manifestPath)
+			refs, err := usage.Scan(dir, []string{key}, usage.Options{Exclude: exclude})
+			if err != nil {
+				return fmt.Errorf("error scanning %s: %w", dir, err)
+			}
+
+			teams := []string{}
+			for _, area := range usage.ByTeam(refs, v.GetStringMapStringSlice(usageTeamsConfigKey)) {
+				if area.Name != usage.UnownedArea {
+					teams = append(teams, area.Name)
+				}
+			}
+			sort.Strings(teams)
+
+			targets := []explainTarget{}
+			for _, name := range sortedKeys(v.GetStringMap("targets")) {
+				prefix := "targets." + name + "."
+				targets = append(targets, explainRemoteState(
+					name,
+					v.GetString(prefix+config.ProviderURLFlagName),
+					v.GetString(prefix+config.AuthTokenFlagName),
+					*flag,
+				))
+			}
+
+			if refs == nil {
+				refs = []usage.Reference{}
+			}
+			dossier := explainDossier{
+				Key:        key,
+				Manifest:   manifestPath,
+				Definition: manifest.Entry(*flag),
+				Schedule:   formatSchedule(*flag, time.Now()),
+				Targets:    targets,
+				Usage:      refs,
+				Teams:      teams,
+				History:    explainHistory(manifestPath, key),
+			}
+
+			if outputFormat == "json" {
+				data, err := json.MarshalIndent(dossier, "", "  ")
+				if err != nil {
+					return fmt.Errorf("error marshaling dossier: %w", err)
+				}
+				return printResult(cmd, string(data), "dossier")
+			}
+
+			displayDossier(dossier, *flag, dir)
+			return copyResult(cmd, dossierMarkdown(dossier, *flag), "dossier")
+		},
+	}
+
+	config.AddExplainFlags(explainCmd)
+	addStabilityInfo(explainCmd)
+
+	return explainCmd
+}
+
+// explainRemoteState compares the flag with the flags last pulled from a target
+func explainRemoteState(name string, providerURL string, authToken string, flag flagset.Flag) explainTarget {
+	target := explainTarget{Name: name, ProviderURL: providerURL, Status: explainNotCached}
+	if providerURL == "" {
+		return target
+	}
+
+	remote, fetchedAt, ok := manifest.CachedFlags(providerURL, authToken)
+	if !ok {
+		return target
+	}
+	target.FetchedAt = &fetchedAt
+
+	var remoteFlag *flagset.Flag
+	target.Status, remoteFlag = compareRemoteFlag(flag, remote)
+	if remoteFlag != nil {
+		target.DefaultValue = remoteFlag.DefaultValue
+	}
+
+	return target
+}
+
+// compareRemoteFlag returns whether the flag is in sync with, differs from, or is missing from the
+// remote flags, along with the remote flag if it exists
+func compareRemoteFlag(flag flagset.Flag, remote *flagset.Flagset) (string, *flagset.Flag) {
+	for i := range remote.Flags {
+		remoteFlag := &remote.Flags[i]
+		if remoteFlag.Key != flag.Key {
+			continue
+		}
+
+		local, _ := json.Marshal(flag.DefaultValue)
+		remoteValue, _ := json.Marshal(remoteFlag.DefaultValue)
+		if remoteFlag.Type == flag.Type && bytes.Equal(local, remoteValue) {
+			return explainInSync, remoteFlag
+		}
+		return explainDiffers, remoteFlag
+	}
+	return explainMissing, nil
+}
+
+// explainHistory returns the git commits that added or removed the flag key from the manifest,
+// newest first. Returns no commits if git is unavailable or the manifest is not tracked.
+func explainHistory(manifestPath string, key string) []explainCommit {
+	commits := []explainCommit{}
+
+	out, err := exec.Command("git", "-C", filepath.Dir(manifestPath), "log",
+		"--format=%h%x1f%as%x1f%an%x1f%s", "-S", fmt.Sprintf("%q", key),
+		"--", filepath.Base(manifestPath)).Output()
+	if err != nil {
+		return commits
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		fields := strings.Split(line, "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		commits = append(commits, explainCommit{Hash: fields[0], Date: fields[1], Author: fields[2], Subject: fields[3]})
+	}
+	return commits
+}
+
+// sortedKeys returns the keys of the map in alphabetical order
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// displayDossier prints the dossier as readable sections. References to the flag link to their
+// files, relative to the scanned directory, in terminals that support hyperlinks.
+func displayDossier(dossier explainDossier, flag flagset.Flag, dir string) {
+	pterm.DefaultSection.Println(fmt.Sprintf("Flag %s", dossier.Key))
+	_ = pterm.DefaultTable.WithData(dossierDefinition(dossier, flag)).Render()
+
+	pterm.DefaultSection.WithLevel(2).Println("Targets")
+	if len(dossier.Targets) == 0 {
+		pterm.Info.Println("No targets defined in .openfeature.yaml")
+	} else {
+		_ = pterm.DefaultTable.WithHasHeader().WithData(dossierTargets(dossier)).Render()
+	}
+
+	pterm.DefaultSection.WithLevel(2).Println(fmt.Sprintf("Usage (%s)", display.count(len(dossier.Usage))))
+	for _, ref := range dossier.Usage {
+		pterm.Printf("  %s\n", terminal.FileLink(filepath.Join(dir, ref.File), ref.Line))
+	}
+	if len(dossier.Teams) > 0 {
+		pterm.Printf("  Teams: %s\n", strings.Join(dossier.Teams, ", "))
+	}
+
+	pterm.DefaultSection.WithLevel(2).Println(fmt.Sprintf("History (%s)", display.count(len(dossier.History))))
+	for _, commit := range dossier.History {
+		pterm.Printf("  %s\n", formatCommit(commit))
+	}
+}
+
+// dossierMarkdown formats the dossier as Markdown, to paste into issues and chats
+func dossierMarkdown(dossier explainDossier, flag flagset.Flag) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Flag `%s`\n\n", dossier.Key)
+	writeMarkdownTable(&b, append([][]string{{"Field", "Value"}}, dossierDefinition(dossier, flag)...))
+
+	b.WriteString("\n### Targets\n\n")
+	if len(dossier.Targets) == 0 {
+		b.WriteString("No targets defined in .openfeature.yaml\n")
+	} else {
+		writeMarkdownTable(&b, dossierTargets(dossier))
+	}
+
+	fmt.Fprintf(&b, "\n### Usage (%s)\n\n", display.count(len(dossier.Usage)))
+	for _, ref := range dossier.Usage {
+		fmt.Fprintf(&b, "- `%s:%d`\n", ref.File, ref.Line)
+	}
+	if len(dossier.Teams) > 0 {
+		fmt.Fprintf(&b, "\nTeams: %s\n", strings.Join(dossier.Teams, ", "))
+	}
+
+	fmt.Fprintf(&b, "\n### History (%s)\n\n", display.count(len(dossier.History)))
+	for _, commit := range dossier.History {
+		fmt.Fprintf(&b, "- %s\n", formatCommit(commit))
+	}
+	return b.String()
+}
+
+// dossierDefinition returns the rows of the definition of the flag
+func dossierDefinition(dossier explainDossier, flag flagset.Flag) [][]string {
+	definition := [][]string{
+		{"Manifest", dossier.Manifest},
+		{"Type", flag.Type.String()},
+		{"Description", flag.Description},
+		{"Default Value", formatValue(flag.DefaultValue)},
+	}
+	if dossier.Schedule != "" {
+		definition = append(definition, []string{"Active", dossier.Schedule})
+	}
+	if flag.KillSwitch {
+		definition = append(definition, []string{"Kill Switch", "yes"})
+	}
+	if flag.Risk != "" {
+		definition = append(definition, []string{"Risk", flag.Risk})
+	}
+	if flag.Experiment != nil {
+		definition = append(definition, []string{"Experiment", flag.Experiment.ID})
+	}
+	if flag.Compliance != nil && len(flag.Compliance.Regions) > 0 {
+		definition = append(definition, []string{"Regions", strings.Join(flag.Compliance.Regions, ", ")})
+	}
+	return definition
+}
+
+// dossierTargets returns the rows of the targets of the dossier, with a header
+func dossierTargets(dossier explainDossier) [][]string {
+	rows := [][]string{{"Target", "Status", "Default Value", "Fetched"}}
+	for _, target := range dossier.Targets {
+		fetched, value := "", ""
+		if target.FetchedAt != nil {
+			fetched = display.dateTime(*target.FetchedAt)
+		}
+		if target.DefaultValue != nil {
+			value = formatValue(target.DefaultValue)
+		}
+		rows = append(rows, []string{target.Name, target.Status, value, fetched})
+	}
+	return rows
+}
+
+// formatCommit formats a commit of the history of a flag for display
+func formatCommit(commit explainCommit) string {
+	date := commit.Date
+	if t, err := time.Parse(time.DateOnly, commit.Date); err == nil {
+		date = display.date(t)
+	}
+	return fmt.Sprintf("%s %s %s (%s)", commit.Hash, date, commit.Subject, commit.Author)
+}
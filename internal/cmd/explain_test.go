@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainCmd(t *testing.T) {
+	setupExplainTest := func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+		require.NoError(t, afero.WriteFile(fs, "src/checkout/cart.go", []byte(`if client.Boolean(ctx, "enableFeatureA", false, nil) {}`), 0o644))
+
+		setupConfigFileForTest(t, `
+targets:
+  staging:
+    provider-url: "https://flags.staging.example.com"
+  prod:
+    provider-url: "https://flags.example.com"
+    auth-token: "prod-token"
+  dev:
+    provider-url: "https://flags.dev.example.com"
+usage:
+  teams:
+    payments: ["checkout"]
+`)
+
+		cache := func(url string, token string, flags ...flagset.Flag) {
+			_, err := manifest.LoadWithCache(url, token, manifest.CacheOptions{TTL: time.Hour}, func() (*flagset.Flagset, error) {
+				return &flagset.Flagset{Flags: flags}, nil
+			})
+			require.NoError(t, err)
+		}
+		cache("https://flags.staging.example.com", "", flagset.Flag{Key: "enableFeatureA", Type: flagset.BoolType, DefaultValue: true})
+		cache("https://flags.example.com", "prod-token", flagset.Flag{Key: "enableFeatureA", Type: flagset.BoolType, DefaultValue: false})
+	}
+
+	t.Run("writes the dossier as JSON", func(t *testing.T) {
+		setupExplainTest(t)
+
+		cmd := GetExplainCmd()
+		config.AddRootFlags(cmd)
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{"enableFeatureA", "-m", "flags.json", "--dir", "src", "--output", "json"})
+		require.NoError(t, cmd.Execute())
+
+		var dossier explainDossier
+		require.NoError(t, json.Unmarshal(out.Bytes(), &dossier))
+
+		assert.Equal(t, "enableFeatureA", dossier.Key)
+		assert.Equal(t, "boolean", dossier.Definition["flagType"])
+		assert.Equal(t, false, dossier.Definition["defaultValue"])
+
+		require.Len(t, dossier.Targets, 3)
+		assert.Equal(t, "dev", dossier.Targets[0].Name)
+		assert.Equal(t, explainNotCached, dossier.Targets[0].Status)
+		assert.Equal(t, "prod", dossier.Targets[1].Name)
+		assert.Equal(t, explainInSync, dossier.Targets[1].Status)
+		assert.Equal(t, "staging", dossier.Targets[2].Name)
+		assert.Equal(t, explainDiffers, dossier.Targets[2].Status)
+		assert.Equal(t, true, dossier.Targets[2].DefaultValue)
+
+		require.Len(t, dossier.Usage, 1)
+		assert.Equal(t, "checkout/cart.go", dossier.Usage[0].File)
+		assert.Equal(t, []string{"payments"}, dossier.Teams)
+	})
+
+	t.Run("reports a flag missing from a target", func(t *testing.T) {
+		setupExplainTest(t)
+
+		cmd := GetExplainCmd()
+		config.AddRootFlags(cmd)
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{"usernameMaxLength", "-m", "flags.json", "--dir", "src", "--output", "json"})
+		require.NoError(t, cmd.Execute())
+
+		var dossier explainDossier
+		require.NoError(t, json.Unmarshal(out.Bytes(), &dossier))
+		assert.Equal(t, explainMissing, dossier.Targets[1].Status)
+		assert.Empty(t, dossier.Usage)
+	})
+
+	t.Run("fails for a flag that is not in the manifest", func(t *testing.T) {
+		setupExplainTest(t)
+
+		cmd := GetExplainCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"unknown-flag", "-m", "flags.json"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `flag "unknown-flag" not found in manifest`)
+	})
+
+	t.Run("formats the dossier as Markdown", func(t *testing.T) {
+		flag := flagset.Flag{Key: "new-checkout", Type: flagset.BoolType, Description: "New checkout | v2", DefaultValue: false}
+		dossier := explainDossier{
+			Key:      "new-checkout",
+			Manifest: "flags.json",
+			Targets:  []explainTarget{{Name: "prod", Status: explainInSync, DefaultValue: false}},
+			Usage:    []usage.Reference{{Key: "new-checkout", File: "checkout/cart.go", Line: 3}},
+			Teams:    []string{"payments"},
+			History:  []explainCommit{{Hash: "abc1234", Date: "2026-01-02", Author: "Ada", Subject: "Add new-checkout"}},
+		}
+
+		assert.Equal(t, "## Flag `new-checkout`\n"+
+			"\n"+
+			"| Field | Value |\n"+
+			"| --- | --- |\n"+
+			"| Manifest | flags.json |\n"+
+			"| Type | boolean |\n"+
+			"| Description | New checkout \\| v2 |\n"+
+			"| Default Value | false |\n"+
+			"\n### Targets\n\n"+
+			"| Target | Status | Default Value | Fetched |\n"+
+			"| --- | --- | --- | --- |\n"+
+			"| prod | in sync | false |  |\n"+
+			"\n### Usage (1)\n\n"+
+			"- `checkout/cart.go:3`\n"+
+			"\nTeams: payments\n"+
+			"\n### History (1)\n\n"+
+			"- abc1234 2026-01-02 Add new-checkout (Ada)\n",
+			dossierMarkdown(dossier, flag))
+	})
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/export"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/cobra"
+)
+
+func GetExportCmd() *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the flag manifest for use by other tools",
+		Long: `Export the flag manifest in a format consumed by other tools.
+
+Available formats:
+
+- cyclonedx - A standalone CycloneDX bill of materials declaring the flag inventory.
+              Each flag is listed with its type and a SHA-256 hash of its definition,
+              so release artifacts can declare which flags they were built against.`,
+		Example: `  # Attach the flag inventory to a release as a CycloneDX BOM
+  openfeature export --format cyclonedx --output flags.cdx.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "export")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			format := config.GetFormat(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			if !export.IsValidFormat(format) {
+				return fmt.Errorf("invalid export format: %q. Valid formats are: %s",
+					format, strings.Join(export.GetValidFormats(), ", "))
+			}
+
+			data, err := manifest.ReadData(manifestPath)
+			if err != nil {
+				return fmt.Errorf("error reading manifest %q: %w", manifestPath, err)
+			}
+
+			fs, err := manifest.ParseFlagSet(data)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+			if err := manifest.ResolveDefaultValueFiles(fs, filepath.Dir(manifestPath)); err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			content, err := export.Render(export.Format(format), fs, export.Options{
+				ManifestPath: manifestPath,
+				ManifestData: data,
+				ToolVersion:  Version,
+				Timestamp:    time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+
+			if outputPath == "" {
+				_, err := fmt.Fprintln(cmd.OutOrStdout(), string(content))
+				return err
+			}
+
+			if err := filesystem.WriteFile(outputPath, content); err != nil {
+				return fmt.Errorf("error writing %s export: %w", format, err)
+			}
+
+			logger.Default.FileCreated(outputPath)
+			return nil
+		},
+	}
+
+	config.AddExportFlags(exportCmd)
+	addStabilityInfo(exportCmd)
+
+	return exportCmd
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportCmd(t *testing.T) {
+	t.Run("writes a CycloneDX BOM", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+
+		cmd := GetExportCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"-m", "flags.json", "--format", "cyclonedx", "--output", "flags.cdx.json"})
+		require.NoError(t, cmd.Execute())
+
+		content, err := afero.ReadFile(fs, "flags.cdx.json")
+		require.NoError(t, err)
+
+		var bom map[string]any
+		require.NoError(t, json.Unmarshal(content, &bom))
+		assert.Equal(t, "CycloneDX", bom["bomFormat"])
+		assert.Contains(t, string(content), `"openfeature:flag:enableFeatureA:sha256"`)
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+
+		cmd := GetExportCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"-m", "flags.json", "--format", "spdx"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid export format: "spdx"`)
+	})
+}
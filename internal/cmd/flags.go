@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func GetFlagsCmd() *cobra.Command {
+	flagsCmd := &cobra.Command{
+		Use:   "flags",
+		Short: "Plan changes to the flags of remote providers",
+		Long:  `Commands for reviewing the flags of the remote providers of the named targets against the manifest.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceErrors:              true,
+		SilenceUsage:               true,
+		DisableSuggestions:         false,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	flagsCmd.AddCommand(GetFlagsPrunePlanCmd())
+
+	addStabilityInfo(flagsCmd)
+
+	return flagsCmd
+}
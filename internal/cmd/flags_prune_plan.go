@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetFlagsPrunePlanCmd() *cobra.Command {
+	prunePlanCmd := &cobra.Command{
+		Use:   "prune-plan",
+		Short: "Plan the deletion of remote flags that are not in the manifest",
+		Long: `Fetch the flags of every named target in .openfeature.yaml, compare them with the manifest,
+and write the remote flags that are not in the manifest to a reviewable plan file.
+
+The flags are grouped by target and by age, based on when the provider last updated them
+(the updatedAt or createdAt field of the remote flag, if the provider reports one).
+
+Nothing is deleted. Once the plan has been reviewed (and edited, if some flags must be kept),
+pass it to push with --prune to delete only the flags it lists:
+
+  openfeature push --target prod --prune --plan prune-plan.json`,
+		Example: `  # Write the plan to prune-plan.json
+  openfeature flags prune-plan
+
+  # Write the plan to a custom path
+  openfeature flags prune-plan --plan plans/cleanup.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "flags.prune-plan")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			planPath := config.GetPlanPath(cmd)
+
+			fs, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+
+			names := sortedKeys(v.GetStringMap("targets"))
+			if len(names) == 0 {
+				return fmt.Errorf("no targets defined in .openfeature.yaml")
+			}
+
+			now := time.Now()
+			plan := manifest.PrunePlan{CreatedAt: now.UTC(), Manifest: manifestPath, Targets: []manifest.PrunePlanTarget{}}
+			for _, name := range names {
+				prefix := "targets." + name + "."
+				providerURL := v.GetString(prefix + config.ProviderURLFlagName)
+				if providerURL == "" {
+					pterm.Warning.Printf("Skipping target %s: no provider-url\n", name)
+					continue
+				}
+
+				ctx, cancel := remoteContext(cmd)
+				remote, err := manifest.LoadFromSyncAPI(ctx, providerURL, v.GetString(prefix+config.AuthTokenFlagName))
+				cancel()
+				if err != nil {
+					return fmt.Errorf("error fetching flags of target %s: %w", name, timeoutError(cmd, err))
+				}
+
+				flags, err := manifest.PlanPrune(fs, remote, now)
+				if err != nil {
+					return fmt.Errorf("error comparing flags of target %s: %w", name, err)
+				}
+				plan.Targets = append(plan.Targets, manifest.PrunePlanTarget{Name: name, ProviderURL: providerURL, Flags: flags})
+			}
+
+			if err := manifest.WritePrunePlan(planPath, plan); err != nil {
+				return err
+			}
+
+			displayPrunePlan(plan, planPath)
+			return nil
+		},
+	}
+
+	config.AddFlagsPrunePlanFlags(prunePlanCmd)
+	addStabilityInfo(prunePlanCmd)
+
+	return prunePlanCmd
+}
+
+// displayPrunePlan prints the flags of the plan grouped by target and age
+func displayPrunePlan(plan manifest.PrunePlan, planPath string) {
+	total := 0
+	for _, target := range plan.Targets {
+		pterm.DefaultSection.Println(fmt.Sprintf("%s (%s)", target.Name, target.ProviderURL))
+		if len(target.Flags) == 0 {
+			pterm.Success.Println("No remote flags missing from the manifest")
+			continue
+		}
+		total += len(target.Flags)
+
+		for _, age := range []string{manifest.PruneAgeOld, manifest.PruneAgeStale, manifest.PruneAgeRecent, manifest.PruneAgeUnknown} {
+			tableData := pterm.TableData{{"Key", "Type", "Updated"}}
+			for _, flag := range target.Flags {
+				if flag.Age != age {
+					continue
+				}
+				updated := ""
+				if flag.UpdatedAt != nil {
+					updated = display.date(*flag.UpdatedAt)
+				}
+				tableData = append(tableData, []string{flag.Key, flag.Type, updated})
+			}
+			if len(tableData) == 1 {
+				continue
+			}
+			heading := fmt.Sprintf("Updated %s ago", age)
+			if age == manifest.PruneAgeUnknown {
+				heading = "Unknown age"
+			}
+			pterm.DefaultSection.WithLevel(2).Println(fmt.Sprintf("%s (%s)", heading, display.count(len(tableData)-1)))
+			_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		}
+	}
+
+	pterm.Info.Printf("Wrote a plan to delete %d remote flag(s) to %s. Review it, then run push with --prune --plan %s\n", total, planPath, planPath)
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagsPrunePlanCmd(t *testing.T) {
+	t.Run("writes the remote flags missing from the manifest per target", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+		setupConfigFileForTest(t, `
+targets:
+  prod:
+    provider-url: "https://flags.example.com"
+    auth-token: "prod-token"
+  staging:
+    provider-url: "https://flags.staging.example.com"
+`)
+		defer gock.Off()
+
+		gock.New("https://flags.example.com").
+			Get("/openfeature/v0/manifest").
+			MatchHeader("Authorization", "Bearer prod-token").
+			Reply(200).
+			JSON(map[string]any{
+				"flags": []map[string]any{
+					{"key": "enableFeatureA", "type": "boolean", "defaultValue": false},
+					{"key": "legacyCheckout", "type": "boolean", "defaultValue": true, "updatedAt": "2020-01-01T00:00:00Z"},
+				},
+			})
+		gock.New("https://flags.staging.example.com").
+			Get("/openfeature/v0/manifest").
+			Reply(200).
+			JSON(map[string]any{
+				"flags": []map[string]any{
+					{"key": "enableFeatureA", "type": "boolean", "defaultValue": false},
+				},
+			})
+
+		cmd := GetFlagsPrunePlanCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"-m", "flags.json", "--plan", "plan.json"})
+		require.NoError(t, cmd.Execute())
+		assert.True(t, gock.IsDone(), "Not all expected HTTP requests were made")
+
+		plan, err := manifest.LoadPrunePlan("plan.json")
+		require.NoError(t, err)
+		assert.Equal(t, "flags.json", plan.Manifest)
+		require.Len(t, plan.Targets, 2)
+
+		assert.Equal(t, "prod", plan.Targets[0].Name)
+		require.Len(t, plan.Targets[0].Flags, 1)
+		assert.Equal(t, "legacyCheckout", plan.Targets[0].Flags[0].Key)
+		assert.Equal(t, manifest.PruneAgeOld, plan.Targets[0].Flags[0].Age)
+
+		assert.Equal(t, "staging", plan.Targets[1].Name)
+		assert.Empty(t, plan.Targets[1].Flags)
+	})
+
+	t.Run("fails without targets", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+		setupConfigFileForTest(t, "")
+
+		cmd := GetFlagsPrunePlanCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"-m", "flags.json"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no targets defined")
+	})
+}
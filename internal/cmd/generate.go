@@ -1,18 +1,34 @@
 package cmd
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
 	"github.com/open-feature/cli/internal/generators"
 	"github.com/open-feature/cli/internal/generators/angular"
 	"github.com/open-feature/cli/internal/generators/csharp"
+	"github.com/open-feature/cli/internal/generators/custom"
+	"github.com/open-feature/cli/internal/generators/edge"
+	"github.com/open-feature/cli/internal/generators/env"
 	"github.com/open-feature/cli/internal/generators/golang"
 	"github.com/open-feature/cli/internal/generators/java"
 	"github.com/open-feature/cli/internal/generators/nestjs"
 	"github.com/open-feature/cli/internal/generators/nodejs"
 	"github.com/open-feature/cli/internal/generators/python"
 	"github.com/open-feature/cli/internal/generators/react"
+	"github.com/open-feature/cli/internal/generators/reactnative"
+	"github.com/open-feature/cli/internal/generators/snapshot"
+	"github.com/open-feature/cli/internal/generators/spreadsheet"
+	"github.com/open-feature/cli/internal/generators/vue"
 	"github.com/open-feature/cli/internal/logger"
 	"github.com/open-feature/cli/internal/manifest"
 	"github.com/spf13/cobra"
@@ -22,12 +38,47 @@ func GetGenerateCmd() *cobra.Command {
 	generateCmd := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate typesafe OpenFeature accessors.",
+		Long: `Generate typesafe OpenFeature accessors with one of the generators listed below.
+
+Without a generator, the targets listed in the generate block of .openfeature.yaml are generated
+in order, which regenerates every client of a polyglot repository in one run. A target is the name
+of a generator, or an object with the generator and the values of its command line flags:
+
+  generate:
+    targets:
+      - generator: go
+        output: ./services/flags
+        package-name: flags
+      - generator: react
+        output: ./web/src/flags
+      - csv
+
+The options of generate.<generator> blocks apply to the targets too. Without targets, the available
+generators are listed.`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			return initializeConfig(cmd, "generate")
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cmd.Println("Available generators:")
-			return generators.DefaultManager.PrintGeneratorsTable()
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+			var names []string
+			for _, subCmd := range cmd.Commands() {
+				names = append(names, subCmd.Name())
+			}
+			targets, err := loadGenerateTargets(v, names)
+			if err != nil {
+				return err
+			}
+
+			if len(targets) == 0 {
+				cmd.Println("Available generators:")
+				return generators.DefaultManager.PrintGeneratorsTable()
+			}
+			return withWatch(withCheck(func(cmd *cobra.Command, args []string) error {
+				return runGenerateTargets(cmd, targets)
+			}))(cmd, args)
 		},
 	}
 
@@ -38,12 +89,97 @@ func GetGenerateCmd() *cobra.Command {
 	for _, subCmd := range generators.DefaultManager.GetCommands() {
 		generateCmd.AddCommand(subCmd)
 	}
+	addWatchMode(generateCmd)
+	addCheckMode(generateCmd)
 
 	addStabilityInfo(generateCmd)
 
 	return generateCmd
 }
 
+// generateExcludeTagsConfigKey lists the tags of the flags to leave out of generated code,
+// under generate for all generators or under generate.<generator> for a single one
+const generateExcludeTagsConfigKey = "exclude-tags"
+
+// generateTemplateOverridesConfigKey maps template names to the templates replacing them,
+// under generate.<generator>
+const generateTemplateOverridesConfigKey = "template-overrides"
+
+// loadGenerateFlagSet loads the flagset from the manifest and applies the generate options to it.
+// It sets the provenance written in the headers of the generated files, the CLI version and the hash
+// of the manifest, and which flags are marked deprecated in params: the flags expiring within
+// --deprecate-within days of --deprecate-as-of, or all flags with an activeUntil date without it.
+func loadGenerateFlagSet[T any](cmd *cobra.Command, manifestPath string, params *generators.Params[T]) (*flagset.Flagset, error) {
+	fs, err := manifest.LoadFlagSet(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The hash covers the files the manifest includes, whose flags are generated too
+	files, err := manifest.Files(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	for _, file := range files {
+		data, err := filesystem.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading contents from file %q", file)
+		}
+		hash.Write(data)
+	}
+	params.Provenance = generators.Provenance{CLIVersion: Version, ManifestHash: hex.EncodeToString(hash.Sum(nil))}
+
+	deprecateWithin := config.GetDeprecateWithin(cmd)
+	if deprecateWithin < 0 {
+		return nil, fmt.Errorf("--%s must not be negative", config.DeprecateWithinFlagName)
+	}
+	params.Deprecation = generators.Deprecation{Window: time.Duration(deprecateWithin) * 24 * time.Hour}
+	if deprecateAsOf := config.GetDeprecateAsOf(cmd); deprecateAsOf != "" {
+		params.Deprecation.AsOf, err = time.Parse(time.DateOnly, deprecateAsOf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s %q: expected a date such as 2026-01-31", config.DeprecateAsOfFlagName, deprecateAsOf)
+		}
+	}
+
+	v, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	excludeTagsKey := "generate." + cmd.Name() + "." + generateExcludeTagsConfigKey
+	if !v.IsSet(excludeTagsKey) {
+		excludeTagsKey = "generate." + generateExcludeTagsConfigKey
+	}
+
+	return fs.ForCodegen(v.GetStringSlice(excludeTagsKey)).Localize(config.GetLocale(cmd)), nil
+}
+
+// generateTestSnapshot generates the snapshot provider that the tests generated with --with-tests run against
+func generateTestSnapshot[T any](fs *flagset.Flagset, generated *generators.Params[T], language string, goPackage string) error {
+	params := generators.Params[snapshot.Params]{
+		OutputPath:  generated.OutputPath,
+		Provenance:  generated.Provenance,
+		Deprecation: generated.Deprecation,
+		Custom:      snapshot.Params{Language: language, GoPackage: goPackage},
+	}
+	return snapshot.NewGenerator(fs).Generate(&params)
+}
+
+// loadTemplateOverrides returns the template overrides of the generate command, read from the
+// generate.<generator>.template-overrides block of the config file and from --template-override,
+// which takes precedence
+func loadTemplateOverrides(cmd *cobra.Command) (map[string]string, error) {
+	v, err := readConfigFile()
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := map[string]string{}
+	maps.Copy(overrides, v.GetStringMapString("generate."+cmd.Name()+"."+generateTemplateOverridesConfigKey))
+	maps.Copy(overrides, config.GetTemplateOverrides(cmd))
+	return overrides, nil
+}
+
 // addStabilityInfo adds stability information to the command's help template before "Usage:"
 func addStabilityInfo(cmd *cobra.Command) {
 	// Only modify commands that have a stability annotation
@@ -71,7 +207,11 @@ func getGenerateNodeJSCmd() *cobra.Command {
 	nodeJSCmd := &cobra.Command{
 		Use:   "nodejs",
 		Short: "Generate typesafe Node.js client.",
-		Long:  `Generate typesafe Node.js client compatible with the OpenFeature JavaScript Server SDK.`,
+		Long: `Generate typesafe Node.js client compatible with the OpenFeature JavaScript Server SDK.
+
+The client also runs on Deno and Bun. Use --runtime deno to import the SDK with an npm: specifier,
+which Deno resolves without a package.json. Bun resolves the same imports as Node.js, so
+--runtime bun generates the same output as node.`,
 		Annotations: map[string]string{
 			"stability": string(generators.Alpha),
 		},
@@ -82,15 +222,29 @@ func getGenerateNodeJSCmd() *cobra.Command {
 			manifestPath := config.GetManifestPath(cmd)
 			outputPath := config.GetOutputPath(cmd)
 			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
+			runtime := config.GetRuntime(cmd)
+
+			if !nodejs.IsValidRuntime(runtime) {
+				return fmt.Errorf("invalid runtime: %q. Valid runtimes are: node, deno, bun", runtime)
+			}
+			withTests := config.GetWithTests(cmd)
+			if withTests && runtime == nodejs.RuntimeDeno {
+				return fmt.Errorf("--with-tests is not supported with --runtime deno")
+			}
 
 			logger.Default.GenerationStarted("Node.js")
 
 			params := generators.Params[nodejs.Params]{
-				OutputPath:   outputPath,
-				TemplatePath: templatePath,
-				Custom:       nodejs.Params{},
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom:            nodejs.Params{Runtime: runtime, WithTests: withTests},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
 			if err != nil {
 				return err
 			}
@@ -101,6 +255,14 @@ func getGenerateNodeJSCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if withTests {
+				if err := generateTestSnapshot(flagset, &params, snapshot.LanguageNodeJS, ""); err != nil {
+					return err
+				}
+			}
+			if err := reportBundleSize(cmd, flagset, filepath.Join(outputPath, "openfeature.ts"), nodeJSTreeShakingNote); err != nil {
+				return err
+			}
 
 			logger.Default.GenerationComplete("Node.js")
 
@@ -108,6 +270,9 @@ func getGenerateNodeJSCmd() *cobra.Command {
 		},
 	}
 
+	config.AddNodeJSGenerateFlags(nodeJSCmd)
+	config.AddBundleReportFlags(nodeJSCmd)
+
 	addStabilityInfo(nodeJSCmd)
 
 	return nodeJSCmd
@@ -117,7 +282,11 @@ func getGenerateReactCmd() *cobra.Command {
 	reactCmd := &cobra.Command{
 		Use:   "react",
 		Short: "Generate typesafe React Hooks.",
-		Long:  `Generate typesafe React Hooks compatible with the OpenFeature React SDK.`,
+		Long: `Generate typesafe React Hooks compatible with the OpenFeature React SDK.
+
+With --suspense, the generated hooks suspend until the flags are ready. With --provider-wrapper, a
+typed OpenFeatureProvider is also generated, whose defaultValues replace the flag defaults of the
+hooks below it, e.g., with the values of the flags evaluated during server-side rendering.`,
 		Annotations: map[string]string{
 			"stability": string(generators.Alpha),
 		},
@@ -128,15 +297,23 @@ func getGenerateReactCmd() *cobra.Command {
 			manifestPath := config.GetManifestPath(cmd)
 			outputPath := config.GetOutputPath(cmd)
 			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
 
 			logger.Default.GenerationStarted("React")
 
 			params := generators.Params[react.Params]{
-				OutputPath:   outputPath,
-				TemplatePath: templatePath,
-				Custom:       react.Params{},
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom: react.Params{
+					Suspense:        config.GetSuspense(cmd),
+					ProviderWrapper: config.GetProviderWrapper(cmd),
+				},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
 			if err != nil {
 				return err
 			}
@@ -147,6 +324,9 @@ func getGenerateReactCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if err := reportBundleSize(cmd, flagset, filepath.Join(outputPath, "openfeature.ts"), reactTreeShakingNote); err != nil {
+				return err
+			}
 
 			logger.Default.GenerationComplete("React")
 
@@ -154,16 +334,411 @@ func getGenerateReactCmd() *cobra.Command {
 		},
 	}
 
+	config.AddReactGenerateFlags(reactCmd)
+	config.AddBundleReportFlags(reactCmd)
 	addStabilityInfo(reactCmd)
 
 	return reactCmd
 }
 
+func getGenerateReactNativeCmd() *cobra.Command {
+	reactNativeCmd := &cobra.Command{
+		Use:   "react-native",
+		Short: "Generate typesafe React Native Hooks.",
+		Long: `Generate typesafe React Hooks for React Native and Expo apps, compatible with the OpenFeature React SDK.
+
+Unlike the react output, the generated file has no web-only assumptions (such as the 'use client'
+directive or Suspense hooks) and uses no Node built-ins. It also provides helpers to save the
+evaluation context to AsyncStorage (or any storage with the same getItem and setItem methods)
+and restore it on the next launch.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.react-native")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationStarted("React Native")
+
+			params := generators.Params[reactnative.Params]{
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom:            reactnative.Params{},
+			}
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
+			if err != nil {
+				return err
+			}
+
+			generator := reactnative.NewGenerator(flagset)
+			logger.Default.Debug("Executing React Native generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("React Native")
+
+			return nil
+		},
+	}
+
+	addStabilityInfo(reactNativeCmd)
+
+	return reactNativeCmd
+}
+
+func getGenerateEdgeCmd() *cobra.Command {
+	edgeCmd := &cobra.Command{
+		Use:   "edge",
+		Short: "Generate a lightweight flag client for edge runtimes.",
+		Long: `Generate a dependency-free TypeScript flag client for edge runtimes such as Cloudflare Workers
+and Vercel Edge, where the OpenFeature SDKs rely on features that are not available.
+
+The client embeds a snapshot of the default values of the manifest. When it is created with the
+URL of an OFREP (OpenFeature Remote Evaluation Protocol) service, flags are evaluated remotely with
+fetch, falling back to the embedded defaults on errors and timeouts.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.edge")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationStarted("Edge")
+
+			params := generators.Params[edge.Params]{
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom:            edge.Params{},
+			}
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
+			if err != nil {
+				return err
+			}
+
+			generator := edge.NewGenerator(flagset)
+			logger.Default.Debug("Executing Edge generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Edge")
+
+			return nil
+		},
+	}
+
+	addStabilityInfo(edgeCmd)
+
+	return edgeCmd
+}
+
+func getGenerateSnapshotCmd() *cobra.Command {
+	snapshotCmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Generate an in-process provider seeded with the manifest defaults.",
+		Long: `Generate an in-process provider that evaluates each flag of the manifest to its default value,
+built on the in-memory provider of the OpenFeature SDK of the selected language:
+
+- go     - github.com/open-feature/go-sdk (memprovider)
+- nodejs - @openfeature/server-sdk
+- web    - @openfeature/web-sdk
+
+The provider needs no network access, so it can be registered in tests, in air-gapped deployments,
+or as the fallback of a multi-provider when the flag service is unreachable.`,
+		Example: `  # Generate a Go snapshot provider in the flags package
+  openfeature generate snapshot --language go --package-name flags
+
+  # Generate a snapshot provider for the Node.js server SDK
+  openfeature generate snapshot --language nodejs`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.snapshot")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
+			language := config.GetLanguage(cmd)
+
+			if !slices.Contains(snapshot.GetValidLanguages(), language) {
+				return fmt.Errorf("invalid language: %q. Valid languages are: %s", language, strings.Join(snapshot.GetValidLanguages(), ", "))
+			}
+
+			logger.Default.GenerationStarted("Snapshot")
+
+			params := generators.Params[snapshot.Params]{
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom: snapshot.Params{
+					Language:  language,
+					GoPackage: config.GetGoPackageName(cmd),
+				},
+			}
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
+			if err != nil {
+				return err
+			}
+
+			generator := snapshot.NewGenerator(flagset)
+			logger.Default.Debug("Executing Snapshot generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Snapshot")
+
+			return nil
+		},
+	}
+
+	config.AddSnapshotGenerateFlags(snapshotCmd)
+
+	addStabilityInfo(snapshotCmd)
+
+	return snapshotCmd
+}
+
+func getGenerateEnvCmd() *cobra.Command {
+	envCmd := &cobra.Command{
+		Use:   "env",
+		Short: "Generate a provider that resolves flags from environment variables.",
+		Long: `Generate a provider that resolves each flag of the manifest from an environment variable, and
+falls back to its default value in the manifest when the variable is not set:
+
+- go     - github.com/open-feature/go-sdk
+- nodejs - @openfeature/server-sdk
+
+The variable of a flag is --env-prefix followed by its key in SCREAMING_SNAKE_CASE, so the flag
+enableFeatureA is read from OPENFEATURE_FLAG_ENABLE_FEATURE_A. Booleans are read as true or false,
+numbers in decimal notation and objects as JSON. A value that can't be parsed is an evaluation error.
+
+The provider needs no flag service, so it suits scripts and CI jobs, where flags are set in the
+environment of the job.`,
+		Example: `  # Generate a Go provider in the flags package
+  openfeature generate env --language go --package-name flags
+
+  # Generate a provider for the Node.js server SDK, reading variables such as FLAG_ENABLE_FEATURE_A
+  openfeature generate env --language nodejs --env-prefix FLAG_`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.env")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
+			language := config.GetLanguage(cmd)
+
+			if !slices.Contains(env.GetValidLanguages(), language) {
+				return fmt.Errorf("invalid language: %q. Valid languages are: %s", language, strings.Join(env.GetValidLanguages(), ", "))
+			}
+
+			logger.Default.GenerationStarted("Env")
+
+			params := generators.Params[env.Params]{
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom: env.Params{
+					Language:  language,
+					GoPackage: config.GetGoPackageName(cmd),
+					Prefix:    config.GetEnvPrefix(cmd),
+				},
+			}
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
+			if err != nil {
+				return err
+			}
+
+			generator := env.NewGenerator(flagset)
+			logger.Default.Debug("Executing Env generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Env")
+
+			return nil
+		},
+	}
+
+	config.AddEnvGenerateFlags(envCmd)
+
+	addStabilityInfo(envCmd)
+
+	return envCmd
+}
+
+func getGenerateCustomCmd() *cobra.Command {
+	customCmd := &cobra.Command{
+		Use:   "custom",
+		Short: "Generate files from your own templates.",
+		Long: `Render every file of a template directory with Go text/template against the flags of the manifest,
+to generate bespoke artifacts such as configuration files, documentation or DSLs.
+
+Each template is written to the same relative path in the output directory, without its .tmpl
+extension. Templates receive the same data as the templates of the built-in generators:
+.Flagset.Flags is the list of flags, each with a Key, Type, Description and DefaultValue, along with
+the other fields of the manifest. The built-in template functions (such as ToPascal, ToCamel,
+ToScreamingSnake and Quote) and ToJSONString are available.`,
+		Example: `  # Render the templates in ./templates to ./generated
+  openfeature generate custom --template-dir ./templates --output ./generated
+
+  # A templates/flags.md.tmpl template
+  {{ range .Flagset.Flags }}- ` + "`{{ .Key }}`" + ` ({{ .Type }}): {{ .Description }}
+  {{ end }}`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.custom")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+			templateDir := config.GetTemplateDir(cmd)
+
+			if templateDir == "" {
+				return fmt.Errorf("template directory is required. Please provide --template-dir")
+			}
+			if config.GetTemplatePath(cmd) != "" {
+				return fmt.Errorf("--template is not supported by the custom generator, use --template-dir instead")
+			}
+			if len(config.GetTemplateOverrides(cmd)) > 0 {
+				return fmt.Errorf("--template-override is not supported by the custom generator, edit the templates of --template-dir instead")
+			}
+
+			logger.Default.GenerationStarted("Custom")
+
+			params := generators.Params[custom.Params]{
+				OutputPath: outputPath,
+				Custom:     custom.Params{TemplateDir: templateDir},
+			}
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
+			if err != nil {
+				return err
+			}
+
+			generator := custom.NewGenerator(flagset)
+			logger.Default.Debug("Executing Custom generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Custom")
+
+			return nil
+		},
+	}
+
+	config.AddCustomGenerateFlags(customCmd)
+
+	addStabilityInfo(customCmd)
+
+	return customCmd
+}
+
+func getGenerateVueCmd() *cobra.Command {
+	vueCmd := &cobra.Command{
+		Use:   "vue",
+		Short: "Generate typesafe Vue composables.",
+		Long: `Generate typesafe Vue 3 composables built on the OpenFeature web SDK.
+
+Each flag gets a composable (for example useMyFlag()) returning reactive refs for the value and
+evaluation details of the flag, which are updated when the provider configuration or the
+evaluation context changes.`,
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate.vue")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationStarted("Vue")
+
+			params := generators.Params[vue.Params]{
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom:            vue.Params{},
+			}
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
+			if err != nil {
+				return err
+			}
+
+			generator := vue.NewGenerator(flagset)
+			logger.Default.Debug("Executing Vue generator")
+			err = generator.Generate(&params)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.GenerationComplete("Vue")
+
+			return nil
+		},
+	}
+
+	addStabilityInfo(vueCmd)
+
+	return vueCmd
+}
+
 func GetGenerateNestJsCmd() *cobra.Command {
 	nestJsCmd := &cobra.Command{
 		Use:   "nestjs",
 		Short: "Generate typesafe NestJS decorators.",
-		Long:  `Generate typesafe NestJS decorators compatible with the OpenFeature NestJS SDK.`,
+		Long: `Generate typesafe NestJS decorators compatible with the OpenFeature NestJS SDK.
+
+With --flags-module, an openfeature-flags.module.ts file is also generated. It holds a FlagsModule
+providing an injectable FlagsService, and parameter decorators that take the key of a flag of the
+manifest, such as @BooleanFlag('my-flag').`,
 		Annotations: map[string]string{
 			"stability": string(generators.Alpha),
 		},
@@ -174,19 +749,26 @@ func GetGenerateNestJsCmd() *cobra.Command {
 			manifestPath := config.GetManifestPath(cmd)
 			outputPath := config.GetOutputPath(cmd)
 			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
 
 			logger.Default.GenerationStarted("NestJS")
 
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			nestjsParams := generators.Params[nestjs.Params]{
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom: nestjs.Params{
+					FlagsModule: config.GetFlagsModule(cmd),
+				},
+			}
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &nestjsParams)
 			if err != nil {
 				return err
 			}
 
-			nestjsParams := generators.Params[nestjs.Params]{
-				OutputPath:   outputPath,
-				TemplatePath: templatePath,
-				Custom:       nestjs.Params{},
-			}
 			nestjsGenerator := nestjs.NewGenerator(flagset)
 			logger.Default.Debug("Executing NestJS generator")
 			err = nestjsGenerator.Generate(&nestjsParams)
@@ -195,8 +777,10 @@ func GetGenerateNestJsCmd() *cobra.Command {
 			}
 
 			nodejsParams := generators.Params[nodejs.Params]{
-				OutputPath: outputPath,
-				Custom:     nodejs.Params{},
+				OutputPath:  outputPath,
+				Provenance:  nestjsParams.Provenance,
+				Deprecation: nestjsParams.Deprecation,
+				Custom:      nodejs.Params{},
 			}
 			nodeGenerator := nodejs.NewGenerator(flagset)
 			err = nodeGenerator.Generate(&nodejsParams)
@@ -210,6 +794,7 @@ func GetGenerateNestJsCmd() *cobra.Command {
 		},
 	}
 
+	config.AddNestJSGenerateFlags(nestJsCmd)
 	addStabilityInfo(nestJsCmd)
 
 	return nestJsCmd
@@ -231,17 +816,25 @@ func getGenerateCSharpCmd() *cobra.Command {
 			manifestPath := config.GetManifestPath(cmd)
 			outputPath := config.GetOutputPath(cmd)
 			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
 
 			logger.Default.GenerationStarted("C#")
 
 			params := generators.Params[csharp.Params]{
-				OutputPath:   outputPath,
-				TemplatePath: templatePath,
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
 				Custom: csharp.Params{
-					Namespace: namespace,
+					Namespace:    namespace,
+					DIExtensions: config.GetDIExtensions(cmd),
+					Nullable:     config.GetNullable(cmd),
+					XMLDocs:      config.GetXMLDocs(cmd),
 				},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
 			if err != nil {
 				return err
 			}
@@ -271,7 +864,11 @@ func getGenerateJavaCmd() *cobra.Command {
 	javaCmd := &cobra.Command{
 		Use:   "java",
 		Short: "Generate typesafe Java client.",
-		Long:  `Generate typesafe Java client compatible with the OpenFeature Java SDK.`,
+		Long: `Generate typesafe Java client compatible with the OpenFeature Java SDK.
+
+Use --spring-boot to also generate OpenFeatureConfiguration.java, a Spring @Configuration that
+registers the OpenFeature client and the generated client as beans, and Flags.java, a record of
+typed accessors that can be injected into Spring components (requires Java 17).`,
 		Annotations: map[string]string{
 			"stability": string(generators.Alpha),
 		},
@@ -283,18 +880,24 @@ func getGenerateJavaCmd() *cobra.Command {
 			javaPackageName := config.GetJavaPackageName(cmd)
 			outputPath := config.GetOutputPath(cmd)
 			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
 
 			logger.Default.GenerationStarted("Java")
 
 			params := generators.Params[java.Params]{
-				OutputPath:   outputPath,
-				TemplatePath: templatePath,
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
 				Custom: java.Params{
 					JavaPackage: javaPackageName,
+					SpringBoot:  config.GetSpringBoot(cmd),
 				},
 			}
 
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
 			if err != nil {
 				return err
 			}
@@ -324,7 +927,19 @@ func getGenerateGoCmd() *cobra.Command {
 	goCmd := &cobra.Command{
 		Use:   "go",
 		Short: "Generate typesafe accessors for OpenFeature.",
-		Long:  `Generate typesafe accessors compatible with the OpenFeature Go SDK.`,
+		Long: `Generate typesafe accessors compatible with the OpenFeature Go SDK.
+
+With --otel-hooks, the generated client is instrumented out of the box with the OpenTelemetry
+tracing and metrics hooks (github.com/open-feature/go-sdk-contrib/hooks/open-telemetry) and the
+logging hook of the Go SDK. The hooks report to the global OpenTelemetry providers.
+
+With --constants-only, no client is generated. Each flag key is a constant of a key type per flag
+type (BooleanKey, StringKey, IntKey, FloatKey, ObjectKey), and Flags lists the key and type of every
+flag. This gives compile-time key safety to teams that evaluate flags with their own wrapper, e.g.:
+
+  func Bool(ctx context.Context, key flags.BooleanKey, defaultValue bool) bool {
+      return client.Boolean(ctx, string(key), defaultValue, openfeature.TransactionContext(ctx))
+  }`,
 		Annotations: map[string]string{
 			"stability": string(generators.Alpha),
 		},
@@ -336,19 +951,34 @@ func getGenerateGoCmd() *cobra.Command {
 			manifestPath := config.GetManifestPath(cmd)
 			outputPath := config.GetOutputPath(cmd)
 			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
+			constantsOnly := config.GetConstantsOnly(cmd)
+			if constantsOnly && config.GetGoOTelHooks(cmd) {
+				return fmt.Errorf("--%s is not supported with --%s", config.GoOTelHooksFlagName, config.ConstantsOnlyFlagName)
+			}
+			if constantsOnly && config.GetWithTests(cmd) {
+				return fmt.Errorf("--%s is not supported with --%s", config.WithTestsFlagName, config.ConstantsOnlyFlagName)
+			}
 
 			logger.Default.GenerationStarted("Go")
 
 			params := generators.Params[golang.Params]{
-				OutputPath:   outputPath,
-				TemplatePath: templatePath,
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
 				Custom: golang.Params{
-					GoPackage:  goPackageName,
-					CLIVersion: Version,
+					GoPackage:     goPackageName,
+					CLIVersion:    Version,
+					OTelHooks:     config.GetGoOTelHooks(cmd),
+					WithTests:     config.GetWithTests(cmd),
+					ConstantsOnly: constantsOnly,
 				},
 			}
 
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
 			if err != nil {
 				return err
 			}
@@ -359,6 +989,11 @@ func getGenerateGoCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if params.Custom.WithTests {
+				if err := generateTestSnapshot(flagset, &params, snapshot.LanguageGo, goPackageName); err != nil {
+					return err
+				}
+			}
 
 			logger.Default.GenerationComplete("Go")
 
@@ -378,7 +1013,12 @@ func getGeneratePythonCmd() *cobra.Command {
 	pythonCmd := &cobra.Command{
 		Use:   "python",
 		Short: "Generate typesafe Python client.",
-		Long:  `Generate typesafe Python client compatible with the OpenFeature Python SDK.`,
+		Long: `Generate typesafe Python client compatible with the OpenFeature Python SDK.
+
+Object flags evaluate to dicts. Use --models dataclass or --models pydantic to generate classes for
+their values instead, with fields inferred from their default values. Use --stubs to also generate
+openfeature.pyi, a type stub that types the values of object flags as TypedDicts if no models are
+generated.`,
 		Annotations: map[string]string{
 			"stability": string(generators.Alpha),
 		},
@@ -386,15 +1026,28 @@ func getGeneratePythonCmd() *cobra.Command {
 			manifestPath := config.GetManifestPath(cmd)
 			outputPath := config.GetOutputPath(cmd)
 			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
+
+			models := config.GetModels(cmd)
+			if !python.IsValidModels(models) {
+				return fmt.Errorf("invalid models: %q. Valid models are: %s, %s", models, python.ModelsDataclass, python.ModelsPydantic)
+			}
 
 			logger.Default.GenerationStarted("Python")
 
 			params := generators.Params[python.Params]{
-				OutputPath:   outputPath,
-				TemplatePath: templatePath,
-				Custom:       python.Params{},
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom: python.Params{
+					Models: models,
+					Stubs:  config.GetStubs(cmd),
+				},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
 			if err != nil {
 				return err
 			}
@@ -412,6 +1065,8 @@ func getGeneratePythonCmd() *cobra.Command {
 		},
 	}
 
+	config.AddPythonGenerateFlags(pythonCmd)
+
 	addStabilityInfo(pythonCmd)
 
 	return pythonCmd
@@ -421,7 +1076,12 @@ func getGenerateAngularCmd() *cobra.Command {
 	angularCmd := &cobra.Command{
 		Use:   "angular",
 		Short: "Generate typesafe Angular services and directives.",
-		Long:  `Generate typesafe Angular services and directives compatible with the OpenFeature Angular SDK.`,
+		Long: `Generate typesafe Angular services and directives compatible with the OpenFeature Angular SDK.
+
+The generated service exposes an Observable of the evaluation details of each flag and is
+provided in root, so it can be injected anywhere. The generated directives are standalone and
+can be imported directly into standalone components. Use --ng-module to also generate an
+NgModule exporting the directives, for applications that declare their components in modules.`,
 		Annotations: map[string]string{
 			"stability": string(generators.Alpha),
 		},
@@ -432,15 +1092,22 @@ func getGenerateAngularCmd() *cobra.Command {
 			manifestPath := config.GetManifestPath(cmd)
 			outputPath := config.GetOutputPath(cmd)
 			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
 
 			logger.Default.GenerationStarted("Angular")
 
 			params := generators.Params[angular.Params]{
-				OutputPath:   outputPath,
-				TemplatePath: templatePath,
-				Custom:       angular.Params{},
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom: angular.Params{
+					NgModule: config.GetNgModule(cmd),
+				},
 			}
-			flagset, err := manifest.LoadFlagSet(manifestPath)
+			flagset, err := loadGenerateFlagSet(cmd, manifestPath, &params)
 			if err != nil {
 				return err
 			}
@@ -458,19 +1125,91 @@ func getGenerateAngularCmd() *cobra.Command {
 		},
 	}
 
+	config.AddAngularGenerateFlags(angularCmd)
 	addStabilityInfo(angularCmd)
 
 	return angularCmd
 }
 
+func getGenerateCSVCmd() *cobra.Command {
+	return newGenerateSpreadsheetCmd(spreadsheet.FormatCSV, "CSV", "a CSV file")
+}
+
+func getGenerateXLSXCmd() *cobra.Command {
+	return newGenerateSpreadsheetCmd(spreadsheet.FormatXLSX, "Excel", "an Excel workbook")
+}
+
+// newGenerateSpreadsheetCmd returns the command exporting the flag inventory as a spreadsheet in
+// the given format, described for the help text (e.g., "a CSV file")
+func newGenerateSpreadsheetCmd(format string, name string, description string) *cobra.Command {
+	spreadsheetCmd := &cobra.Command{
+		Use:   format,
+		Short: fmt.Sprintf("Export the flag inventory as %s.", description),
+		Long: fmt.Sprintf(`Export the flag inventory as %s (flags.%s) for product managers and audits.
+
+Each flag is a row with its type, description, default value, initiative, tags, visibility,
+kill switch, activation window, experiment, compliance and cache settings, followed by a
+metadata.<key> column for each metadata key of the manifest. Unlike the code generators, the
+inventory lists every flag, including the flags left out of code generation.
+
+The template renders CSV rows, so a custom template or overrides of its "header" and "row"
+templates apply to both the CSV and the Excel export.`, description, format),
+		Annotations: map[string]string{
+			"stability": string(generators.Alpha),
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "generate."+format)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+			templatePath := config.GetTemplatePath(cmd)
+			templateOverrides, err := loadTemplateOverrides(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger.Default.Info(fmt.Sprintf("Exporting the flag inventory as %s", description))
+
+			params := generators.Params[spreadsheet.Params]{
+				OutputPath:        outputPath,
+				TemplatePath:      templatePath,
+				TemplateOverrides: templateOverrides,
+				Custom:            spreadsheet.Params{Format: format},
+			}
+			// The inventory lists every flag, so the flags are not filtered for code generation
+			flagset, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return err
+			}
+
+			generator := spreadsheet.NewGenerator(flagset.Localize(config.GetLocale(cmd)))
+			logger.Default.Debug(fmt.Sprintf("Executing %s generator", name))
+			return generator.Generate(&params)
+		},
+	}
+
+	addStabilityInfo(spreadsheetCmd)
+
+	return spreadsheetCmd
+}
+
 func init() {
 	// Register generators with the manager
 	generators.DefaultManager.Register(getGenerateAngularCmd)
 	generators.DefaultManager.Register(getGenerateReactCmd)
+	generators.DefaultManager.Register(getGenerateReactNativeCmd)
+	generators.DefaultManager.Register(getGenerateVueCmd)
 	generators.DefaultManager.Register(getGenerateGoCmd)
 	generators.DefaultManager.Register(getGenerateNodeJSCmd)
+	generators.DefaultManager.Register(getGenerateEdgeCmd)
 	generators.DefaultManager.Register(getGeneratePythonCmd)
 	generators.DefaultManager.Register(getGenerateCSharpCmd)
 	generators.DefaultManager.Register(GetGenerateNestJsCmd)
 	generators.DefaultManager.Register(getGenerateJavaCmd)
+	generators.DefaultManager.Register(getGenerateSnapshotCmd)
+	generators.DefaultManager.Register(getGenerateEnvCmd)
+	generators.DefaultManager.Register(getGenerateCSVCmd)
+	generators.DefaultManager.Register(getGenerateXLSXCmd)
+	generators.DefaultManager.Register(getGenerateCustomCmd)
 }
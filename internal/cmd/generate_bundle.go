@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/pterm/pterm"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+// Tree shaking notes of the web generators, shown with the bundle size report
+const (
+	reactTreeShakingNote = "Each flag is a separate hook export, so bundlers that tree-shake ES modules drop the hooks " +
+		"that are not imported. FlagKeys holds the key of every flag and is kept whole once imported."
+	nodeJSTreeShakingNote = "The generated client object holds every flag, so no flag is tree-shaken once the client is imported. " +
+		"Types and comments are removed by the bundler."
+)
+
+// reportBundleSize warns about object flags whose default value is larger than --max-default-size and,
+// with --bundle-report, prints the estimated bundle size of the generated file
+func reportBundleSize(cmd *cobra.Command, fs *flagset.Flagset, path string, treeShakingNote string) error {
+	output, err := afero.ReadFile(filesystem.FileSystem(), path)
+	if err != nil {
+		return fmt.Errorf("error reading generated file %s: %w", path, err)
+	}
+	report := generators.EstimateBundleSize(fs, output)
+
+	if maxSize := config.GetMaxDefaultSize(cmd); maxSize > 0 {
+		for _, flag := range report.Flags {
+			if flag.Type == flagset.ObjectType && flag.DefaultBytes > maxSize {
+				logger.Default.Warning(fmt.Sprintf("Flag '%s' has an object default value of %d bytes, larger than the %d bytes allowed by --%s",
+					flag.Key, flag.DefaultBytes, maxSize, config.MaxDefaultSizeFlagName))
+			}
+		}
+	}
+
+	if !config.GetBundleReport(cmd) {
+		return nil
+	}
+
+	pterm.DefaultSection.Println("Estimated bundle size")
+	tableData := pterm.TableData{{"Flag", "Type", "Default (bytes)", "Code (bytes)"}}
+	for _, flag := range report.Flags {
+		tableData = append(tableData, []string{flag.Key, flag.Type.String(), display.count(flag.DefaultBytes), display.count(flag.CodeBytes)})
+	}
+	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+	pterm.Printf("Total: %s bytes (%s bytes gzipped), before minification\n", display.count(report.Bytes), display.count(report.GzipBytes))
+	pterm.Info.Println(treeShakingNote)
+	return nil
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// generateTargetsConfigKey lists the generators that generate runs without arguments, in order
+const generateTargetsConfigKey = "generate.targets"
+
+// generateTargetGeneratorKey is the key of the generator of a target that has options
+const generateTargetGeneratorKey = "generator"
+
+// generateTarget is a generator run by generate without arguments, with its command line flags
+type generateTarget struct {
+	Generator string
+	Options   map[string]any
+}
+
+// loadGenerateTargets reads the targets of the generate block of the config. A target is either the
+// name of a generator, or an object with the generator and the values of its command line flags:
+//
+//	generate:
+//	  targets:
+//	    - csv
+//	    - generator: go
+//	      output: ./services/flags
+//	      package-name: flags
+func loadGenerateTargets(v *viper.Viper, generators []string) ([]generateTarget, error) {
+	if !v.IsSet(generateTargetsConfigKey) {
+		return nil, nil
+	}
+
+	items, ok := v.Get(generateTargetsConfigKey).([]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s in config: expected a list of generators", generateTargetsConfigKey)
+	}
+
+	targets := make([]generateTarget, 0, len(items))
+	for i, item := range items {
+		var target generateTarget
+		switch value := item.(type) {
+		case string:
+			target.Generator = value
+		case map[string]any:
+			target.Generator, _ = value[generateTargetGeneratorKey].(string)
+			target.Options = maps.Clone(value)
+			delete(target.Options, generateTargetGeneratorKey)
+		default:
+			return nil, fmt.Errorf("invalid target %d in %s of config: expected a generator or an object with a %s",
+				i+1, generateTargetsConfigKey, generateTargetGeneratorKey)
+		}
+
+		if !slices.Contains(generators, target.Generator) {
+			return nil, fmt.Errorf("invalid target %d in %s of config: unknown generator %q. Valid generators are: %s",
+				i+1, generateTargetsConfigKey, target.Generator, strings.Join(generators, ", "))
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// args returns the command line arguments of the target, with its options sorted by name so the
+// generation is the same on every run
+func (t generateTarget) args() []string {
+	args := []string{t.Generator}
+	for _, name := range slices.Sorted(maps.Keys(t.Options)) {
+		switch value := t.Options[name].(type) {
+		case []any:
+			for _, item := range value {
+				args = append(args, fmt.Sprintf("--%s=%v", name, item))
+			}
+		case map[string]any:
+			for _, key := range slices.Sorted(maps.Keys(value)) {
+				args = append(args, fmt.Sprintf("--%s=%s=%v", name, key, value[key]))
+			}
+		default:
+			args = append(args, fmt.Sprintf("--%s=%v", name, value))
+		}
+	}
+	return args
+}
+
+// runGenerateTargets runs the generator of each target in order, stopping at the first that fails.
+// Each generator runs as its own command, with the global flags of the generate command, so the
+// config blocks, hooks and defaults apply as if it was run on its own.
+func runGenerateTargets(cmd *cobra.Command, targets []generateTarget) error {
+	var global []string
+	cmd.InheritedFlags().Visit(func(f *pflag.Flag) {
+		global = append(global, fmt.Sprintf("--%s=%s", f.Name, f.Value.String()))
+	})
+	// The targets skip their post hooks when checked
+	if config.GetCheck(cmd) {
+		global = append(global, "--"+config.CheckFlagName)
+	}
+
+	for i, target := range targets {
+		logger.Default.Info(fmt.Sprintf("Generating target %d of %d: %s", i+1, len(targets), target.Generator))
+
+		rootCmd := GetRootCmd()
+		rootCmd.SetOut(cmd.OutOrStdout())
+		rootCmd.SetErr(cmd.ErrOrStderr())
+		rootCmd.SetArgs(append(append([]string{"generate"}, target.args()...), global...))
+		if err := rootCmd.ExecuteContext(cmd.Context()); err != nil {
+			return fmt.Errorf("target %d (%s) failed: %w", i+1, target.Generator, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadGenerateTargets(t *testing.T) {
+	generators := []string{"csv", "go", "react"}
+	load := func(t *testing.T, yaml string) ([]generateTarget, error) {
+		v := viper.New()
+		v.SetConfigType("yaml")
+		require.NoError(t, v.ReadConfig(strings.NewReader(yaml)))
+		return loadGenerateTargets(v, generators)
+	}
+
+	t.Run("names and objects", func(t *testing.T) {
+		targets, err := load(t, `
+generate:
+  targets:
+    - generator: go
+      package-name: flags
+      output: ./services/flags
+    - csv
+    - generator: react
+      template-override: {provider: ./provider.tmpl, hook: ./hook.tmpl}
+`)
+		require.NoError(t, err)
+		require.Len(t, targets, 3)
+		assert.Equal(t, []string{"go", "--output=./services/flags", "--package-name=flags"}, targets[0].args())
+		assert.Equal(t, []string{"csv"}, targets[1].args())
+		assert.Equal(t, []string{"react", "--template-override=hook=./hook.tmpl", "--template-override=provider=./provider.tmpl"}, targets[2].args())
+	})
+
+	t.Run("no targets", func(t *testing.T) {
+		targets, err := load(t, "generate:\n  go:\n    package-name: flags\n")
+		require.NoError(t, err)
+		assert.Empty(t, targets)
+	})
+
+	t.Run("unknown generator", func(t *testing.T) {
+		_, err := load(t, "generate:\n  targets: [go, docs]\n")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid target 2 in generate.targets of config: unknown generator "docs"`)
+	})
+
+	t.Run("not a list", func(t *testing.T) {
+		_, err := load(t, "generate:\n  targets: go\n")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "expected a list of generators")
+	})
+}
+
+func TestGenerateTargets(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+
+	setupConfigFileForTest(t, `
+generate:
+  targets:
+    - generator: go
+      output: services/flags
+      package-name: flags
+    - generator: nodejs
+      output: web/flags
+`)
+
+	cmd := GetRootCmd()
+	cmd.SetArgs([]string{"generate", "-m", "flags.json"})
+	require.NoError(t, cmd.Execute())
+
+	content, err := afero.ReadFile(fs, "services/flags/flags_gen.go")
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "package flags")
+
+	exists, err := afero.Exists(fs, "web/flags/openfeature.ts")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
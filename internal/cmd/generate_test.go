@@ -1,27 +1,40 @@
 package cmd
 
 import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/h2non/gock"
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/pkg/generate"
+	"github.com/pterm/pterm"
 	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // generateTestCase holds the configuration for each generate test
 type generateTestCase struct {
-	name           string // test case name
-	command        string // generator to run
-	manifestGolden string // path to the golden manifest file
-	outputGolden   string // path to the golden output file
-	outputPath     string // output directory (optional, defaults to "output")
-	outputFile     string // output file name
-	packageName    string // optional, used for Go (package-name), Java (package-name) and C# (namespace)
-	templateFile   string // optional, path to a custom template file
+	name           string   // test case name
+	command        string   // generator to run
+	manifestGolden string   // path to the golden manifest file
+	outputGolden   string   // path to the golden output file
+	outputPath     string   // output directory (optional, defaults to "output")
+	outputFile     string   // output file name
+	packageName    string   // optional, used for Go and snapshot (package-name), Java (package-name) and C# (namespace)
+	templateFile   string   // optional, path to a custom template file
+	locale         string   // optional, locale of the flag descriptions
+	extraArgs      []string // optional, generator specific arguments
 }
 
 func TestGenerate(t *testing.T) {
@@ -33,6 +46,14 @@ func TestGenerate(t *testing.T) {
 			outputGolden:   "testdata/success_angular.golden",
 			outputFile:     "openfeature.generated.ts",
 		},
+		{
+			name:           "Angular generation with NgModule",
+			command:        "angular",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_angular_ngmodule.golden",
+			outputFile:     "openfeature.generated.ts",
+			extraArgs:      []string{"--ng-module"},
+		},
 		{
 			name:           "Go generation success",
 			command:        "go",
@@ -41,6 +62,33 @@ func TestGenerate(t *testing.T) {
 			outputFile:     "testpackage_gen.go",
 			packageName:    "testpackage",
 		},
+		{
+			name:           "Go generation with OpenTelemetry hooks",
+			command:        "go",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_go_otel.golden",
+			outputFile:     "testpackage_gen.go",
+			packageName:    "testpackage",
+			extraArgs:      []string{"--otel-hooks"},
+		},
+		{
+			name:           "Go generation of constants only",
+			command:        "go",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_go_constants.golden",
+			outputFile:     "testpackage_gen.go",
+			packageName:    "testpackage",
+			extraArgs:      []string{"--constants-only"},
+		},
+		{
+			name:           "Go generation with tests",
+			command:        "go",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_go_test.golden",
+			outputFile:     "testpackage_gen_test.go",
+			packageName:    "testpackage",
+			extraArgs:      []string{"--with-tests"},
+		},
 		{
 			name:           "React generation success",
 			command:        "react",
@@ -48,6 +96,36 @@ func TestGenerate(t *testing.T) {
 			outputGolden:   "testdata/success_react.golden",
 			outputFile:     "openfeature.ts",
 		},
+		{
+			name:           "React generation with suspense",
+			command:        "react",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_react_suspense.golden",
+			outputFile:     "openfeature.ts",
+			extraArgs:      []string{"--suspense"},
+		},
+		{
+			name:           "React generation with provider wrapper",
+			command:        "react",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_react_provider.golden",
+			outputFile:     "openfeature.ts",
+			extraArgs:      []string{"--provider-wrapper"},
+		},
+		{
+			name:           "React Native generation success",
+			command:        "react-native",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_react_native.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "Vue generation success",
+			command:        "vue",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_vue.golden",
+			outputFile:     "openfeature.ts",
+		},
 		{
 			name:           "NodeJS generation success",
 			command:        "nodejs",
@@ -55,6 +133,21 @@ func TestGenerate(t *testing.T) {
 			outputGolden:   "testdata/success_nodejs.golden",
 			outputFile:     "openfeature.ts",
 		},
+		{
+			name:           "NodeJS generation for Deno",
+			command:        "nodejs",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_nodejs_deno.golden",
+			outputFile:     "openfeature.ts",
+			extraArgs:      []string{"--runtime", "deno"},
+		},
+		{
+			name:           "Edge generation success",
+			command:        "edge",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_edge.golden",
+			outputFile:     "openfeature.edge.ts",
+		},
 		{
 			name:           "NestJS generation success",
 			command:        "nestjs",
@@ -62,6 +155,14 @@ func TestGenerate(t *testing.T) {
 			outputGolden:   "testdata/success_nestjs.golden",
 			outputFile:     "openfeature-decorators.ts",
 		},
+		{
+			name:           "NestJS generation with flags module",
+			command:        "nestjs",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_nestjs_flags_module.golden",
+			outputFile:     "openfeature-flags.module.ts",
+			extraArgs:      []string{"--flags-module"},
+		},
 		{
 			name:           "Python generation success",
 			command:        "python",
@@ -69,6 +170,30 @@ func TestGenerate(t *testing.T) {
 			outputGolden:   "testdata/success_python.golden",
 			outputFile:     "openfeature.py",
 		},
+		{
+			name:           "Python generation with dataclass models",
+			command:        "python",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_python_dataclass.golden",
+			outputFile:     "openfeature.py",
+			extraArgs:      []string{"--models", "dataclass"},
+		},
+		{
+			name:           "Python generation with pydantic models",
+			command:        "python",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_python_pydantic.golden",
+			outputFile:     "openfeature.py",
+			extraArgs:      []string{"--models", "pydantic"},
+		},
+		{
+			name:           "Python generation with type stubs",
+			command:        "python",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_python_stubs.golden",
+			outputFile:     "openfeature.pyi",
+			extraArgs:      []string{"--stubs"},
+		},
 		{
 			name:           "CSharp generation success",
 			command:        "csharp",
@@ -77,6 +202,15 @@ func TestGenerate(t *testing.T) {
 			outputFile:     "OpenFeature.g.cs",
 			packageName:    "TestNamespace", // Using packageName field for namespace
 		},
+		{
+			name:           "CSharp generation without DI extensions, nullable annotations and XML docs",
+			command:        "csharp",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_csharp_minimal.golden",
+			outputFile:     "OpenFeature.g.cs",
+			packageName:    "TestNamespace",
+			extraArgs:      []string{"--di-extensions=false", "--nullable=false", "--xml-docs=false"},
+		},
 		{
 			name:           "Java generation success",
 			command:        "java",
@@ -85,6 +219,58 @@ func TestGenerate(t *testing.T) {
 			outputFile:     "OpenFeature.java",
 			packageName:    "com.example.openfeature",
 		},
+		{
+			name:           "Java generation with Spring Boot configuration",
+			command:        "java",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_java_spring_configuration.golden",
+			outputFile:     "OpenFeatureConfiguration.java",
+			packageName:    "com.example.openfeature",
+			extraArgs:      []string{"--spring-boot"},
+		},
+		{
+			name:           "Java generation with Spring Boot flags record",
+			command:        "java",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_java_flags_record.golden",
+			outputFile:     "Flags.java",
+			packageName:    "com.example.openfeature",
+			extraArgs:      []string{"--spring-boot"},
+		},
+		{
+			name:           "Go snapshot generation success",
+			command:        "snapshot",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_snapshot_go.golden",
+			outputFile:     "testpackage_snapshot.go",
+			packageName:    "testpackage",
+			extraArgs:      []string{"--language", "go"},
+		},
+		{
+			name:           "Node.js snapshot generation success",
+			command:        "snapshot",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_snapshot_nodejs.golden",
+			outputFile:     "openfeature.snapshot.ts",
+			extraArgs:      []string{"--language", "nodejs"},
+		},
+		{
+			name:           "Go env provider generation success",
+			command:        "env",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_env_go.golden",
+			outputFile:     "testpackage_env.go",
+			packageName:    "testpackage",
+			extraArgs:      []string{"--language", "go"},
+		},
+		{
+			name:           "Node.js env provider generation with a custom prefix",
+			command:        "env",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_env_nodejs.golden",
+			outputFile:     "openfeature.env.ts",
+			extraArgs:      []string{"--language", "nodejs", "--env-prefix", "FLAG_"},
+		},
 		{
 			name:           "Angular generation with custom template",
 			command:        "angular",
@@ -152,6 +338,37 @@ func TestGenerate(t *testing.T) {
 			outputFile:     "openfeature-decorators.ts",
 			templateFile:   "testdata/custom_template/custom_nestjs.tmpl",
 		},
+		{
+			name:           "Go generation with localized descriptions",
+			command:        "go",
+			manifestGolden: "testdata/localized_manifest.golden",
+			outputGolden:   "testdata/localized_go.golden",
+			outputFile:     "testpackage_gen.go",
+			packageName:    "testpackage",
+			locale:         "de-AT",
+		},
+		{
+			name:           "NodeJS generation with tests",
+			command:        "nodejs",
+			manifestGolden: "testdata/success_manifest.golden",
+			outputGolden:   "testdata/success_nodejs_test.golden",
+			outputFile:     "openfeature.test.ts",
+			extraArgs:      []string{"--with-tests"},
+		},
+		{
+			name:           "NodeJS generation with cache hints",
+			command:        "nodejs",
+			manifestGolden: "testdata/cache_manifest.golden",
+			outputGolden:   "testdata/cache_nodejs.golden",
+			outputFile:     "openfeature.ts",
+		},
+		{
+			name:           "CSV inventory generation success",
+			command:        "csv",
+			manifestGolden: "testdata/inventory_manifest.golden",
+			outputGolden:   "testdata/success_csv.golden",
+			outputFile:     "flags.csv",
+		},
 		// Add more test cases here as needed
 	}
 
@@ -192,7 +409,7 @@ func TestGenerate(t *testing.T) {
 				switch tc.command {
 				case "csharp":
 					args = append(args, "--namespace", tc.packageName)
-				case "go":
+				case "go", "snapshot", "env":
 					args = append(args, "--package-name", tc.packageName)
 				case "java":
 					args = append(args, "--package-name", tc.packageName)
@@ -204,6 +421,11 @@ func TestGenerate(t *testing.T) {
 				args = append(args, "--template", memoryTemplatePath)
 			}
 
+			if tc.locale != "" {
+				args = append(args, "--locale", tc.locale)
+			}
+			args = append(args, tc.extraArgs...)
+
 			cmd.SetArgs(args)
 
 			// Run command
@@ -270,3 +492,449 @@ func compareOutput(t *testing.T, testFile, memoryOutputPath string, fs afero.Fs)
 		t.Errorf("output mismatch (-want +got):\n%s", diff)
 	}
 }
+
+// The generate package offers every generator of the generate command, except custom, whose
+// templates are only read from a directory of the project
+func TestGeneratePackageLanguages(t *testing.T) {
+	var commands []string
+	for _, subCmd := range GetGenerateCmd().Commands() {
+		if subCmd.Name() != "custom" {
+			commands = append(commands, subCmd.Name())
+		}
+	}
+
+	var languages []string
+	for _, language := range generate.Languages() {
+		languages = append(languages, string(language))
+	}
+	assert.ElementsMatch(t, commands, languages)
+}
+
+func TestGenerateWithRemoteTemplate(t *testing.T) {
+	const remoteTemplate = "package {{ .Params.Custom.GoPackage }}\n\n// {{ len .Flagset.Flags }} flags\n"
+	checksum := sha256.Sum256([]byte(remoteTemplate))
+	pinned := "#sha256=" + hex.EncodeToString(checksum[:])
+
+	tests := []struct {
+		name          string
+		fragment      string
+		served        string
+		expectedError string
+	}{
+		{
+			name:   "unpinned template",
+			served: remoteTemplate,
+		},
+		{
+			name:     "pinned template with matching checksum",
+			fragment: pinned,
+			served:   remoteTemplate,
+		},
+		{
+			name:          "pinned template with mismatching checksum",
+			fragment:      pinned,
+			served:        remoteTemplate + "// tampered\n",
+			expectedError: "checksum mismatch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer gock.Off()
+			gock.New("https://templates.example.com").
+				Get("/go/1.2.0/go.tmpl").
+				Reply(200).
+				BodyString(tt.served)
+
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+
+			cmd := GetGenerateCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs([]string{
+				"go",
+				"--manifest", "flags.json",
+				"--output", "output",
+				"--package-name", "testpackage",
+				"--template", "https://templates.example.com/go/1.2.0/go.tmpl" + tt.fragment,
+			})
+
+			err := cmd.Execute()
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			content, err := afero.ReadFile(fs, "output/testpackage_gen.go")
+			require.NoError(t, err)
+			assert.Contains(t, string(content), "// 5 flags")
+		})
+	}
+}
+
+func TestGenerateExcludesFlags(t *testing.T) {
+	const manifest = `{
+		"flags": {
+			"clientFlag": {"flagType": "boolean", "defaultValue": true},
+			"secretFlag": {"flagType": "boolean", "defaultValue": true, "codegen": false},
+			"serverFlag": {"flagType": "boolean", "defaultValue": true, "tags": ["server-only"]},
+			"internalFlag": {"flagType": "boolean", "defaultValue": true, "visibility": "server"}
+		}
+	}`
+
+	tests := []struct {
+		name     string
+		command  []string
+		file     string
+		included []string
+		excluded []string
+	}{
+		{
+			name:     "generator with excluded tags",
+			command:  []string{"react"},
+			file:     "output/openfeature.ts",
+			included: []string{"clientFlag"},
+			excluded: []string{"secretFlag", "serverFlag", "internalFlag"},
+		},
+		{
+			name:     "client generator",
+			command:  []string{"vue"},
+			file:     "output/openfeature.ts",
+			included: []string{"clientFlag", "serverFlag"},
+			excluded: []string{"secretFlag", "internalFlag"},
+		},
+		{
+			name:     "generator without excluded tags",
+			command:  []string{"go", "--package-name", "testpackage"},
+			file:     "output/testpackage_gen.go",
+			included: []string{"clientFlag", "serverFlag", "internalFlag"},
+			excluded: []string{"secretFlag"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(manifest), 0o644))
+			setupConfigFileForTest(t, `
+generate:
+  react:
+    exclude-tags: ["server-only"]
+`)
+
+			cmd := GetGenerateCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append(tt.command, "--manifest", "flags.json", "--output", "output"))
+			require.NoError(t, cmd.Execute())
+
+			content, err := afero.ReadFile(fs, tt.file)
+			require.NoError(t, err)
+			for _, key := range tt.included {
+				assert.Contains(t, string(content), key)
+			}
+			for _, key := range tt.excluded {
+				assert.NotContains(t, string(content), key)
+			}
+		})
+	}
+}
+
+func TestGenerateCustom(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+	require.NoError(t, afero.WriteFile(fs, "templates/flags.md.tmpl",
+		[]byte("{{ range .Flagset.Flags }}- {{ .Key | ToScreamingSnake }}: {{ .DefaultValue | ToJSONString }}\n{{ end }}"), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "templates/config/count.txt",
+		[]byte("{{ len .Flagset.Flags }} flags\n"), 0o644))
+
+	cmd := GetGenerateCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{"custom", "--manifest", "flags.json", "--template-dir", "templates", "--output", "output"})
+	require.NoError(t, cmd.Execute())
+
+	content, err := afero.ReadFile(fs, "output/flags.md")
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "- ENABLE_FEATURE_A: false\n")
+	assert.Contains(t, string(content), `- THEME_CUSTOMIZATION: {"primaryColor":"#007bff","secondaryColor":"#6c757d"}`)
+
+	content, err = afero.ReadFile(fs, "output/config/count.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "5 flags\n", string(content))
+}
+
+func TestGenerateXLSX(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	readOsFileAndWriteToMemMap(t, "testdata/inventory_manifest.golden", "flags.json", fs)
+
+	cmd := GetGenerateCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{"xlsx", "--manifest", "flags.json", "--output", "output"})
+	require.NoError(t, cmd.Execute())
+
+	content, err := afero.ReadFile(fs, "output/flags.xlsx")
+	require.NoError(t, err)
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+
+	sheet, err := archive.Open("xl/worksheets/sheet1.xml")
+	require.NoError(t, err)
+	defer sheet.Close()
+	data, err := io.ReadAll(sheet)
+	require.NoError(t, err)
+
+	worksheet := string(data)
+	assert.Contains(t, worksheet, `<c r="A1" t="inlineStr" s="1"><is><t xml:space="preserve">key</t></is></c>`, "the header is bold")
+	assert.Contains(t, worksheet, `<c r="C3" t="inlineStr"><is><t xml:space="preserve">Accept payments, in EUR, USD and &#34;GBP&#34;</t></is></c>`)
+	assert.Contains(t, worksheet, `<c r="W3" t="inlineStr"><is><t xml:space="preserve">50</t></is></c>`, "metadata has a column per key")
+	assert.Contains(t, worksheet, `<c r="D4" t="inlineStr"><is><t xml:space="preserve">Hello,&#xA;world</t></is></c>`)
+	assert.Contains(t, worksheet, `<autoFilter ref="A1:W4"/>`)
+}
+
+func TestGenerateTemplateOverride(t *testing.T) {
+	setupOverrideTest := func(t *testing.T, configContent string) afero.Fs {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+		require.NoError(t, afero.WriteFile(fs, "hook.tmpl",
+			[]byte("export const use{{ .Key | ToPascal }} = () => useFlag({{ .Key | Quote }}, undefined);"), 0o644))
+		setupConfigFileForTest(t, configContent)
+		return fs
+	}
+
+	t.Run("replaces a named template from the command line", func(t *testing.T) {
+		fs := setupOverrideTest(t, "")
+
+		cmd := GetGenerateCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"react", "--manifest", "flags.json", "--output", "output", "--template-override", "hook=hook.tmpl"})
+		require.NoError(t, cmd.Execute())
+
+		content, err := afero.ReadFile(fs, "output/openfeature.ts")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `export const useEnableFeatureA = () => useFlag("enableFeatureA", undefined);`)
+		// The rest of the built-in template is kept
+		assert.Contains(t, string(content), "export const useSuspenseEnableFeatureA = ")
+	})
+
+	t.Run("replaces a named template from the config file", func(t *testing.T) {
+		fs := setupOverrideTest(t, `
+generate:
+  react:
+    template-overrides:
+      hook: hook.tmpl
+`)
+
+		cmd := GetGenerateCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"react", "--manifest", "flags.json", "--output", "output"})
+		require.NoError(t, cmd.Execute())
+
+		content, err := afero.ReadFile(fs, "output/openfeature.ts")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `export const useEnableFeatureA = () => useFlag("enableFeatureA", undefined);`)
+	})
+
+	t.Run("fails for a template the generator does not define", func(t *testing.T) {
+		setupOverrideTest(t, "")
+
+		cmd := GetGenerateCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"react", "--manifest", "flags.json", "--output", "output", "--template-override", "body=hook.tmpl"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `template "body" is not defined by the generator template`)
+		assert.Contains(t, err.Error(), "Templates that can be overridden: hook, provider, suspense-hook")
+	})
+}
+
+func TestGenerateBundleReport(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+
+	// Enable pterm output and capture it
+	pterm.EnableOutput()
+	defer pterm.DisableOutput()
+	out := &bytes.Buffer{}
+	logger.RedirectOutput(out)
+	defer logger.RedirectOutput(os.Stdout)
+
+	cmd := GetGenerateCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{"react", "--manifest", "flags.json", "--output", "output", "--bundle-report", "--max-default-size", "40"})
+	require.NoError(t, cmd.Execute())
+
+	output := out.String()
+
+	assert.Contains(t, output, "Flag 'themeCustomization' has an object default value of 53 bytes, larger than the 40 bytes allowed by --max-default-size")
+	assert.Contains(t, output, "Estimated bundle size")
+	assert.Contains(t, output, "bytes gzipped")
+	assert.Contains(t, output, "Each flag is a separate hook export")
+}
+
+func TestGenerateGoConstantsOnlyConflicts(t *testing.T) {
+	for _, flag := range []string{"--otel-hooks", "--with-tests"} {
+		t.Run(flag, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+
+			cmd := GetGenerateCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs([]string{"go", "--constants-only", flag, "--manifest", "flags.json", "--output", "output"})
+
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), flag+" is not supported with --constants-only")
+		})
+	}
+}
+
+func TestGenerateDeprecatedFlags(t *testing.T) {
+	manifest := `{
+		"flags": {
+			"expiredFlag": {"flagType": "boolean", "defaultValue": true, "activeUntil": "2025-12-01T00:00:00Z"},
+			"expiringFlag": {"flagType": "boolean", "defaultValue": true, "activeUntil": "2026-01-11T00:00:00Z"},
+			"activeFlag": {"flagType": "boolean", "defaultValue": true, "activeUntil": "2026-06-01T00:00:00Z"},
+			"permanentFlag": {"flagType": "boolean", "defaultValue": true}
+		}
+	}`
+
+	tests := []struct {
+		name        string
+		command     []string
+		file        string
+		marker      string
+		deprecated  int
+		extraArgs   []string
+		expectedErr string
+	}{
+		{
+			name:       "go",
+			command:    []string{"go", "--package-name", "testpackage"},
+			file:       "output/testpackage_gen.go",
+			marker:     "// Deprecated: The flag",
+			deprecated: 3,
+		},
+		{
+			name:       "go as of a date",
+			command:    []string{"go", "--package-name", "testpackage"},
+			file:       "output/testpackage_gen.go",
+			marker:     "// Deprecated: The flag",
+			deprecated: 2,
+			extraArgs:  []string{"--deprecate-as-of", "2026-01-01"},
+		},
+		{
+			name:       "go with a deprecation window of 0",
+			command:    []string{"go", "--package-name", "testpackage"},
+			file:       "output/testpackage_gen.go",
+			marker:     "// Deprecated: The flag expires on 2025-12-01. Remove the code that uses it.",
+			deprecated: 1,
+			extraArgs:  []string{"--deprecate-as-of", "2026-01-01", "--deprecate-within", "0"},
+		},
+		{
+			name:       "java",
+			command:    []string{"java", "--package-name", "com.example"},
+			file:       "output/OpenFeature.java",
+			marker:     "* @deprecated The flag",
+			deprecated: 4,
+			extraArgs:  []string{"--deprecate-as-of", "2026-01-01"},
+		},
+		{
+			name:       "csharp",
+			command:    []string{"csharp"},
+			file:       "output/OpenFeature.g.cs",
+			marker:     `[Obsolete("The flag`,
+			deprecated: 4,
+			extraArgs:  []string{"--deprecate-as-of", "2026-01-01"},
+		},
+		{
+			name:       "nodejs",
+			command:    []string{"nodejs"},
+			file:       "output/openfeature.ts",
+			marker:     "* @deprecated The flag",
+			deprecated: 4,
+			extraArgs:  []string{"--deprecate-as-of", "2026-01-01"},
+		},
+		{
+			name:        "negative deprecation window",
+			command:     []string{"nodejs"},
+			extraArgs:   []string{"--deprecate-within", "-1"},
+			expectedErr: "--deprecate-within must not be negative",
+		},
+		{
+			name:        "invalid date",
+			command:     []string{"nodejs"},
+			extraArgs:   []string{"--deprecate-as-of", "01/01/2026"},
+			expectedErr: `invalid --deprecate-as-of "01/01/2026"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(manifest), 0o644))
+
+			cmd := GetGenerateCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append(append(tt.command, "--manifest", "flags.json", "--output", "output"), tt.extraArgs...))
+			err := cmd.Execute()
+			if tt.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			content, err := afero.ReadFile(fs, tt.file)
+			require.NoError(t, err)
+			assert.Equal(t, tt.deprecated, strings.Count(string(content), tt.marker))
+		})
+	}
+}
+
+func TestGenerateEnvErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifest    string
+		args        []string
+		expectedErr string
+	}{
+		{
+			name:        "keys read from the same variable",
+			manifest:    `{"flags": {"new-checkout": {"flagType": "boolean", "defaultValue": false}, "newCheckout": {"flagType": "boolean", "defaultValue": true}}}`,
+			args:        []string{"--language", "go"},
+			expectedErr: `flags "new-checkout" and "newCheckout" are both read from the environment variable OPENFEATURE_FLAG_NEW_CHECKOUT`,
+		},
+		{
+			name:        "invalid language",
+			manifest:    `{"flags": {}}`,
+			args:        []string{"--language", "web"},
+			expectedErr: `invalid language: "web". Valid languages are: go, nodejs`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(tt.manifest), 0o644))
+
+			cmd := GetGenerateCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append([]string{"env", "--manifest", "flags.json", "--output", "output"}, tt.args...))
+
+			err := cmd.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
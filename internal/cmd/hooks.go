@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// hooksConfigKey is the key of the command hooks in the config file
+const hooksConfigKey = "hooks"
+
+// Stages at which hooks run
+const (
+	hookPre  = "pre"
+	hookPost = "post"
+	// hookUpdate runs whenever a long-running command, such as listen, updated the manifest
+	hookUpdate = "update"
+)
+
+// addCommandHooks runs the hooks configured in the hooks block of the config before and after
+// every runnable command in the tree. Pre hooks run once the configuration of the command is
+// resolved, post hooks only run if the command succeeded. With --watch, the watch loop runs the
+// post hooks after each regeneration instead.
+func addCommandHooks(cmd *cobra.Command) {
+	for _, child := range cmd.Commands() {
+		addCommandHooks(child)
+	}
+	if !cmd.Runnable() || cmd.Parent() == nil {
+		return
+	}
+
+	preRunE, preRun := cmd.PreRunE, cmd.PreRun
+	cmd.PreRun = nil
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if preRunE != nil {
+			if err := preRunE(cmd, args); err != nil {
+				return err
+			}
+		} else if preRun != nil {
+			preRun(cmd, args)
+		}
+		return runCommandHooks(cmd, args, hookPre)
+	}
+
+	postRunE, postRun := cmd.PostRunE, cmd.PostRun
+	cmd.PostRun = nil
+	cmd.PostRunE = func(cmd *cobra.Command, args []string) error {
+		if postRunE != nil {
+			if err := postRunE(cmd, args); err != nil {
+				return err
+			}
+		} else if postRun != nil {
+			postRun(cmd, args)
+		}
+		// With --watch, the post hooks already ran after each regeneration
+		if config.GetWatch(cmd) {
+			return nil
+		}
+		return runCommandHooks(cmd, args, hookPost)
+	}
+}
+
+// runCommandHooks runs the hooks of the stage configured for the command. Hooks are looked up by the
+// path of the command, from the most specific to the least specific (e.g., post-generate-go, then post-generate).
+func runCommandHooks(cmd *cobra.Command, args []string, stage string) error {
+	// Post hooks, such as formatters, would change the files on disk that --check compares against
+	if stage == hookPost && config.GetCheck(cmd) {
+		return nil
+	}
+
+	v, err := readConfigFile()
+	if err != nil {
+		return err
+	}
+
+	name, commands, err := lookupHooks(v, cmd, stage)
+	if err != nil || len(commands) == 0 {
+		return err
+	}
+
+	env := append(os.Environ(), hookEnv(cmd, args, stage)...)
+	out := cmd.OutOrStdout()
+	if _, ok := cmd.Annotations[stdioAnnotation]; ok {
+		out = cmd.ErrOrStderr()
+	}
+
+	for _, command := range commands {
+		logger.Default.Debug(fmt.Sprintf("Running %s hook: %s", name, command))
+		hook := shellCommand(command)
+		hook.Env = env
+		hook.Stdout = out
+		hook.Stderr = cmd.ErrOrStderr()
+		if err := hook.Run(); err != nil {
+			return fmt.Errorf("%s hook %q failed: %w", name, command, err)
+		}
+	}
+	return nil
+}
+
+// lookupHooks returns the name and the commands of the most specific hook configured for the command.
+// A hook is either a single shell command or a list of shell commands.
+func lookupHooks(v *viper.Viper, cmd *cobra.Command, stage string) (string, []string, error) {
+	path := strings.Fields(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()))
+	for i := len(path); i > 0; i-- {
+		name := stage + "-" + strings.Join(path[:i], "-")
+		key := hooksConfigKey + "." + name
+		if !v.IsSet(key) {
+			continue
+		}
+
+		switch value := v.Get(key).(type) {
+		case string:
+			return name, []string{value}, nil
+		case []any:
+			commands := make([]string, 0, len(value))
+			for _, item := range value {
+				command, ok := item.(string)
+				if !ok {
+					return "", nil, fmt.Errorf("invalid %s hook in config: expected a command or a list of commands", name)
+				}
+				commands = append(commands, command)
+			}
+			return name, commands, nil
+		default:
+			return "", nil, fmt.Errorf("invalid %s hook in config: expected a command or a list of commands", name)
+		}
+	}
+	return "", nil, nil
+}
+
+// hookEnvFlags are the options whose values are passed to hooks
+var hookEnvFlags = []string{
+	config.OutputFlagName,
+	config.TargetFlagName,
+	config.TargetRegionFlagName,
+	config.DirFlagName,
+	config.DryRunFlagName,
+	config.NoInputFlagName,
+	config.OverrideFlagName,
+	config.FormatFlagName,
+	config.LanguageFlagName,
+	config.GoPackageFlagName,
+	config.CheckFlagName,
+}
+
+// hookEnv returns the environment variables describing the context of the command to its hooks
+func hookEnv(cmd *cobra.Command, args []string, stage string) []string {
+	env := []string{
+		"OPENFEATURE_HOOK=" + stage,
+		"OPENFEATURE_COMMAND=" + strings.TrimSpace(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name())),
+		"OPENFEATURE_ARGS=" + strings.Join(args, " "),
+	}
+	if cmd.Flags().Lookup(config.ManifestFlagName) != nil {
+		env = append(env, "OPENFEATURE_MANIFEST="+config.GetManifestPath(cmd))
+	}
+
+	// The resolved value of the options that describe what the command works on, e.g.
+	// OPENFEATURE_FLAG_OUTPUT for --output. Credentials such as --auth-token are never passed on.
+	for _, flagName := range hookEnvFlags {
+		f := cmd.Flags().Lookup(flagName)
+		if f == nil {
+			continue
+		}
+		name := strings.ToUpper(strings.ReplaceAll(f.Name, "-", "_"))
+		env = append(env, "OPENFEATURE_FLAG_"+name+"="+f.Value.String())
+	}
+	return env
+}
+
+// shellCommand returns a command that runs the command line with the shell of the platform
+func shellCommand(command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.Command("cmd", "/C", command)
+	}
+	return exec.Command("sh", "-c", command)
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandHooks(t *testing.T) {
+	setupHooksTest := func(t *testing.T, hooks string) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+		setupConfigFileForTest(t, hooks)
+	}
+
+	t.Run("runs the hooks around the command with its context", func(t *testing.T) {
+		setupHooksTest(t, `
+hooks:
+  pre-manifest-list: echo "$OPENFEATURE_HOOK $OPENFEATURE_COMMAND $OPENFEATURE_MANIFEST" >> hooks.log
+  post-manifest:
+    - echo "$OPENFEATURE_HOOK $OPENFEATURE_COMMAND" >> hooks.log
+    - echo "no-input=$OPENFEATURE_FLAG_NO_INPUT" >> hooks.log
+`)
+
+		cmd := GetRootCmd()
+		cmd.SetArgs([]string{"manifest", "list", "-m", "flags.json", "--no-input"})
+		require.NoError(t, cmd.Execute())
+
+		log, err := os.ReadFile("hooks.log")
+		require.NoError(t, err)
+		assert.Equal(t, "pre manifest list flags.json\npost manifest list\nno-input=true\n", string(log))
+	})
+
+	t.Run("a failing pre hook aborts the command", func(t *testing.T) {
+		setupHooksTest(t, `
+hooks:
+  pre-manifest-list: exit 3
+  post-manifest-list: touch post.log
+`)
+
+		cmd := GetRootCmd()
+		cmd.SetArgs([]string{"manifest", "list", "-m", "flags.json"})
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `pre-manifest-list hook "exit 3" failed`)
+		assert.NoFileExists(t, "post.log")
+	})
+
+	t.Run("post hooks run once per regeneration with --watch", func(t *testing.T) {
+		setupHooksTest(t, `
+hooks:
+  pre-generate: echo "$OPENFEATURE_HOOK" >> hooks.log
+  post-generate: echo "$OPENFEATURE_HOOK" >> hooks.log
+`)
+
+		// The watch stops right after the first generation
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		cmd := GetRootCmd()
+		cmd.SetArgs([]string{"generate", "go", "-m", "flags.json", "-o", "output", "--watch"})
+		require.NoError(t, cmd.ExecuteContext(ctx))
+
+		log, err := os.ReadFile("hooks.log")
+		require.NoError(t, err)
+		assert.Equal(t, "pre\npost\n", string(log))
+	})
+}
+
+func TestHookEnv_NeverPassesCredentials(t *testing.T) {
+	cmd := GetPushCmd()
+	require.NoError(t, cmd.ParseFlags([]string{"--auth-token", "secret-token", "--dry-run"}))
+
+	env := hookEnv(cmd, nil, hookPre)
+	assert.Contains(t, env, "OPENFEATURE_FLAG_DRY_RUN=true")
+	for _, variable := range env {
+		assert.False(t, strings.HasPrefix(variable, "OPENFEATURE_FLAG_AUTH_TOKEN="), "hook env has %s", variable)
+		assert.NotContains(t, variable, "secret-token")
+	}
+}
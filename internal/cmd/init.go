@@ -131,7 +131,8 @@ const configTemplateText = `# OpenFeature CLI Configuration
 # For full documentation, visit: https://github.com/open-feature/cli#configuration
 
 # Global Configuration
-# Path to your flag manifest file (default: "flags.json")
+# Path to your flag manifest file, in JSON, in YAML (.yaml, .yml) or in TOML (.toml)
+# (default: "flags.json", or "flags.yaml" if there is no flags.json)
 # manifest: "flags.json"
 
 # URL of your flag provider for the 'pull' and 'push' commands
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func GetInitiativesCmd() *cobra.Command {
+	initiativesCmd := &cobra.Command{
+		Use:   "initiatives",
+		Short: "Show flags grouped by epic or initiative",
+		Long:  `Commands for reviewing the flags of the manifest by the epic or initiative they are delivered as part of (the initiative field of a flag).`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceErrors:              true,
+		SilenceUsage:               true,
+		DisableSuggestions:         false,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	initiativesCmd.AddCommand(GetInitiativesStatusCmd())
+
+	addStabilityInfo(initiativesCmd)
+
+	return initiativesCmd
+}
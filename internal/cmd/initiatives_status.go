@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetInitiativesStatusCmd() *cobra.Command {
+	initiativesStatusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Roll up the flags of each initiative",
+		Long: `Roll up the flags of each initiative: the number of flags, their rollout state and their expiry health.
+
+The rollout state of a flag is read from the metadata its provider reports on pull: a rolloutState
+(e.g., full), or a rolloutPercentage or rollout (off at 0, full at 100 and partial in between).
+Flags without either are counted as unknown.
+
+The expiry health of an initiative is expired if any of its flags has expired, expiring if any
+expires within 30 days, and ok otherwise. Flags without an initiative are listed as unassigned.`,
+		Example: `  # Show the status of every initiative
+  openfeature initiatives status
+
+  # Write the status as JSON, e.g., for a dashboard
+  openfeature initiatives status --output json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "initiatives.status")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputFormat := config.GetOutputPath(cmd)
+
+			if outputFormat != "table" && outputFormat != "json" {
+				return fmt.Errorf("invalid output format: %q. Valid formats are: table, json", outputFormat)
+			}
+
+			fs, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			statuses := manifest.RollUpInitiatives(fs.Flags, time.Now())
+
+			if outputFormat == "json" {
+				data, err := json.MarshalIndent(statuses, "", "  ")
+				if err != nil {
+					return fmt.Errorf("error marshaling initiatives: %w", err)
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+				return err
+			}
+
+			displayInitiatives(statuses)
+			return nil
+		},
+	}
+
+	config.AddInitiativesStatusFlags(initiativesStatusCmd)
+	addStabilityInfo(initiativesStatusCmd)
+
+	return initiativesStatusCmd
+}
+
+// displayInitiatives prints the initiatives as a table
+func displayInitiatives(statuses []manifest.InitiativeStatus) {
+	if len(statuses) == 0 {
+		pterm.Info.Println("No flags in the manifest")
+		return
+	}
+
+	tableData := pterm.TableData{{"Initiative", "Flags", "Rollout", "Expired", "Expiring", "No expiry", "Health"}}
+	for _, status := range statuses {
+		name := status.Name
+		if name == "" {
+			name = "(unassigned)"
+		}
+		tableData = append(tableData, []string{
+			name,
+			display.count(len(status.Flags)),
+			formatRollout(status.Rollout),
+			display.count(status.Expired),
+			display.count(status.ExpiringSoon),
+			display.count(status.NoExpiry),
+			healthColor(status.Health).Sprint(status.Health),
+		})
+	}
+
+	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+}
+
+// formatRollout formats the number of flags by rollout state, such as "full 2, partial 1"
+func formatRollout(rollout map[string]int) string {
+	states := make([]string, 0, len(rollout))
+	for state := range rollout {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+
+	parts := make([]string, 0, len(states))
+	for _, state := range states {
+		parts = append(parts, fmt.Sprintf("%s %s", state, display.count(rollout[state])))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// healthColor returns the color of the expiry health of an initiative
+func healthColor(health string) pterm.Color {
+	switch health {
+	case manifest.InitiativeHealthExpired:
+		return pterm.FgRed
+	case manifest.InitiativeHealthExpiring:
+		return pterm.FgYellow
+	default:
+		return pterm.FgGreen
+	}
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitiativesStatusCmd(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(`{
+  "flags": {
+    "checkout-api": {"flagType": "boolean", "defaultValue": false, "initiative": "checkout", "metadata": {"rolloutPercentage": 100}},
+    "checkout-button": {"flagType": "boolean", "defaultValue": false, "initiative": "checkout", "activeUntil": "2020-01-01"},
+    "dark-mode": {"flagType": "boolean", "defaultValue": false}
+  }
+}`), 0o644))
+
+	cmd := GetInitiativesCmd()
+	config.AddRootFlags(cmd)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"status", "-m", "flags.json", "--output", "json"})
+	require.NoError(t, cmd.Execute())
+
+	var statuses []manifest.InitiativeStatus
+	require.NoError(t, json.Unmarshal(out.Bytes(), &statuses))
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "checkout", statuses[0].Name)
+	assert.Equal(t, []string{"checkout-api", "checkout-button"}, statuses[0].Flags)
+	assert.Equal(t, map[string]int{manifest.RolloutFull: 1, manifest.RolloutUnknown: 1}, statuses[0].Rollout)
+	assert.Equal(t, 1, statuses[0].Expired)
+	assert.Equal(t, manifest.InitiativeHealthExpired, statuses[0].Health)
+	assert.Equal(t, "", statuses[1].Name, "Unassigned flags should be listed last")
+	assert.Equal(t, []string{"dark-mode"}, statuses[1].Flags)
+}
+
+func TestFormatRollout(t *testing.T) {
+	assert.Equal(t, "full 2, partial 1", formatRollout(map[string]int{"partial": 1, "full": 2}))
+}
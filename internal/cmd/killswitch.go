@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetKillSwitchCmd() *cobra.Command {
+	killSwitchCmd := &cobra.Command{
+		Use:   "killswitch",
+		Short: "List and trip kill switch flags",
+		Long: `Commands for working with kill switches during an incident.
+
+A kill switch is a boolean flag marked with "killSwitch": true in the manifest.
+Its default value in the manifest is the normal operating value; tripping the
+kill switch sets the flag to the opposite value on the remote provider.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceErrors:              true,
+		SilenceUsage:               true,
+		DisableSuggestions:         false,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	killSwitchCmd.AddCommand(GetKillSwitchListCmd())
+	killSwitchCmd.AddCommand(GetKillSwitchTripCmd())
+
+	addStabilityInfo(killSwitchCmd)
+
+	return killSwitchCmd
+}
+
+func GetKillSwitchListCmd() *cobra.Command {
+	killSwitchListCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all kill switches in the manifest",
+		Long:  `Display all flags marked as kill switches in the manifest, with the value they are set to when tripped.`,
+		Args:  cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "killswitch.list")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+
+			fs, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			displayKillSwitchList(killSwitches(fs), manifestPath)
+			return nil
+		},
+	}
+
+	addStabilityInfo(killSwitchListCmd)
+
+	return killSwitchListCmd
+}
+
+func GetKillSwitchTripCmd() *cobra.Command {
+	killSwitchTripCmd := &cobra.Command{
+		Use:   "trip <key>",
+		Short: "Trip a kill switch on the remote provider",
+		Long: `Trip a kill switch by setting the flag to the opposite of its manifest default on the remote provider.
+
+Because tripping a kill switch affects production traffic, the command asks you
+to type the flag key to confirm. Use --yes to skip the confirmation (required when
+prompts are disabled) and --dry-run to preview the change.
+
+Tripping is refused in read-only mode (OPENFEATURE_READ_ONLY=1 or 'read-only' in
+.openfeature.yaml) and when the selected target doesn't list killswitch-trip in its
+'allowed-operations'.
+
+The manifest itself is not modified. Push the manifest again to reset the kill switch.`,
+		Example: `  # Trip a kill switch after typing its key to confirm
+  openfeature killswitch trip enable-payments --provider-url https://api.example.com
+
+  # Trip a kill switch from an incident runbook without prompting
+  openfeature killswitch trip enable-payments --provider-url https://api.example.com --yes`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "killswitch.trip")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+			providerURL := config.GetFlagSourceURL(cmd)
+			manifestPath := config.GetManifestPath(cmd)
+			authToken := config.GetAuthToken(cmd)
+			dryRun := config.GetDryRun(cmd)
+			yes := config.GetYes(cmd)
+			noInput := config.ShouldDisableInteractivePrompts(cmd)
+
+			if providerURL == "" {
+				return fmt.Errorf("provider URL is required. Please provide --provider-url")
+			}
+
+			parsedURL, err := url.Parse(providerURL)
+			if err != nil {
+				return fmt.Errorf("invalid provider URL: %w", err)
+			}
+			if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+				return fmt.Errorf("unsupported URL scheme: %s. Supported schemes are http:// and https://", parsedURL.Scheme)
+			}
+
+			fs, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			tripped, err := trippedKillSwitch(fs, key)
+			if err != nil {
+				return err
+			}
+
+			if !dryRun {
+				if err := checkOperations(cmd, config.OperationKillSwitchTrip); err != nil {
+					return err
+				}
+				if err := confirmKillSwitchTrip(tripped, providerURL, yes, noInput); err != nil {
+					return err
+				}
+			}
+
+			ctx, cancel := remoteContext(cmd)
+			defer cancel()
+
+			result, err := manifest.SaveToRemote(ctx, providerURL, &flagset.Flagset{Flags: []flagset.Flag{tripped}}, authToken, manifest.PushOptions{
+				DryRun: dryRun,
+			})
+			if err != nil {
+				return fmt.Errorf("error tripping kill switch %q: %w", key, timeoutError(cmd, err))
+			}
+
+			switch {
+			case len(result.Created)+len(result.Updated) == 0:
+				pterm.Info.Printfln("Kill switch %q is already tripped on %s", key, providerURL)
+			case dryRun:
+				pterm.Info.Printfln("Would trip kill switch %q on %s (set to %v)", key, providerURL, tripped.DefaultValue)
+			default:
+				pterm.Success.Printfln("Tripped kill switch %q on %s (set to %v)", key, providerURL, tripped.DefaultValue)
+			}
+
+			return nil
+		},
+	}
+
+	config.AddKillSwitchTripFlags(killSwitchTripCmd)
+	addStabilityInfo(killSwitchTripCmd)
+
+	return killSwitchTripCmd
+}
+
+// killSwitches returns the flags of the flagset that are marked as kill switches
+func killSwitches(fs *flagset.Flagset) []flagset.Flag {
+	var switches []flagset.Flag
+	for _, flag := range fs.Flags {
+		if flag.KillSwitch {
+			switches = append(switches, flag)
+		}
+	}
+	return switches
+}
+
+// trippedKillSwitch returns the kill switch with the given key, set to its tripped value
+func trippedKillSwitch(fs *flagset.Flagset, key string) (flagset.Flag, error) {
+	for _, flag := range fs.Flags {
+		if flag.Key != key {
+			continue
+		}
+		if !flag.KillSwitch {
+			return flagset.Flag{}, fmt.Errorf("flag %q is not marked as a kill switch", key)
+		}
+		defaultValue, ok := flag.DefaultValue.(bool)
+		if flag.Type != flagset.BoolType || !ok {
+			return flagset.Flag{}, fmt.Errorf("kill switch %q must be a boolean flag", key)
+		}
+
+		flag.DefaultValue = !defaultValue
+		return flag, nil
+	}
+	return flagset.Flag{}, fmt.Errorf("flag %q not found in manifest", key)
+}
+
+// confirmKillSwitchTrip asks the user to type the key of the kill switch to confirm tripping it.
+// When prompts are disabled, tripping must be confirmed up front with --yes.
+func confirmKillSwitchTrip(tripped flagset.Flag, providerURL string, yes bool, noInput bool) error {
+	if yes {
+		return nil
+	}
+
+	if noInput {
+		return fmt.Errorf("refusing to trip kill switch %q without confirmation. Re-run with --yes to confirm", tripped.Key)
+	}
+
+	pterm.DefaultBox.WithTitle(pterm.Red("TRIP KILL SWITCH")).Println(fmt.Sprintf(
+		"Flag:     %s\nProvider: %s\nNew value: %v\n\nThis changes the flag for everyone evaluating it.",
+		tripped.Key, providerURL, tripped.DefaultValue,
+	))
+
+	typed, err := pterm.DefaultInteractiveTextInput.Show(fmt.Sprintf("Type %q to confirm", tripped.Key))
+	if err != nil {
+		return fmt.Errorf("failed to show confirmation prompt: %w", err)
+	}
+	if typed != tripped.Key {
+		return fmt.Errorf("kill switch %q was not tripped: confirmation did not match", tripped.Key)
+	}
+	return nil
+}
+
+// displayKillSwitchList prints a formatted table of the kill switches
+func displayKillSwitchList(switches []flagset.Flag, manifestPath string) {
+	if len(switches) == 0 {
+		pterm.Info.Println("No kill switches found in manifest")
+		return
+	}
+
+	pterm.DefaultSection.Println(fmt.Sprintf("Kill switches in %s (%s)", manifestPath, display.count(len(switches))))
+
+	tableData := pterm.TableData{
+		{"Key", "Default Value", "Tripped Value", "Description"},
+	}
+	for _, flag := range switches {
+		tripped := "-"
+		if defaultValue, ok := flag.DefaultValue.(bool); ok {
+			tripped = fmt.Sprintf("%v", !defaultValue)
+		}
+		tableData = append(tableData, []string{
+			flag.Key,
+			formatValue(flag.DefaultValue),
+			tripped,
+			flag.Description,
+		})
+	}
+
+	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+}
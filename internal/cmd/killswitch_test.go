@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const killSwitchManifest = `{
+	"flags": {
+		"enable-payments": {
+			"flagType": "boolean",
+			"defaultValue": true,
+			"description": "Accept payments",
+			"killSwitch": true
+		},
+		"enable-banner": {
+			"flagType": "boolean",
+			"defaultValue": false,
+			"description": "Show the banner"
+		}
+	}
+}`
+
+func executeKillSwitchCmd(t *testing.T, args ...string) error {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(killSwitchManifest), 0o644))
+
+	cmd := GetKillSwitchCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs(append(args, "-m", "flags.json"))
+	return cmd.Execute()
+}
+
+func TestKillSwitchList(t *testing.T) {
+	err := executeKillSwitchCmd(t, "list")
+	assert.NoError(t, err)
+}
+
+func TestKillSwitchTrip(t *testing.T) {
+	t.Run("trips the kill switch on the remote provider", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New("https://api.example.com").
+			Get("/openfeature/v0/manifest").
+			Reply(200).
+			JSON(map[string]any{
+				"flags": []map[string]any{
+					{"key": "enable-payments", "type": "boolean", "defaultValue": true, "description": "Accept payments"},
+				},
+			})
+
+		gock.New("https://api.example.com").
+			Put("/openfeature/v0/manifest/flags/enable-payments").
+			MatchType("application/json").
+			BodyString(`"defaultValue":false`).
+			Reply(200).
+			JSON(map[string]any{
+				"flag":      map[string]any{"key": "enable-payments"},
+				"updatedAt": "2024-03-02T09:45:03.000Z",
+			})
+
+		err := executeKillSwitchCmd(t, "trip", "enable-payments",
+			"--provider-url", "https://api.example.com/openfeature/v0/manifest", "--yes")
+		assert.NoError(t, err)
+		assert.True(t, gock.IsDone(), "Not all expected HTTP requests were made")
+	})
+
+	t.Run("requires confirmation when prompts are disabled", func(t *testing.T) {
+		defer gock.Off()
+
+		err := executeKillSwitchCmd(t, "trip", "enable-payments",
+			"--provider-url", "https://api.example.com/openfeature/v0/manifest")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Re-run with --yes")
+	})
+
+	t.Run("is refused in read-only mode", func(t *testing.T) {
+		defer gock.Off()
+		t.Setenv(config.ReadOnlyEnvVar, "1")
+
+		err := executeKillSwitchCmd(t, "trip", "enable-payments",
+			"--provider-url", "https://api.example.com/openfeature/v0/manifest", "--yes")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "killswitch-trip refused: read-only mode is enabled (OPENFEATURE_READ_ONLY)")
+	})
+
+	t.Run("rejects flags that are not kill switches", func(t *testing.T) {
+		err := executeKillSwitchCmd(t, "trip", "enable-banner",
+			"--provider-url", "https://api.example.com/openfeature/v0/manifest", "--yes")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not marked as a kill switch")
+	})
+
+	t.Run("rejects unknown flags", func(t *testing.T) {
+		err := executeKillSwitchCmd(t, "trip", "missing",
+			"--provider-url", "https://api.example.com/openfeature/v0/manifest", "--yes")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found in manifest")
+	})
+}
@@ -0,0 +1,270 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// learnLanguages are the languages the tutorial can generate code for
+var learnLanguages = []string{"go", "nodejs"}
+
+// learnFlagKey is the flag added in the tutorial
+const learnFlagKey = "welcome-message"
+
+// learnState is the progress of the tutorial, saved between runs of learn
+type learnState struct {
+	Workspace string `json:"workspace"`
+	Language  string `json:"language"`
+	// Step is the index of the next step to run
+	Step int `json:"step"`
+}
+
+// learnStep is a step of the tutorial, which runs an openfeature command in the workspace
+type learnStep struct {
+	Title       string
+	Explanation string
+	// Args are the arguments of the openfeature command run by the step. Steps without
+	// arguments clean up the workspace.
+	Args []string
+}
+
+func GetLearnCmd() *cobra.Command {
+	learnCmd := &cobra.Command{
+		Use:   "learn",
+		Short: "Learn the CLI with an interactive tutorial",
+		Long: `Learn the CLI with an interactive tutorial, run in a temporary workspace so no project is changed.
+
+The tutorial walks through the lifecycle of a flag:
+
+1. Create a manifest
+2. Add a flag
+3. Generate typesafe accessors
+4. Generate a local provider, which evaluates the flags without a flag service
+5. Evaluate the flag and see where the generated code references it
+6. Clean up the workspace
+
+Each step shows the command it runs and asks before running it. The progress is saved, so stopping
+the tutorial and running learn again continues where it stopped. With --no-input, or when stdin is not
+a terminal, the remaining steps run without asking.`,
+		Example: `  # Start or continue the tutorial
+  openfeature learn
+
+  # Generate Node.js code in the tutorial
+  openfeature learn --language nodejs
+
+  # Start over
+  openfeature learn --reset`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "learn")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			language := config.GetLanguage(cmd)
+			noInput := config.ShouldDisableInteractivePrompts(cmd)
+			if !slices.Contains(learnLanguages, language) {
+				return fmt.Errorf("invalid language: %q. Valid languages are: %s", language, strings.Join(learnLanguages, ", "))
+			}
+
+			statePath, err := filesystem.CachePath("learn.json")
+			if err != nil {
+				return err
+			}
+
+			state, ok := readLearnState(statePath)
+			if ok && config.GetReset(cmd) {
+				if err := cleanUpLearnWorkspace(statePath, state); err != nil {
+					return err
+				}
+				ok = false
+			}
+			if ok {
+				pterm.Info.Printfln("Continuing the tutorial in %s. Run learn --reset to start over", state.Workspace)
+			} else {
+				workspace, err := os.MkdirTemp("", "openfeature-learn-")
+				if err != nil {
+					return fmt.Errorf("error creating the tutorial workspace: %w", err)
+				}
+				state = learnState{Workspace: workspace, Language: language}
+				if err := writeLearnState(statePath, state); err != nil {
+					return err
+				}
+				pterm.Info.Printfln("Created the tutorial workspace %s", state.Workspace)
+			}
+
+			steps := learnSteps(state.Language)
+			for state.Step < len(steps) {
+				step := steps[state.Step]
+				pterm.DefaultSection.Println(fmt.Sprintf("Step %d of %d: %s", state.Step+1, len(steps), step.Title))
+				pterm.Println(step.Explanation)
+				pterm.Println()
+				if step.Args != nil {
+					pterm.Printfln("  $ %s", learnCommandLine(step.Args))
+					pterm.Println()
+				}
+
+				if !noInput {
+					run, err := pterm.DefaultInteractiveConfirm.WithDefaultValue(true).Show("Run this step?")
+					if err != nil {
+						return fmt.Errorf("failed to show confirmation prompt: %w", err)
+					}
+					if !run {
+						pterm.Info.Println("Run learn again to continue where you stopped")
+						return nil
+					}
+				}
+
+				if step.Args == nil {
+					if err := cleanUpLearnWorkspace(statePath, state); err != nil {
+						return err
+					}
+					logger.Default.Success("Tutorial complete. Run openfeature init in your project to get started")
+					return nil
+				}
+
+				if err := runLearnStep(state.Workspace, step.Args); err != nil {
+					return fmt.Errorf("step %d failed: %w. Run learn again to retry it", state.Step+1, err)
+				}
+				state.Step++
+				if err := writeLearnState(statePath, state); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	config.AddLearnFlags(learnCmd)
+	addStabilityInfo(learnCmd)
+
+	return learnCmd
+}
+
+// learnSteps returns the steps of the tutorial, generating code in the given language
+func learnSteps(language string) []learnStep {
+	generateArgs := []string{"generate", language, "--output", "flags"}
+	snapshotArgs := []string{"generate", "snapshot", "--language", language, "--output", "flags"}
+	if language == "go" {
+		generateArgs = append(generateArgs, "--package-name", "flags")
+		snapshotArgs = append(snapshotArgs, "--package-name", "flags")
+	}
+
+	return []learnStep{
+		{
+			Title: "Create a manifest",
+			Explanation: `The flag manifest (flags.json) is the source of truth for the flags of a project: their keys,
+types, default values and descriptions. init creates an empty manifest and .openfeature.yaml,
+the configuration of the CLI.`,
+			Args: []string{"init"},
+		},
+		{
+			Title: "Add a flag",
+			Explanation: `manifest add adds a flag to the manifest. Without arguments, it prompts for the key, type,
+default value and description.`,
+			Args: []string{"manifest", "add", learnFlagKey, "--type", "string", "--default-value", "Hello!", "--description", "Greeting shown on the home page"},
+		},
+		{
+			Title: "Generate code",
+			Explanation: `generate turns the manifest into typesafe accessors, so the code can't misspell a flag key
+or evaluate a flag with the wrong type. Regenerate after changing the manifest.`,
+			Args: generateArgs,
+		},
+		{
+			Title: "Run a local provider",
+			Explanation: `A provider evaluates the flags for the OpenFeature SDK. generate snapshot generates one that
+runs in the process and evaluates each flag to its default value, so the code runs without a
+flag service: in tests, in development, or as a fallback.`,
+			Args: snapshotArgs,
+		},
+		{
+			Title: "Evaluate the flag",
+			Explanation: fmt.Sprintf(`Registered with the SDK, the local provider evaluates %s to its default value, "Hello!".
+explain shows everything known about the flag, including where the generated code references it.`, learnFlagKey),
+			Args: []string{"explain", learnFlagKey},
+		},
+		{
+			Title: "Clean up",
+			Explanation: `The tutorial workspace is deleted. To use the CLI in a project, run init in its directory
+and generate the code for its language.`,
+		},
+	}
+}
+
+// runLearnStep runs an openfeature command in the workspace of the tutorial
+func runLearnStep(workspace string, args []string) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err := os.Chdir(workspace); err != nil {
+		return fmt.Errorf("error entering the tutorial workspace: %w", err)
+	}
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+
+	rootCmd := GetRootCmd()
+	rootCmd.SetArgs(append(args, "--"+config.NoInputFlagName))
+	return rootCmd.Execute()
+}
+
+// learnCommandLine formats the arguments of a step as the command line a user would type
+func learnCommandLine(args []string) string {
+	parts := []string{"openfeature"}
+	for _, arg := range args {
+		if strings.ContainsAny(arg, " !\"$") {
+			arg = "'" + arg + "'"
+		}
+		parts = append(parts, arg)
+	}
+	return strings.Join(parts, " ")
+}
+
+// readLearnState reads the progress of the tutorial, returning false if no tutorial is in progress
+func readLearnState(path string) (learnState, bool) {
+	data, err := filesystem.ReadFile(path)
+	if err != nil {
+		return learnState{}, false
+	}
+
+	var state learnState
+	if err := json.Unmarshal(data, &state); err != nil || state.Workspace == "" {
+		return learnState{}, false
+	}
+	if _, err := os.Stat(state.Workspace); err != nil {
+		return learnState{}, false
+	}
+	return state, true
+}
+
+// writeLearnState saves the progress of the tutorial
+func writeLearnState(path string, state learnState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := filesystem.WriteFile(path, data); err != nil {
+		return fmt.Errorf("error saving the tutorial progress: %w", err)
+	}
+	return nil
+}
+
+// cleanUpLearnWorkspace deletes the workspace of the tutorial and its progress
+func cleanUpLearnWorkspace(path string, state learnState) error {
+	if err := os.RemoveAll(state.Workspace); err != nil {
+		return fmt.Errorf("error deleting the tutorial workspace: %w", err)
+	}
+	if err := filesystem.FileSystem().Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error deleting the tutorial progress: %w", err)
+	}
+	logger.Default.Info(fmt.Sprintf("Deleted the tutorial workspace %s", state.Workspace))
+	return nil
+}
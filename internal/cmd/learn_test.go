@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLearnCmd(t *testing.T) {
+	setup := func(t *testing.T) string {
+		filesystem.SetFileSystem(afero.NewOsFs())
+		t.Cleanup(func() { filesystem.SetFileSystem(afero.NewMemMapFs()) })
+		cacheDir := t.TempDir()
+		t.Setenv("XDG_CACHE_HOME", cacheDir)
+		t.Setenv("TMPDIR", t.TempDir())
+		t.Chdir(t.TempDir())
+		return filepath.Join(cacheDir, "openfeature", "learn.json")
+	}
+	run := func(args ...string) error {
+		cmd := GetLearnCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs(append(args, "--no-input"))
+		return cmd.Execute()
+	}
+
+	t.Run("runs every step and cleans up", func(t *testing.T) {
+		statePath := setup(t)
+		require.NoError(t, run())
+
+		_, err := os.Stat(statePath)
+		assert.True(t, os.IsNotExist(err), "the progress is deleted")
+		workspaces, err := filepath.Glob(filepath.Join(os.TempDir(), "openfeature-learn-*"))
+		require.NoError(t, err)
+		assert.Empty(t, workspaces)
+		assert.NoFileExists(t, "flags.json", "the current directory is not changed")
+	})
+
+	t.Run("continues where it stopped", func(t *testing.T) {
+		statePath := setup(t)
+		workspace := t.TempDir()
+		require.NoError(t, writeLearnState(statePath, learnState{Workspace: workspace, Language: "go", Step: 2}))
+		// The failing generate step leaves the progress as it is, so it can be retried
+		err := run()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "step 3 failed")
+		state, ok := readLearnState(statePath)
+		require.True(t, ok)
+		assert.Equal(t, 2, state.Step)
+
+		require.NoError(t, os.WriteFile(filepath.Join(workspace, "flags.json"),
+			[]byte(`{"flags": {"welcome-message": {"flagType": "string", "defaultValue": "Hello!"}}}`), 0o644))
+		require.NoError(t, run())
+		_, err = os.Stat(workspace)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("reset starts over", func(t *testing.T) {
+		statePath := setup(t)
+		workspace := t.TempDir()
+		require.NoError(t, writeLearnState(statePath, learnState{Workspace: workspace, Language: "go", Step: 2}))
+		require.NoError(t, run("--reset"))
+		_, err := os.Stat(workspace)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("invalid language", func(t *testing.T) {
+		setup(t)
+		err := run("--language", "cobol")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid language: "cobol"`)
+	})
+}
+
+func TestLearnCommandLine(t *testing.T) {
+	assert.Equal(t, `openfeature manifest add welcome-message --default-value 'Hello!'`,
+		learnCommandLine([]string{"manifest", "add", "welcome-message", "--default-value", "Hello!"}))
+}
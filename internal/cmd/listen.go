@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/cobra"
+)
+
+// maxWebhookPayloadSize is the largest webhook payload the listener accepts
+const maxWebhookPayloadSize = 1 << 20
+
+func GetListenCmd() *cobra.Command {
+	listenCmd := &cobra.Command{
+		Use:   "listen",
+		Short: "Keep the manifest current with the webhooks of the provider",
+		Long: `Run an HTTP listener for the webhooks of the flag provider, and apply the flags created, changed
+or deleted on the provider to the local manifest as they happen, without scheduled pulls.
+
+Point the webhook of the provider at http://<address>/ (any path is accepted). A payload is an event,
+or a list of events, with the flag in the format of the sync API:
+
+  {"type": "flag.changed", "flag": {"key": "new-checkout", "type": "boolean", "defaultValue": true}}
+
+The event type is flag.created, flag.changed or flag.deleted.
+
+Created and changed flags take the type, default value and description of the provider, keeping the
+fields only the manifest has, such as tags or expiry dates.
+
+Payloads must carry their HMAC-SHA256 signature with the --secret of the webhook in the
+X-OpenFeature-Signature header, as sha256=<hex digest>. Set the secret in .openfeature.yaml or on the
+command line. The listener refuses to start without a secret, unless --insecure is set to accept
+unsigned payloads, e.g. on a local machine.
+
+After each update of the manifest, the update-listen hooks of .openfeature.yaml run, for example to
+commit the manifest and open a pull request:
+
+  hooks:
+    update-listen: git checkout -B flag-sync && git commit -am "Sync flags" && gh pr create --fill`,
+		Example: `  # Listen on localhost:8787 and check the signature of the payloads
+  openfeature listen --secret "$WEBHOOK_SECRET"
+
+  # Listen on all interfaces
+  openfeature listen --address :8787 --secret "$WEBHOOK_SECRET"
+
+  # Accept unsigned payloads while trying out the webhook locally
+  openfeature listen --insecure`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "listen")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			secret := config.GetSecret(cmd)
+			if secret == "" && !config.GetInsecure(cmd) {
+				return fmt.Errorf("no --secret set: set the shared secret of the webhook, or --insecure to accept unsigned payloads")
+			}
+
+			listener, err := net.Listen("tcp", config.GetAddress(cmd))
+			if err != nil {
+				return fmt.Errorf("error listening on %s: %w", config.GetAddress(cmd), err)
+			}
+
+			handler := &webhookHandler{
+				manifestPath: config.GetManifestPath(cmd),
+				secret:       secret,
+				decode:       manifest.DecodeWebhookEvents,
+				onUpdate: func() error {
+					return runCommandHooks(cmd, args, hookUpdate)
+				},
+			}
+			if secret == "" {
+				logger.Default.Warning("--insecure set: the listener accepts unsigned payloads")
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			logger.Default.Info(fmt.Sprintf("Listening for webhooks on %s. Press Ctrl+C to stop.", listener.Addr()))
+			return serveWebhooks(ctx, listener, handler)
+		},
+	}
+
+	config.AddListenFlags(listenCmd)
+	addStabilityInfo(listenCmd)
+
+	return listenCmd
+}
+
+// serveWebhooks serves the handler on the listener until the context is done
+func serveWebhooks(ctx context.Context, listener net.Listener, handler http.Handler) error {
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("webhook listener stopped: %w", err)
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("error stopping the webhook listener: %w", err)
+		}
+		return nil
+	}
+}
+
+// webhookHandler applies the flag events of provider webhooks to the manifest
+type webhookHandler struct {
+	manifestPath string
+	secret       string
+	decode       manifest.WebhookDecoder
+	// onUpdate is called after the manifest was updated
+	onUpdate func() error
+
+	// mu serializes the updates of the manifest
+	mu sync.Mutex
+}
+
+func (h *webhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "webhooks must be sent with POST", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error reading payload: %v", err), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if h.secret != "" {
+		if err := manifest.VerifyWebhookSignature(h.secret, r.Header, body); err != nil {
+			logger.Default.Warning(fmt.Sprintf("Rejected webhook from %s: %v", r.RemoteAddr, err))
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	events, err := h.decode(r.Header, body)
+	if err != nil {
+		logger.Default.Warning(fmt.Sprintf("Rejected webhook from %s: %v", r.RemoteAddr, err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	changes, err := h.apply(events)
+	if err != nil {
+		logger.Default.Error(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"changes": changes})
+}
+
+// apply applies the events to the manifest and returns the changes made
+func (h *webhookHandler) apply(events []manifest.WebhookEvent) ([]string, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	fs, err := manifest.LoadFlagSet(h.manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("error loading manifest: %w", err)
+	}
+
+	changes := manifest.ApplyWebhookEvents(fs, events)
+	if len(changes) == 0 {
+		logger.Default.Debug("Webhook matches the manifest already")
+		return []string{}, nil
+	}
+	if err := manifest.Write(h.manifestPath, *fs); err != nil {
+		return nil, fmt.Errorf("error writing manifest: %w", err)
+	}
+	logger.Default.Success(fmt.Sprintf("Updated %s: %s", h.manifestPath, strings.Join(changes, ", ")))
+
+	if h.onUpdate != nil {
+		if err := h.onUpdate(); err != nil {
+			// The manifest is updated, so the provider has nothing to retry
+			logger.Default.Error(err.Error())
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const listenManifest = `{
+	"flags": {
+		"banner": {"flagType": "string", "defaultValue": "Hi", "description": "Banner", "tags": ["ui"]},
+		"old-checkout": {"flagType": "boolean", "defaultValue": false, "description": "Old checkout"}
+	}
+}`
+
+func TestWebhookHandler(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(listenManifest), 0o644))
+
+	updates := 0
+	handler := &webhookHandler{
+		manifestPath: "flags.json",
+		secret:       "s3cret",
+		decode:       manifest.DecodeWebhookEvents,
+		onUpdate: func() error {
+			updates++
+			return nil
+		},
+	}
+	send := func(method string, body string, secret string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/", strings.NewReader(body))
+		if secret != "" {
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write([]byte(body))
+			req.Header.Set(manifest.WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	payload := `[
+		{"type": "flag.changed", "flag": {"key": "banner", "type": "string", "defaultValue": "Hello"}},
+		{"type": "flag.deleted", "flag": {"key": "old-checkout"}}
+	]`
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		assert.Equal(t, http.StatusMethodNotAllowed, send(http.MethodGet, "", "").Code)
+	})
+
+	t.Run("rejects payloads without a valid signature", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, send(http.MethodPost, payload, "").Code)
+		assert.Equal(t, http.StatusUnauthorized, send(http.MethodPost, payload, "other").Code)
+	})
+
+	t.Run("rejects invalid payloads", func(t *testing.T) {
+		rec := send(http.MethodPost, `{"type": "flag.renamed", "flag": {"key": "banner"}}`, "s3cret")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "unknown event type")
+	})
+
+	t.Run("applies events to the manifest", func(t *testing.T) {
+		rec := send(http.MethodPost, payload, "s3cret")
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		assert.JSONEq(t, `{"changes": ["updated banner", "deleted old-checkout"]}`, rec.Body.String())
+		assert.Equal(t, 1, updates)
+
+		fs, err := manifest.LoadFlagSet("flags.json")
+		require.NoError(t, err)
+		require.Len(t, fs.Flags, 1)
+		assert.Equal(t, "Hello", fs.Flags[0].DefaultValue)
+		assert.Equal(t, "Banner", fs.Flags[0].Description)
+		assert.Equal(t, []string{"ui"}, fs.Flags[0].Tags)
+	})
+
+	t.Run("skips events matching the manifest", func(t *testing.T) {
+		rec := send(http.MethodPost, payload, "s3cret")
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"changes": []}`, rec.Body.String())
+		assert.Equal(t, 1, updates)
+	})
+}
+
+func TestListenRequiresSecret(t *testing.T) {
+	cmd := GetListenCmd()
+	cmd.SetArgs([]string{"--address", "localhost:0"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no --secret set")
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/lsp"
+	"github.com/spf13/cobra"
+)
+
+func GetLSPCmd() *cobra.Command {
+	lspCmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Start a language server that provides flag intelligence in editors",
+		Long: `Start a language server that communicates over stdin and stdout using the Language Server Protocol.
+
+The server is powered by the flag manifest and works in any editor with LSP support,
+without per-editor plugins. It provides:
+
+- Completion of flag keys in string literals
+- Hover information with the description, type, default value and activation window of a flag
+- Diagnostics for flag evaluations that use keys which are not defined in the manifest
+  or whose flag has expired (its activeUntil time has passed)
+
+The manifest is resolved relative to the working directory the editor starts the server in,
+and is reloaded whenever it, a file it includes or a default value file is saved in the editor.`,
+		Example: `  # Start the language server (usually done by the editor)
+  openfeature lsp
+
+  # Neovim (lspconfig) example
+  vim.lsp.start({ name = "openfeature", cmd = { "openfeature", "lsp" }, root_dir = vim.fn.getcwd() })`,
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			stdioAnnotation: "true",
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "lsp")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			server := lsp.NewServer(config.GetManifestPath(cmd))
+			return server.Serve(cmd.InOrStdin(), cmd.OutOrStdout())
+		},
+	}
+
+	addStabilityInfo(lspCmd)
+
+	return lspCmd
+}
@@ -22,6 +22,12 @@ func GetManifestCmd() *cobra.Command {
 	manifestCmd.AddCommand(GetManifestAddCmd())
 	manifestCmd.AddCommand(GetManifestListCmd())
 	manifestCmd.AddCommand(GetManifestDeleteCmd())
+	manifestCmd.AddCommand(GetManifestSetCmd())
+	manifestCmd.AddCommand(GetManifestEditCmd())
+	manifestCmd.AddCommand(GetManifestRenameCmd())
+	manifestCmd.AddCommand(GetManifestValidateCmd())
+	manifestCmd.AddCommand(GetManifestApplyPatchCmd())
+	manifestCmd.AddCommand(GetManifestExportCmd())
 
 	addStabilityInfo(manifestCmd)
 
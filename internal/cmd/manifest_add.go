@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/iancoleman/strcase"
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/filesystem"
 	"github.com/open-feature/cli/internal/flagset"
@@ -32,6 +35,29 @@ Interactive Mode:
   
   Use --no-input to disable interactive prompts (required for CI/automation).
 
+Validation:
+  The key is checked against the manifest before any prompt: keys that exist, or that only differ
+  from an existing key in case or separators (new-checkout and newCheckout), are refused. The default
+  value must match the type. --default is short for --default-value, and bool, int, number and json
+  are accepted as types.
+
+Expiry:
+  --expiry sets when the flag expires (its activeUntil time), as a date (2026-12-31) or a time
+  from today in days, weeks, months or years (+90d, 12w, "in 3 months", "in 1 year").
+  Times from today are normalized to a date.
+
+Presets:
+  Reusable flag settings can be defined in the presets block of .openfeature.yaml and
+  applied with --preset. Values given on the command line take precedence over the preset.
+
+    presets:
+      experiment:
+        type: boolean
+        default-value: false
+        description: "Experiment flag"
+        expires-in: 60d      # sets activeUntil relative to the time the flag is added
+        kill-switch: false
+
 Examples:
   # Interactive mode - prompts for key, type, value, and description
   openfeature manifest add
@@ -42,6 +68,9 @@ Examples:
   # Add a boolean flag (default type)
   openfeature manifest add new-feature --default-value false
 
+  # The same, with the short spellings
+  openfeature manifest add new-feature --type bool --default false
+
   # Add a string flag with description
   openfeature manifest add welcome-message --type string --default-value "Hello!" --description "Welcome message for users"
 
@@ -53,6 +82,21 @@ Examples:
 
   # Add an object flag
   openfeature manifest add config --type object --default-value '{"key":"value"}'
+
+  # Add a flag from the "experiment" preset in .openfeature.yaml
+  openfeature manifest add my-experiment --preset experiment
+
+  # Add a flag that expires in 90 days (or --expiry 2026-12-31, --expiry "in 3 months")
+  openfeature manifest add summer-sale --default-value false --expiry +90d
+
+  # Add a flag that must not be exposed to client-side code
+  openfeature manifest add internal-limits --type object --default-value '{}' --visibility server
+
+  # Add a flag that is delivered as part of the checkout-redesign initiative
+  openfeature manifest add new-checkout --default-value false --initiative checkout-redesign
+
+  # Add a flag whose default value can only be changed on the remote with an extra confirmation
+  openfeature manifest add payment-provider --type string --default-value stripe --risk high
   
   # Disable interactive prompts (for automation)
   openfeature manifest add my-flag --default-value true --no-input`,
@@ -89,13 +133,82 @@ Examples:
 				}
 			}
 
+			// Load the existing manifest
+			var fs *flagset.Flagset
+			exists, err := afero.Exists(filesystem.FileSystem(), manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to check manifest existence: %w", err)
+			}
+
+			if exists {
+				fs, err = manifest.LoadFlagSet(manifestPath)
+				if err != nil {
+					return fmt.Errorf("failed to load manifest: %w", err)
+				}
+			} else {
+				// If manifest doesn't exist, create a new one
+				fs = &flagset.Flagset{
+					Flags: []flagset.Flag{},
+				}
+			}
+
+			// Check for duplicates before prompting for the rest of the flag
+			if err := checkDuplicateFlagKey(fs, flagName); err != nil {
+				return err
+			}
+
 			// Get flag configuration from command flags
 			flagType, _ := cmd.Flags().GetString("type")
 			defaultValueStr, _ := cmd.Flags().GetString("default-value")
 			description, _ := cmd.Flags().GetString("description")
+			hasType := cmd.Flags().Changed("type")
+			hasDefaultValue := cmd.Flags().Changed("default-value")
+			hasDescription := cmd.Flags().Changed("description")
+			visibility := config.GetVisibility(cmd)
+			if visibility != "" && !slices.Contains(flagset.GetValidVisibilities(), visibility) {
+				return fmt.Errorf("invalid visibility: %q. Valid visibilities are: %s", visibility, strings.Join(flagset.GetValidVisibilities(), ", "))
+			}
+			risk := config.GetRisk(cmd)
+			if risk != "" && !slices.Contains(flagset.GetValidRisks(), risk) {
+				return fmt.Errorf("invalid risk: %q. Valid risks are: %s", risk, strings.Join(flagset.GetValidRisks(), ", "))
+			}
+
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+			policy, err := loadPolicy(v)
+			if err != nil {
+				return err
+			}
+
+			// Apply the preset to the values not given on the command line
+			var preset *flagPreset
+			if name := config.GetPreset(cmd); name != "" {
+				preset, err = loadPreset(v, policy, name)
+				if err != nil {
+					return err
+				}
+
+				if !hasType && preset.Type != "" {
+					flagType, hasType = preset.Type, true
+				}
+				if !hasDefaultValue {
+					value, ok, err := preset.defaultValueString()
+					if err != nil {
+						return err
+					}
+					if ok {
+						defaultValueStr, hasDefaultValue = value, true
+					}
+				}
+				if !hasDescription && preset.Description != "" {
+					description, hasDescription = preset.Description, true
+				}
+			}
 
 			// Handle flag type: prompt if not changed and not --no-input
-			if !cmd.Flags().Changed("type") && !noInput {
+			if !hasType && !noInput {
 				selectedType, err := promptForFlagType(flagName)
 				if err != nil {
 					return fmt.Errorf("failed to get flag type: %w", err)
@@ -111,7 +224,7 @@ Examples:
 
 			// Handle default-value: prompt if missing and not --no-input
 			var defaultValue any
-			if !cmd.Flags().Changed("default-value") {
+			if !hasDefaultValue {
 				if noInput {
 					return errors.New("--default-value is required")
 				}
@@ -132,7 +245,7 @@ Examples:
 			}
 
 			// Handle description: prompt if missing and not --no-input
-			if !cmd.Flags().Changed("description") && !noInput {
+			if !hasDescription && !noInput {
 				promptText := fmt.Sprintf("Enter description for flag '%s' (press Enter to skip)", flagName)
 				descInput, err := pterm.DefaultInteractiveTextInput.WithDefaultText("").Show(promptText)
 				if err != nil {
@@ -141,44 +254,58 @@ Examples:
 				description = descInput
 			}
 
-			// Load existing manifest
-			var fs *flagset.Flagset
-			exists, err := afero.Exists(filesystem.FileSystem(), manifestPath)
-			if err != nil {
-				return fmt.Errorf("failed to check manifest existence: %w", err)
-			}
-
-			if exists {
-				fs, err = manifest.LoadFlagSet(manifestPath)
-				if err != nil {
-					return fmt.Errorf("failed to load manifest: %w", err)
-				}
-			} else {
-				// If manifest doesn't exist, create a new one
-				fs = &flagset.Flagset{
-					Flags: []flagset.Flag{},
-				}
-			}
-
-			// Check if flag already exists
-			for _, flag := range fs.Flags {
-				if flag.Key == flagName {
-					return fmt.Errorf("flag '%s' already exists in the manifest", flagName)
-				}
-			}
-
 			// Add new flag
 			newFlag := flagset.Flag{
 				Key:          flagName,
 				Type:         parsedType,
 				Description:  description,
 				DefaultValue: defaultValue,
+				Visibility:   visibility,
+				Initiative:   config.GetInitiative(cmd),
+				Risk:         risk,
 			}
-			fs.Flags = append(fs.Flags, newFlag)
-
-			// Write updated manifest
-			if err := manifest.Write(manifestPath, *fs); err != nil {
-				return fmt.Errorf("failed to write manifest: %w", err)
+			if preset != nil {
+				if preset.KillSwitch && parsedType != flagset.BoolType {
+					return fmt.Errorf("preset %q marks flags as kill switches, which must be of type boolean", config.GetPreset(cmd))
+				}
+				newFlag.KillSwitch = preset.KillSwitch
+				newFlag.ActiveUntil, err = preset.activeUntil(time.Now())
+				if err != nil {
+					return err
+				}
+			}
+			if expiry := config.GetExpiry(cmd); expiry != "" {
+				// Dates start in the timezone of the manifest
+				loc, err := flagset.Location(fs.Timezone)
+				if err != nil {
+					return err
+				}
+				until, err := parseExpiry(expiry, time.Now(), loc)
+				if err != nil {
+					return err
+				}
+				newFlag.ActiveUntil = &until
+			}
+			if err := policyError(policy.Check([]flagset.Flag{newFlag})); err != nil {
+				return err
+			}
+			// Add the flag to the existing manifest in place, keeping its formatting
+			if exists {
+				data, err := manifest.ReadData(manifestPath)
+				if err != nil {
+					return fmt.Errorf("failed to read manifest: %w", err)
+				}
+				if data, err = manifest.AddFlag(data, newFlag); err != nil {
+					return err
+				}
+				if err := manifest.WriteData(manifestPath, data); err != nil {
+					return fmt.Errorf("failed to write manifest: %w", err)
+				}
+			} else {
+				fs.Flags = append(fs.Flags, newFlag)
+				if err := manifest.Write(manifestPath, *fs); err != nil {
+					return fmt.Errorf("failed to write manifest: %w", err)
+				}
 			}
 
 			// Success message
@@ -197,6 +324,21 @@ Examples:
 	return manifestAddCmd
 }
 
+// checkDuplicateFlagKey returns an error if the manifest has a flag with the key, or with a key that
+// only differs in case or separators (e.g., new-checkout and newCheckout), whose generated accessors
+// would have the same name
+func checkDuplicateFlagKey(fs *flagset.Flagset, key string) error {
+	for _, flag := range fs.Flags {
+		if flag.Key == key {
+			return fmt.Errorf("flag '%s' already exists in the manifest", key)
+		}
+		if strcase.ToSnake(flag.Key) == strcase.ToSnake(key) {
+			return fmt.Errorf("flag '%s' conflicts with '%s' in the manifest: the keys only differ in case or separators, so their generated accessors would have the same name", key, flag.Key)
+		}
+	}
+	return nil
+}
+
 // parseFlagTypeString converts a string flag type to FlagType enum
 func parseFlagTypeString(typeStr string) (flagset.FlagType, error) {
 	switch strings.ToLower(typeStr) {
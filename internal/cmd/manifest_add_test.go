@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/h2non/gock"
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/filesystem"
 	"github.com/pterm/pterm"
@@ -172,6 +174,48 @@ func TestManifestAddCmd(t *testing.T) {
 			}`,
 			expectedError: "flag 'existing-flag' already exists in the manifest",
 		},
+		{
+			name: "error on a key that only differs in case or separators",
+			args: []string{
+				"add", "existingFlag",
+				"--default-value", "true",
+			},
+			existingManifest: `{
+				"$schema": "https://raw.githubusercontent.com/open-feature/cli/refs/heads/main/schema/v0/flag-manifest.json",
+				"flags": {
+					"existing-flag": {
+						"flagType": "boolean",
+						"defaultValue": false,
+						"description": "An existing flag"
+					}
+				}
+			}`,
+			expectedError: "flag 'existingFlag' conflicts with 'existing-flag' in the manifest",
+		},
+		{
+			name: "add flag with the short spellings of the type and default value",
+			args: []string{
+				"add", "max-retries",
+				"--type", "int",
+				"--default", "3",
+			},
+			existingManifest: `{
+				"$schema": "https://raw.githubusercontent.com/open-feature/cli/refs/heads/main/schema/v0/flag-manifest.json",
+				"flags": {}
+			}`,
+			validateResult: func(t *testing.T, fs afero.Fs) {
+				content, err := afero.ReadFile(fs, "flags.json")
+				require.NoError(t, err)
+
+				var manifest map[string]any
+				err = json.Unmarshal(content, &manifest)
+				require.NoError(t, err)
+
+				flag := manifest["flags"].(map[string]any)["max-retries"].(map[string]any)
+				assert.Equal(t, "integer", flag["flagType"])
+				assert.Equal(t, float64(3), flag["defaultValue"])
+			},
+		},
 		{
 			name: "error on missing default value",
 			args: []string{
@@ -607,3 +651,343 @@ func TestManifestAddCmd_NoFlagKeyArgument(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "flag-key argument is required when --no-input is set")
 }
+
+func TestManifestAddCmd_Preset(t *testing.T) {
+	setupPresetTest := func(t *testing.T) afero.Fs {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		setupConfigFileForTest(t, `
+presets:
+  experiment:
+    type: boolean
+    default-value: false
+    description: "Experiment flag"
+    expires-in: 60d
+  pricing:
+    type: object
+    default-value:
+      currency: EUR
+  broken:
+    expires-in: soon
+`)
+		return fs
+	}
+
+	readFlag := func(t *testing.T, fs afero.Fs, key string) map[string]any {
+		content, err := afero.ReadFile(fs, "flags.json")
+		require.NoError(t, err)
+		var manifest map[string]any
+		require.NoError(t, json.Unmarshal(content, &manifest))
+		return manifest["flags"].(map[string]any)[key].(map[string]any)
+	}
+
+	t.Run("creates the flag from the preset", func(t *testing.T) {
+		fs := setupPresetTest(t)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "new-checkout", "--preset", "experiment", "--no-input"})
+		require.NoError(t, cmd.Execute())
+
+		flag := readFlag(t, fs, "new-checkout")
+		assert.Equal(t, "boolean", flag["flagType"])
+		assert.Equal(t, false, flag["defaultValue"])
+		assert.Equal(t, "Experiment flag", flag["description"])
+
+		activeUntil, err := time.Parse(time.RFC3339, flag["activeUntil"].(string))
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(60*24*time.Hour), activeUntil, time.Minute)
+	})
+
+	t.Run("command line values take precedence over the preset", func(t *testing.T) {
+		fs := setupPresetTest(t)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "new-checkout", "--preset", "experiment", "--default-value", "true", "--description", "New checkout", "--no-input"})
+		require.NoError(t, cmd.Execute())
+
+		flag := readFlag(t, fs, "new-checkout")
+		assert.Equal(t, true, flag["defaultValue"])
+		assert.Equal(t, "New checkout", flag["description"])
+	})
+
+	t.Run("applies object default values", func(t *testing.T) {
+		fs := setupPresetTest(t)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "plans", "--preset", "pricing", "--no-input"})
+		require.NoError(t, cmd.Execute())
+
+		flag := readFlag(t, fs, "plans")
+		assert.Equal(t, "object", flag["flagType"])
+		assert.Equal(t, map[string]any{"currency": "EUR"}, flag["defaultValue"])
+		assert.NotContains(t, flag, "activeUntil")
+	})
+
+	t.Run("fails for an unknown preset", func(t *testing.T) {
+		setupPresetTest(t)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "new-checkout", "--preset", "rollout", "--no-input"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `preset "rollout" is not defined`)
+	})
+
+	t.Run("fails for an invalid expiry", func(t *testing.T) {
+		setupPresetTest(t)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "new-checkout", "--preset", "broken", "--default-value", "true", "--no-input"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid expires-in "soon"`)
+	})
+}
+
+func TestManifestAddCmd_Policy(t *testing.T) {
+	setupPolicyTest := func(t *testing.T) afero.Fs {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		setupConfigFileForTest(t, `
+policy:
+  url: "https://platform.example.com/openfeature/policy.json"
+`)
+		gock.New("https://platform.example.com").
+			Get("/openfeature/policy.json").
+			Reply(200).
+			BodyString(`{
+				"presets": {"experiment": {"type": "boolean", "default-value": false, "description": "Experiment flag"}},
+				"rules": {"requireDescription": true, "keyPattern": "^[a-z][a-z0-9-]*$"}
+			}`)
+		return fs
+	}
+
+	t.Run("rejects a flag that violates the policy", func(t *testing.T) {
+		defer gock.Off()
+		setupPolicyTest(t)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "NewCheckout", "--default-value", "true", "--no-input"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "flags violate the organization policy")
+		assert.Contains(t, err.Error(), "flag key 'NewCheckout' does not match the pattern")
+		assert.Contains(t, err.Error(), "flag 'NewCheckout' requires a description by policy")
+	})
+
+	t.Run("creates the flag from a preset of the policy", func(t *testing.T) {
+		defer gock.Off()
+		fs := setupPolicyTest(t)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "new-checkout", "--preset", "experiment", "--no-input"})
+		require.NoError(t, cmd.Execute())
+
+		content, err := afero.ReadFile(fs, "flags.json")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"description": "Experiment flag"`)
+	})
+}
+
+func TestManifestAddCmd_Visibility(t *testing.T) {
+	t.Run("writes the visibility of the flag", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "internal-limits", "--default-value", "true", "--visibility", "server", "--no-input"})
+		require.NoError(t, cmd.Execute())
+
+		content, err := afero.ReadFile(fs, "flags.json")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"visibility": "server"`)
+	})
+
+	t.Run("fails for an invalid visibility", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "internal-limits", "--default-value", "true", "--visibility", "edge", "--no-input"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid visibility: "edge"`)
+	})
+}
+
+func TestParseExpiry(t *testing.T) {
+	now := time.Date(2026, 10, 16, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		expiry string
+		want   time.Time
+	}{
+		{"2026-12-31", time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"2026-12-31T18:00:00+02:00", time.Date(2026, 12, 31, 16, 0, 0, 0, time.UTC)},
+		{"+90d", time.Date(2027, 1, 14, 0, 0, 0, 0, time.UTC)},
+		{"30d", time.Date(2026, 11, 15, 0, 0, 0, 0, time.UTC)},
+		{"+2w", time.Date(2026, 10, 30, 0, 0, 0, 0, time.UTC)},
+		{"+3m", time.Date(2027, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{"in 3 months", time.Date(2027, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{"in 1 year", time.Date(2027, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"In 10 Days", time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expiry, func(t *testing.T) {
+			got, err := parseExpiry(tt.expiry, now, time.UTC)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.UTC())
+		})
+	}
+
+	t.Run("dates start in the timezone", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/Los_Angeles")
+		require.NoError(t, err)
+
+		got, err := parseExpiry("2026-12-31", now, loc)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 12, 31, 8, 0, 0, 0, time.UTC), got.UTC())
+
+		// It is still October 16 in Los Angeles at 02:00 UTC on October 17
+		got, err = parseExpiry("+1d", time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC), loc)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 10, 17, 7, 0, 0, 0, time.UTC), got.UTC())
+	})
+
+	for _, invalid := range []string{"", "soon", "+0d", "-5d", "in three months", "12/31/2026"} {
+		t.Run("rejects "+invalid, func(t *testing.T) {
+			_, err := parseExpiry(invalid, now, time.UTC)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "invalid expiry")
+		})
+	}
+}
+
+func TestManifestAddCmd_Expiry(t *testing.T) {
+	t.Run("writes the expiry as activeUntil", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "summer-sale", "--default-value", "false", "--expiry", "2026-12-31", "--no-input"})
+		require.NoError(t, cmd.Execute())
+
+		content, err := afero.ReadFile(fs, "flags.json")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"activeUntil": "2026-12-31T00:00:00Z"`)
+	})
+
+	t.Run("fails for an invalid expiry", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "summer-sale", "--default-value", "false", "--expiry", "soon", "--no-input"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid expiry "soon"`)
+	})
+}
+
+func TestManifestAddCmd_Initiative(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+
+	cmd := GetManifestCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{"add", "new-checkout", "--default-value", "false", "--initiative", "checkout-redesign", "--no-input"})
+	require.NoError(t, cmd.Execute())
+
+	content, err := afero.ReadFile(fs, "flags.json")
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"initiative": "checkout-redesign"`)
+}
+
+func TestManifestAddCmd_Risk(t *testing.T) {
+	t.Run("sets the risk", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "payment-provider", "--type", "string", "--default-value", "stripe", "--risk", "high", "--no-input"})
+		require.NoError(t, cmd.Execute())
+
+		content, err := afero.ReadFile(fs, "flags.json")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"risk": "high"`)
+	})
+
+	t.Run("invalid risk", func(t *testing.T) {
+		filesystem.SetFileSystem(afero.NewMemMapFs())
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"add", "payment-provider", "--default-value", "false", "--risk", "extreme", "--no-input"})
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid risk: "extreme"`)
+	})
+}
+
+func TestManifestCmd_TOMLManifest(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.toml", []byte(`# Flags of the checkout service
+[flags.new-checkout]
+flagType = "boolean"
+defaultValue = false # off until launch
+activeUntil = 2026-12-31
+`), 0o644))
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs(append(args, "-m", "flags.toml", "--no-input"))
+		require.NoError(t, cmd.Execute())
+	}
+	run("add", "summer-sale", "--default-value", "false", "--description", "Summer sale banner")
+	run("set", "new-checkout", "--default", "true")
+
+	content, err := afero.ReadFile(fs, "flags.toml")
+	require.NoError(t, err)
+	assert.Equal(t, `# Flags of the checkout service
+[flags.new-checkout]
+flagType = "boolean"
+defaultValue = true # off until launch
+activeUntil = 2026-12-31
+
+[flags.summer-sale]
+defaultValue = false
+description = "Summer sale banner"
+flagType = "boolean"
+`, string(content))
+
+	run("remove", "summer-sale", "--scan=false")
+	content, err = afero.ReadFile(fs, "flags.toml")
+	require.NoError(t, err)
+	assert.Equal(t, `# Flags of the checkout service
+[flags.new-checkout]
+flagType = "boolean"
+defaultValue = true # off until launch
+activeUntil = 2026-12-31
+`, string(content))
+}
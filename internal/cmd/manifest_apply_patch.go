@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+func GetManifestApplyPatchCmd() *cobra.Command {
+	manifestApplyPatchCmd := &cobra.Command{
+		Use:   "apply-patch <patch-file>",
+		Short: "Apply a JSON Patch or JSON Merge Patch to the manifest",
+		Long: `Apply an RFC 6902 JSON Patch or an RFC 7386 JSON Merge Patch to the manifest file.
+
+The patch file holds either a JSON array of operations (add, remove, replace, move, copy, test),
+such as the json-patch output of the compare command, or a JSON object that is merged into the
+manifest. Use - to read the patch from standard input.
+
+The patched manifest is validated against the manifest schema before it is written, and a diff
+of the manifest is shown. Nothing is written if a test operation fails or the result is invalid.
+
+Examples:
+  # Apply the changes between two manifests to a third one
+  openfeature compare --manifest local.json --against main.json --output json-patch > changes.json
+  openfeature manifest apply-patch changes.json --manifest staging.json
+
+  # Preview a merge patch that changes a default value, without writing the manifest
+  echo '{"flags": {"new-checkout": {"defaultValue": true}}}' | openfeature manifest apply-patch - --dry-run`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.apply-patch")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			patchPath := args[0]
+			manifestPath := config.GetManifestPath(cmd)
+			dryRun := config.GetDryRun(cmd)
+
+			data, err := manifest.ReadData(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to read manifest: %w", err)
+			}
+			var doc any
+			if err := json.Unmarshal(data, &doc); err != nil {
+				return fmt.Errorf("failed to parse manifest %s: %w", manifestPath, err)
+			}
+
+			patch, err := readPatch(cmd, patchPath)
+			if err != nil {
+				return err
+			}
+			patched, err := manifest.ApplyPatch(doc, patch)
+			if err != nil {
+				return fmt.Errorf("failed to apply patch: %w", err)
+			}
+
+			if reflect.DeepEqual(doc, patched) {
+				pterm.Info.Printfln("The patch makes no changes to %s", manifestPath)
+				return nil
+			}
+
+			patchedData, err := marshalPatchedManifest(patched, data)
+			if err != nil {
+				return err
+			}
+			if _, err := manifest.ParseFlagSet(patchedData); err != nil {
+				return fmt.Errorf("the patched manifest is invalid: %w", err)
+			}
+
+			// The diff is of the file, which differs from the data for YAML manifests
+			original, err := filesystem.ReadFile(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to read manifest: %w", err)
+			}
+			rendered, err := manifest.Render(manifestPath, patchedData)
+			if err != nil {
+				return err
+			}
+			diff, err := unifiedDiff(original, rendered, manifestPath)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(cmd.OutOrStdout(), diff)
+
+			if dryRun {
+				pterm.Info.Printfln("Dry run: %s was not changed", manifestPath)
+				return nil
+			}
+
+			if err := manifest.WriteData(manifestPath, patchedData); err != nil {
+				return fmt.Errorf("failed to write manifest: %w", err)
+			}
+
+			pterm.Success.Printfln("Patch applied to %s", manifestPath)
+			logger.Default.Debug(fmt.Sprintf("Applied patch: patchPath=%s, manifestPath=%s", patchPath, manifestPath))
+
+			return nil
+		},
+	}
+
+	config.AddManifestApplyPatchFlags(manifestApplyPatchCmd)
+	addStabilityInfo(manifestApplyPatchCmd)
+
+	return manifestApplyPatchCmd
+}
+
+// marshalPatchedManifest formats a patched manifest as the CLI writes manifests, keeping the
+// trailing newline of the original contents if it had one
+func marshalPatchedManifest(patched any, original []byte) ([]byte, error) {
+	data, err := json.MarshalIndent(patched, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patched manifest: %w", err)
+	}
+	if bytes.HasSuffix(original, []byte("\n")) {
+		data = append(data, '\n')
+	}
+	return data, nil
+}
+
+// readPatch reads the patch file at the path, or standard input if the path is -
+func readPatch(cmd *cobra.Command, path string) ([]byte, error) {
+	if path == "-" {
+		patch, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read patch from standard input: %w", err)
+		}
+		return patch, nil
+	}
+
+	patch, err := afero.ReadFile(filesystem.FileSystem(), path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read patch: %w", err)
+	}
+	return patch, nil
+}
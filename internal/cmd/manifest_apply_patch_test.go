@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const applyPatchManifest = `{
+  "flags": {
+    "new-checkout": {
+      "defaultValue": false,
+      "description": "Use the new checkout flow",
+      "flagType": "boolean"
+    },
+    "theme": {
+      "defaultValue": "light",
+      "description": "Default theme",
+      "flagType": "string"
+    }
+  }
+}
+`
+
+func TestManifestApplyPatchCmd(t *testing.T) {
+	tests := []struct {
+		name          string
+		patch         string
+		extraArgs     []string
+		expectedError string
+		expectedDiff  string
+		wantFlags     map[string]any
+	}{
+		{
+			name: "json patch",
+			patch: `[
+				{"op": "test", "path": "/flags/new-checkout/defaultValue", "value": false},
+				{"op": "replace", "path": "/flags/new-checkout/defaultValue", "value": true},
+				{"op": "remove", "path": "/flags/theme"}
+			]`,
+			expectedDiff: `--- a/flags.json
++++ b/flags.json
+@@ -1,14 +1,9 @@
+ {
+   "flags": {
+     "new-checkout": {
+-      "defaultValue": false,
++      "defaultValue": true,
+       "description": "Use the new checkout flow",
+       "flagType": "boolean"
+-    },
+-    "theme": {
+-      "defaultValue": "light",
+-      "description": "Default theme",
+-      "flagType": "string"
+     }
+   }
+ }
+`,
+			wantFlags: map[string]any{
+				"new-checkout": map[string]any{"defaultValue": true, "description": "Use the new checkout flow", "flagType": "boolean"},
+			},
+		},
+		{
+			name:  "merge patch",
+			patch: `{"flags": {"theme": {"defaultValue": "dark"}, "new-checkout": null}}`,
+			wantFlags: map[string]any{
+				"theme": map[string]any{"defaultValue": "dark", "description": "Default theme", "flagType": "string"},
+			},
+		},
+		{
+			name:          "failed test operation",
+			patch:         `[{"op": "test", "path": "/flags/theme/defaultValue", "value": "dark"}]`,
+			expectedError: `value is "light", expected "dark"`,
+		},
+		{
+			name:          "invalid patched manifest",
+			patch:         `{"flags": {"theme": {"flagType": "color"}}}`,
+			expectedError: "the patched manifest is invalid",
+		},
+		{
+			name:          "patch that is neither an array nor an object",
+			patch:         `"flags"`,
+			expectedError: "expected a JSON Patch array of operations or a JSON Merge Patch object",
+		},
+		{
+			name:      "dry run",
+			patch:     `{"flags": {"theme": {"defaultValue": "dark"}}}`,
+			extraArgs: []string{"--dry-run"},
+			expectedDiff: `--- a/flags.json
++++ b/flags.json
+@@ -6,7 +6,7 @@
+       "flagType": "boolean"
+     },
+     "theme": {
+-      "defaultValue": "light",
++      "defaultValue": "dark",
+       "description": "Default theme",
+       "flagType": "string"
+     }
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(applyPatchManifest), 0o644))
+			require.NoError(t, afero.WriteFile(fs, "changes.json", []byte(tt.patch), 0o644))
+
+			cmd := GetManifestCmd()
+			config.AddRootFlags(cmd)
+			out := new(bytes.Buffer)
+			cmd.SetOut(out)
+			cmd.SetArgs(append([]string{"apply-patch", "changes.json", "-m", "flags.json"}, tt.extraArgs...))
+
+			err := cmd.Execute()
+
+			content, readErr := afero.ReadFile(fs, "flags.json")
+			require.NoError(t, readErr)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Equal(t, applyPatchManifest, string(content), "Manifest should not be changed")
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.expectedDiff != "" {
+				assert.Equal(t, tt.expectedDiff, out.String())
+			}
+			if tt.wantFlags == nil {
+				assert.Equal(t, applyPatchManifest, string(content), "Manifest should not be changed")
+				return
+			}
+
+			var manifest map[string]any
+			require.NoError(t, json.Unmarshal(content, &manifest))
+			assert.Equal(t, tt.wantFlags, manifest["flags"])
+			assert.True(t, strings.HasSuffix(string(content), "}\n"), "Trailing newline should be kept")
+		})
+	}
+}
+
+func TestManifestApplyPatchCmd_Stdin(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(applyPatchManifest), 0o644))
+
+	cmd := GetManifestCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetIn(strings.NewReader(`[{"op": "copy", "from": "/flags/theme", "path": "/flags/theme-v2"}]`))
+	cmd.SetArgs([]string{"apply-patch", "-", "-m", "flags.json"})
+
+	require.NoError(t, cmd.Execute())
+
+	content, err := afero.ReadFile(fs, "flags.json")
+	require.NoError(t, err)
+	var manifest map[string]any
+	require.NoError(t, json.Unmarshal(content, &manifest))
+	flags := manifest["flags"].(map[string]any)
+	assert.Equal(t, flags["theme"], flags["theme-v2"])
+}
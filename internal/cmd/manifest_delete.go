@@ -3,12 +3,14 @@ package cmd
 import (
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/filesystem"
 	"github.com/open-feature/cli/internal/flagset"
 	"github.com/open-feature/cli/internal/logger"
 	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/usage"
 	"github.com/pterm/pterm"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -16,16 +18,31 @@ import (
 
 func GetManifestDeleteCmd() *cobra.Command {
 	manifestDeleteCmd := &cobra.Command{
-		Use:   "delete <flag-name>",
-		Short: "Delete a flag from the manifest",
+		Use:     "delete <flag-name>",
+		Aliases: []string{"remove"},
+		Short:   "Delete a flag from the manifest",
 		Long: `Delete a flag from the manifest file by its key.
 
+With --scan (or scan: true under manifest.delete in the config file), the usage scanner first looks
+for references to its key in the files of --dir, as in the usage command. Files generated by the CLI
+are not counted, since regenerating them removes the flag. The flag is then not deleted while
+references remain, unless --force is set.
+
 Examples:
   # Delete a flag named 'old-feature'
   openfeature manifest delete old-feature
 
+  # The same, with the remove alias
+  openfeature manifest remove old-feature
+
   # Delete a flag from a specific manifest file
-  openfeature manifest delete old-feature --manifest path/to/flags.json`,
+  openfeature manifest delete old-feature --manifest path/to/flags.json
+
+  # Delete a flag only if the code under src no longer references it
+  openfeature manifest delete old-feature --scan --dir src
+
+  # Delete a flag that is still referenced, e.g. in the same change that removes its references
+  openfeature manifest delete old-feature --scan --force`,
 		Args: cobra.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initializeConfig(cmd, "manifest.delete")
@@ -50,24 +67,35 @@ Examples:
 				return fmt.Errorf("failed to load manifest: %w", err)
 			}
 
-			// Remove the flag
-			originalLen := len(fs.Flags)
-			fs.Flags = slices.DeleteFunc(fs.Flags, func(flag flagset.Flag) bool {
+			// Refuse to delete a flag the code still references
+			if config.GetScan(cmd) && slices.ContainsFunc(fs.Flags, func(flag flagset.Flag) bool {
 				return flag.Key == flagName
-			})
+			}) {
+				if err := checkFlagReferences(flagName, config.GetDir(cmd), manifestPath, config.GetForce(cmd)); err != nil {
+					return err
+				}
+			}
 
-			// Check if flag was found (length unchanged means nothing was deleted)
-			if len(fs.Flags) == originalLen {
-				return fmt.Errorf("flag '%s' not found in manifest", flagName)
+			// Remove the entry of the flag from the file that defines it, keeping its formatting
+			file, err := manifest.FileOf(manifestPath, flagName)
+			if err != nil {
+				return err
+			}
+			data, err := manifest.ReadData(file)
+			if err != nil {
+				return fmt.Errorf("failed to read manifest: %w", err)
+			}
+			if data, err = manifest.RemoveFlag(data, flagName); err != nil {
+				return err
 			}
 
 			// Write updated manifest
-			if err := manifest.Write(manifestPath, *fs); err != nil {
+			if err := manifest.WriteData(file, data); err != nil {
 				return fmt.Errorf("failed to write manifest: %w", err)
 			}
 
 			// Success message
-			pterm.Success.Printfln("Flag '%s' deleted successfully from %s", flagName, manifestPath)
+			pterm.Success.Printfln("Flag '%s' deleted successfully from %s", flagName, file)
 			logger.Default.Debug(fmt.Sprintf("Deleted flag: name=%s, manifestPath=%s", flagName, manifestPath))
 
 			return nil
@@ -80,3 +108,27 @@ Examples:
 
 	return manifestDeleteCmd
 }
+
+// checkFlagReferences scans the directory for references to the flag, and returns an error listing
+// them unless force is set, in which case they are listed as a warning
+func checkFlagReferences(key string, dir string, manifestPath string, force bool) error {
+	// Don't count the manifest itself as a reference
+	exclude := manifestExcludes(dir, manifestPath)
+	refs, err := usage.Scan(dir, []string{key}, usage.Options{Exclude: exclude, SkipGenerated: true})
+	if err != nil {
+		return fmt.Errorf("error scanning %s: %w", dir, err)
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+	for _, ref := range refs {
+		sb.WriteString(fmt.Sprintf("\n  - %s:%d", ref.File, ref.Line))
+	}
+	if force {
+		pterm.Warning.Printfln("Deleting flag '%s', which is still referenced in %d place(s):%s", key, len(refs), sb.String())
+		return nil
+	}
+	return fmt.Errorf("flag '%s' is still referenced in %d place(s):%s\nRemove the references first, or use --force to delete it anyway", key, len(refs), sb.String())
+}
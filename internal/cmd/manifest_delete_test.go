@@ -460,3 +460,61 @@ func TestManifestDeleteCmd_DeleteLastFlag(t *testing.T) {
 	assert.Contains(t, flags, "bbb-second")
 	assert.NotContains(t, flags, "zzz-last")
 }
+
+func TestManifestDeleteCmd_ReferencedFlag(t *testing.T) {
+	manifest := `{
+		"flags": {
+			"old-feature": {
+				"flagType": "boolean",
+				"defaultValue": false
+			}
+		}
+	}`
+	tests := []struct {
+		name          string
+		args          []string
+		expectedError string
+	}{
+		{
+			name:          "refused while referenced",
+			args:          []string{"delete", "old-feature", "--scan"},
+			expectedError: "flag 'old-feature' is still referenced in 1 place(s):\n  - src/app.go:3",
+		},
+		{
+			name: "remove alias with --force",
+			args: []string{"remove", "old-feature", "--scan", "--force"},
+		},
+		{
+			name: "without scanning by default",
+			args: []string{"delete", "old-feature"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(manifest), 0o644))
+			require.NoError(t, afero.WriteFile(fs, "src/app.go", []byte("package app\n\nvar enabled = client.Boolean(\"old-feature\")\n"), 0o644))
+			// Generated code references every flag, and is not counted
+			require.NoError(t, afero.WriteFile(fs, "openfeature/openfeature.go", []byte("// Code generated by OpenFeature CLI. DO NOT EDIT.\nconst OldFeature = \"old-feature\"\n"), 0o644))
+
+			cmd := GetManifestCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append(tt.args, "-m", "flags.json"))
+
+			err := cmd.Execute()
+
+			content, readErr := afero.ReadFile(fs, "flags.json")
+			require.NoError(t, readErr)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Contains(t, string(content), "old-feature", "Manifest should be unchanged")
+				return
+			}
+			require.NoError(t, err)
+			assert.NotContains(t, string(content), "old-feature")
+		})
+	}
+}
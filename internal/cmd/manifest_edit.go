@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetManifestEditCmd() *cobra.Command {
+	manifestEditCmd := &cobra.Command{
+		Use:   "edit <flag-key>",
+		Short: "Edit a flag of the manifest interactively",
+		Long: `Edit the default value, description and expiry of a flag in the manifest, prompting for each
+with its current value. Fields left as they are are not changed; clearing the expiry removes it.
+
+As with manifest set, the formatting of the manifest and the order of its flags and fields are kept.
+manifest set changes fields without prompts, for scripts and --no-input.
+
+Examples:
+  # Edit a flag
+  openfeature manifest edit new-checkout`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.edit")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flagKey := args[0]
+			manifestPath := config.GetManifestPath(cmd)
+			if config.ShouldDisableInteractivePrompts(cmd) {
+				return errors.New("manifest edit prompts for the fields of the flag: use manifest set to change them with --no-input")
+			}
+
+			flag, fs, err := loadFlagToEdit(manifestPath, flagKey)
+			if err != nil {
+				return err
+			}
+
+			var edits []manifest.FieldEdit
+			if flag.DefaultValueFile != "" {
+				pterm.Info.Printfln("The default value of flag '%s' is in %s: edit that file to change it", flagKey, flag.DefaultValueFile)
+			} else {
+				current, err := formatDefaultValue(flag.DefaultValue)
+				if err != nil {
+					return err
+				}
+				input, err := pterm.DefaultInteractiveTextInput.WithDefaultValue(current).
+					Show(fmt.Sprintf("Default value (%s)", flag.Type))
+				if err != nil {
+					return fmt.Errorf("failed to prompt for default value: %w", err)
+				}
+				if input = strings.TrimSpace(input); input != current {
+					value, err := parseDefaultValue(input, flag.Type)
+					if err != nil {
+						return fmt.Errorf("invalid default value for type %s: %w", flag.Type, err)
+					}
+					edits = append(edits, manifest.FieldEdit{Name: "defaultValue", Value: value})
+				}
+			}
+
+			description, err := pterm.DefaultInteractiveTextInput.WithDefaultValue(flag.Description).Show("Description")
+			if err != nil {
+				return fmt.Errorf("failed to prompt for description: %w", err)
+			}
+			if description != flag.Description {
+				edits = append(edits, manifest.FieldEdit{Name: "description", Value: description})
+			}
+
+			var currentExpiry string
+			if flag.ActiveUntil != nil {
+				currentExpiry = flag.ActiveUntil.Format(time.RFC3339)
+			}
+			expiry, err := pterm.DefaultInteractiveTextInput.WithDefaultValue(currentExpiry).
+				Show("Expiry (a date, a time from today such as +90d, or empty for none)")
+			if err != nil {
+				return fmt.Errorf("failed to prompt for expiry: %w", err)
+			}
+			switch expiry = strings.TrimSpace(expiry); {
+			case expiry == currentExpiry:
+			case expiry == "":
+				edits = append(edits, manifest.FieldEdit{Name: "activeUntil"})
+			default:
+				until, err := parseFlagExpiry(fs, expiry)
+				if err != nil {
+					return err
+				}
+				edits = append(edits, manifest.FieldEdit{Name: "activeUntil", Value: until})
+			}
+
+			if len(edits) == 0 {
+				pterm.Info.Printfln("Flag '%s' was not changed", flagKey)
+				return nil
+			}
+			return editFlag(manifestPath, flagKey, edits)
+		},
+	}
+
+	addStabilityInfo(manifestEditCmd)
+
+	return manifestEditCmd
+}
+
+// formatDefaultValue formats a default value as it is entered on the command line
+func formatDefaultValue(value any) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case map[string]any, []any:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to format default value: %w", err)
+		}
+		return string(data), nil
+	default:
+		return fmt.Sprint(value), nil
+	}
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/cobra"
+)
+
+func GetManifestExportCmd() *cobra.Command {
+	manifestExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a standalone copy of the manifest, optionally redacted for sharing",
+		Long: `Export a standalone copy of the manifest. Default values kept in external files
+(defaultValueFile) are inlined, so the exported manifest can be shared on its own.
+
+With --redact, sensitive fields are stripped or masked so the manifest can be shared with
+vendors or external auditors. The redaction is configured in the 'redact' block of .openfeature.yaml:
+
+  redact:
+    object-defaults: mask           # mask (default), strip or keep
+    internal-domains: [corp.example.com]
+    fields: [compliance, metadata.owner]
+
+- object-defaults  - mask keeps the members of object default values and replaces their values
+                     with placeholders; strip replaces them with an empty object
+- internal-domains - URLs of these domains and their subdomains are removed from descriptions,
+                     in addition to localhost, private addresses and hosts such as wiki.corp
+- fields           - Fields removed from every flag, as dot-separated paths of the flag entry
+
+Examples:
+  # Share the flag inventory with an external auditor
+  openfeature manifest export --redact --output flags.shared.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.export")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputPath := config.GetOutputPath(cmd)
+
+			var redact *manifest.RedactOptions
+			if config.GetRedact(cmd) {
+				v, err := readConfigFile()
+				if err != nil {
+					return err
+				}
+				redactConfig, err := config.LoadRedactConfig(v)
+				if err != nil {
+					return err
+				}
+				redact = &manifest.RedactOptions{
+					ObjectDefaults:  manifest.ObjectDefaultsRedaction(redactConfig.ObjectDefaults),
+					InternalDomains: redactConfig.InternalDomains,
+					Fields:          redactConfig.Fields,
+				}
+			}
+
+			fs, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			content, err := manifest.Export(fs, redact)
+			if err != nil {
+				return err
+			}
+
+			if outputPath == "" {
+				_, err := fmt.Fprintln(cmd.OutOrStdout(), string(content))
+				return err
+			}
+
+			if err := filesystem.WriteFile(outputPath, append(content, '\n')); err != nil {
+				return fmt.Errorf("error writing exported manifest: %w", err)
+			}
+
+			logger.Default.FileCreated(outputPath)
+			return nil
+		},
+	}
+
+	config.AddManifestExportFlags(manifestExportCmd)
+	addStabilityInfo(manifestExportCmd)
+
+	return manifestExportCmd
+}
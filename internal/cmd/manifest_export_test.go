@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exportManifest = `{
+  "flags": {
+    "checkout": {
+      "flagType": "object",
+      "defaultValueFile": "checkout.json",
+      "description": "Checkout settings, see https://wiki.corp/checkout"
+    }
+  }
+}`
+
+func TestManifestExportCmd(t *testing.T) {
+	t.Run("inlines default value files", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(exportManifest), 0o644))
+		require.NoError(t, afero.WriteFile(fs, "checkout.json", []byte(`{"endpoint": "https://pay.internal"}`), 0o644))
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{"export", "-m", "flags.json"})
+		require.NoError(t, cmd.Execute())
+
+		assert.Contains(t, out.String(), `"endpoint": "https://pay.internal"`)
+		assert.Contains(t, out.String(), "https://wiki.corp/checkout")
+		assert.NotContains(t, out.String(), "defaultValueFile")
+	})
+
+	t.Run("redacts with the redact block of the config", func(t *testing.T) {
+		setupConfigFileForTest(t, `
+redact:
+  object-defaults: strip
+`)
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(exportManifest), 0o644))
+		require.NoError(t, afero.WriteFile(fs, "checkout.json", []byte(`{"endpoint": "https://pay.internal"}`), 0o644))
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"export", "-m", "flags.json", "--redact", "--output", "shared.json"})
+		require.NoError(t, cmd.Execute())
+
+		content, err := afero.ReadFile(fs, "shared.json")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"defaultValue": {}`)
+		assert.Contains(t, string(content), "Checkout settings, see [internal URL]")
+		assert.NotContains(t, string(content), "pay.internal")
+	})
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/flagset"
@@ -15,8 +16,16 @@ func GetManifestListCmd() *cobra.Command {
 	manifestListCmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all flags in the manifest",
-		Long:  `Display all flags defined in the manifest file with their configuration.`,
-		Args:  cobra.NoArgs,
+		Long: `Display all flags defined in the manifest file with their configuration.
+
+With --relative, the activation window is shown relative to now, such as "expires in 12 days"
+or "expired 40 days ago", instead of as dates.`,
+		Example: `  # List the flags
+  openfeature manifest list
+
+  # Show when the flags expire relative to now
+  openfeature manifest list --relative`,
+		Args: cobra.NoArgs,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initializeConfig(cmd, "manifest.list")
 		},
@@ -29,7 +38,7 @@ func GetManifestListCmd() *cobra.Command {
 				return fmt.Errorf("failed to load manifest: %w", err)
 			}
 
-			displayFlagList(fs, manifestPath)
+			displayFlagList(fs, manifestPath, config.GetRelative(cmd))
 			return nil
 		},
 	}
@@ -41,20 +50,39 @@ func GetManifestListCmd() *cobra.Command {
 	return manifestListCmd
 }
 
-// displayFlagList prints a formatted table of all flags in the flagset
-func displayFlagList(fs *flagset.Flagset, manifestPath string) {
+// displayFlagList prints a formatted table of all flags in the flagset, with the activation window
+// relative to now if relative is set
+func displayFlagList(fs *flagset.Flagset, manifestPath string, relative bool) {
 	if len(fs.Flags) == 0 {
 		pterm.Info.Println("No flags found in manifest")
 		return
 	}
 
 	// Print header
-	pterm.DefaultSection.Println(fmt.Sprintf("Flags in %s (%d)", manifestPath, len(fs.Flags)))
+	pterm.DefaultSection.Println(fmt.Sprintf("Flags in %s (%s)", manifestPath, display.count(len(fs.Flags))))
+
+	// Only show the experiment and schedule columns when at least one flag uses them
+	showExperiments := false
+	showSchedules := false
+	for _, flag := range fs.Flags {
+		if flag.Experiment != nil {
+			showExperiments = true
+		}
+		if flag.ActiveFrom != nil || flag.ActiveUntil != nil {
+			showSchedules = true
+		}
+	}
 
 	// Create table data
-	tableData := pterm.TableData{
-		{"Key", "Type", "Default Value", "Description"},
+	header := []string{"Key", "Type", "Default Value", "Description"}
+	if showExperiments {
+		header = append(header, "Experiment")
 	}
+	if showSchedules {
+		header = append(header, "Active")
+	}
+	tableData := pterm.TableData{header}
+	now := time.Now()
 
 	for _, flag := range fs.Flags {
 		// Format default value for display
@@ -68,18 +96,70 @@ func displayFlagList(fs *flagset.Flagset, manifestPath string) {
 			description = description[:maxDescriptionLength-3] + "..."
 		}
 
-		tableData = append(tableData, []string{
+		row := []string{
 			flag.Key,
 			flag.Type.String(),
 			defaultValueStr,
 			description,
-		})
+		}
+		if showExperiments {
+			row = append(row, formatExperiment(flag.Experiment))
+		}
+		if showSchedules && relative {
+			row = append(row, formatRelativeSchedule(flag, now))
+		} else if showSchedules {
+			row = append(row, formatSchedule(flag, now))
+		}
+
+		tableData = append(tableData, row)
 	}
 
 	// Render table
 	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
 }
 
+// formatExperiment converts experiment metadata to a short string suitable for display
+func formatExperiment(experiment *flagset.Experiment) string {
+	if experiment == nil {
+		return ""
+	}
+
+	id := experiment.ID
+	if id == "" {
+		id = "unnamed"
+	}
+	if experiment.Metric != "" {
+		return fmt.Sprintf("%s (%s)", id, experiment.Metric)
+	}
+	return id
+}
+
+// formatSchedule converts the activation window of a flag to a short string suitable for display,
+// including whether the window has started or ended at the given time
+func formatSchedule(flag flagset.Flag, now time.Time) string {
+	if flag.ActiveFrom == nil && flag.ActiveUntil == nil {
+		return ""
+	}
+
+	from, until := "...", "..."
+	if flag.ActiveFrom != nil {
+		from = display.date(*flag.ActiveFrom)
+	}
+	if flag.ActiveUntil != nil {
+		until = display.date(*flag.ActiveUntil)
+	}
+
+	state := "active"
+	switch {
+	case flag.ActiveFrom != nil && now.Before(*flag.ActiveFrom):
+		state = "scheduled"
+	case !flag.IsActive(now):
+		state = "ended"
+	}
+
+	return fmt.Sprintf("%s - %s (%s)", from, until, state)
+}
+
 // formatValue converts a value to a string representation suitable for display
 func formatValue(value any) string {
 	switch v := value.(type) {
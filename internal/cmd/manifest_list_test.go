@@ -3,6 +3,7 @@ package cmd
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/filesystem"
@@ -112,6 +113,33 @@ func TestManifestListCmd(t *testing.T) {
 				"(5)",
 			},
 		},
+		{
+			name: "list flags with experiment metadata",
+			manifestContent: `{
+				"$schema": "https://raw.githubusercontent.com/open-feature/cli/refs/heads/main/schema/v0/flag-manifest.json",
+				"flags": {
+					"new-checkout": {
+						"flagType": "boolean",
+						"defaultValue": false,
+						"description": "New checkout flow",
+						"experiment": {
+							"id": "exp-42",
+							"hypothesis": "A single page checkout increases conversion",
+							"metric": "checkout_conversion"
+						}
+					},
+					"plain-flag": {
+						"flagType": "boolean",
+						"defaultValue": true
+					}
+				}
+			}`,
+			expectedInOutput: []string{
+				"Experiment",
+				"exp-42 (checkout_conversion)",
+				"(2)",
+			},
+		},
 		{
 			name:          "error on missing manifest file",
 			expectedError: "failed to load manifest",
@@ -279,7 +307,7 @@ func TestDisplayFlagList(t *testing.T) {
 			}()
 
 			// Call the function
-			displayFlagList(tt.flagset, tt.manifestPath)
+			displayFlagList(tt.flagset, tt.manifestPath, false)
 
 			// Validate output
 			output := buf.String()
@@ -351,6 +379,33 @@ func TestFormatValue(t *testing.T) {
 	}
 }
 
+func TestFormatSchedule(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	flag := flagset.Flag{Key: "sale-banner", ActiveFrom: &from, ActiveUntil: &until}
+
+	assert.Equal(t, "2026-01-01 - 2026-02-01 (scheduled)", formatSchedule(flag, from.Add(-time.Hour)))
+	assert.Equal(t, "2026-01-01 - 2026-02-01 (active)", formatSchedule(flag, from))
+	assert.Equal(t, "2026-01-01 - 2026-02-01 (ended)", formatSchedule(flag, until))
+	assert.Equal(t, "... - 2026-02-01 (active)", formatSchedule(flagset.Flag{ActiveUntil: &until}, from))
+	assert.Empty(t, formatSchedule(flagset.Flag{}, from))
+}
+
+func TestFormatRelativeSchedule(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	flag := flagset.Flag{Key: "sale-banner", ActiveFrom: &from, ActiveUntil: &until}
+
+	assert.Equal(t, "starts in 3 days", formatRelativeSchedule(flag, from.AddDate(0, 0, -3)))
+	assert.Equal(t, "expires in 12 days", formatRelativeSchedule(flag, until.AddDate(0, 0, -12)))
+	assert.Equal(t, "expires in 1 day", formatRelativeSchedule(flag, until.Add(-25*time.Hour)))
+	assert.Equal(t, "expires today", formatRelativeSchedule(flag, until.Add(-time.Hour)))
+	assert.Equal(t, "expired today", formatRelativeSchedule(flag, until))
+	assert.Equal(t, "expired 40 days ago", formatRelativeSchedule(flag, until.AddDate(0, 0, 40)))
+	assert.Equal(t, "active", formatRelativeSchedule(flagset.Flag{ActiveFrom: &from}, until))
+	assert.Empty(t, formatRelativeSchedule(flagset.Flag{}, from))
+}
+
 func TestMain(m *testing.M) {
 	// Disable pterm output during tests by default
 	pterm.DisableOutput()
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetManifestRenameCmd() *cobra.Command {
+	manifestRenameCmd := &cobra.Command{
+		Use:   "rename <old-key> <new-key>",
+		Short: "Rename a flag, and optionally the references to it in the code",
+		Long: `Rename a flag of the manifest. Only its key changes: the entry of the flag keeps its place, and the
+manifest its formatting.
+
+With --refactor, the references to the flag in the code of --dir are renamed too. They are found like
+with the usage command: the key in quotes, as in a string literal. Without it, the references that
+remain are listed. Identifiers derived from the key in generated code are only renamed by
+regenerating it.
+
+The changes are shown as a diff before they are made; --dry-run only shows them. The new key is
+refused if it is used by another flag, or only differs from another key in case or separators.
+
+Renaming a remote flag deletes the old key: push the manifest with --prune to create the renamed
+flag and delete the old one. To rename every flag with a prefix, use refactor rename-prefix.
+
+Examples:
+  # Preview the rename of a flag and of its references in the src directory
+  openfeature manifest rename new-checkout checkout-v2 --refactor --dir src --dry-run
+
+  # Rename the flag and its references
+  openfeature manifest rename new-checkout checkout-v2 --refactor --dir src`,
+		Args: cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.rename")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			oldKey, newKey := args[0], args[1]
+			manifestPath := config.GetManifestPath(cmd)
+			dir := config.GetDir(cmd)
+			refactor := config.GetRefactor(cmd)
+			dryRun := config.GetDryRun(cmd)
+
+			_, fs, err := loadFlagToEdit(manifestPath, oldKey)
+			if err != nil {
+				return err
+			}
+			others := &flagset.Flagset{Flags: slices.DeleteFunc(fs.Flags, func(flag flagset.Flag) bool {
+				return flag.Key == oldKey
+			})}
+			if err := checkDuplicateFlagKey(others, newKey); err != nil {
+				return err
+			}
+
+			// The flag is renamed in the file that defines it, which may be included by the manifest
+			file, err := manifest.FileOf(manifestPath, oldKey)
+			if err != nil {
+				return err
+			}
+			data, err := manifest.ReadData(file)
+			if err != nil {
+				return fmt.Errorf("failed to read manifest: %w", err)
+			}
+			renamed, err := manifest.RenameFlag(data, oldKey, newKey)
+			if err != nil {
+				return err
+			}
+
+			// The manifest isn't a reference
+			exclude := manifestExcludes(dir, manifestPath)
+			refs, err := usage.Scan(dir, []string{oldKey}, usage.Options{Exclude: exclude})
+			if err != nil {
+				return fmt.Errorf("error scanning %s: %w", dir, err)
+			}
+
+			// The diff is of the file, which differs from the data for YAML manifests
+			original, err := filesystem.ReadFile(file)
+			if err != nil {
+				return fmt.Errorf("failed to read manifest: %w", err)
+			}
+			rendered, err := manifest.Render(file, renamed)
+			if err != nil {
+				return err
+			}
+			renames := map[string]string{oldKey: newKey}
+			diff, err := unifiedDiff(original, rendered, file)
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(cmd.OutOrStdout(), diff)
+			if refactor {
+				files, err := usage.PreviewRename(dir, refs, renames)
+				if err != nil {
+					return fmt.Errorf("error renaming references in %s: %w", dir, err)
+				}
+				for _, file := range files {
+					diff, err := unifiedDiff(file.Original, file.Renamed, filepath.Join(dir, file.File))
+					if err != nil {
+						return err
+					}
+					fmt.Fprint(cmd.OutOrStdout(), diff)
+				}
+			}
+
+			if dryRun {
+				pterm.Info.Println("Dry run: no files were changed")
+				return nil
+			}
+
+			if err := manifest.WriteData(file, renamed); err != nil {
+				return fmt.Errorf("failed to write manifest: %w", err)
+			}
+			pterm.Success.Printfln("Flag '%s' renamed to '%s' in %s", oldKey, newKey, file)
+
+			switch {
+			case refactor:
+				files, err := usage.Rename(dir, refs, renames)
+				if err != nil {
+					return fmt.Errorf("error renaming references in %s: %w", dir, err)
+				}
+				if len(files) > 0 {
+					logger.Default.Success(fmt.Sprintf("Renamed %d reference(s) in %d file(s)", len(refs), len(files)))
+				}
+			case len(refs) > 0:
+				pterm.Warning.Printfln("%d reference(s) to '%s' remain in %s. Rename them to '%s', or use --refactor:", len(refs), oldKey, dir, newKey)
+				for _, ref := range refs {
+					pterm.Printfln("  %s:%d", filepath.Join(dir, ref.File), ref.Line)
+				}
+			}
+			return nil
+		},
+	}
+
+	config.AddManifestRenameFlags(manifestRenameCmd)
+	addStabilityInfo(manifestRenameCmd)
+
+	return manifestRenameCmd
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestRenameCmd(t *testing.T) {
+	const existingManifest = `{
+  "flags": {
+    "new-checkout": {"flagType": "boolean", "defaultValue": false},
+    "greeting": {"flagType": "string", "defaultValue": "Hi"}
+  }
+}
+`
+	const source = "if client.Boolean(\"new-checkout\", false) {\n}\n"
+
+	setup := func(t *testing.T) afero.Fs {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(existingManifest), 0o644))
+		require.NoError(t, afero.WriteFile(fs, "src/app.go", []byte(source), 0o644))
+		return fs
+	}
+	run := func(args ...string) (string, error) {
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs(append(append([]string{"rename"}, args...), "-m", "flags.json"))
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	t.Run("renames the flag and its references", func(t *testing.T) {
+		fs := setup(t)
+		out, err := run("new-checkout", "checkout-v2", "--refactor")
+		require.NoError(t, err)
+		assert.Contains(t, out, "+    \"checkout-v2\": {\"flagType\": \"boolean\", \"defaultValue\": false},")
+		assert.Contains(t, out, "+++ b/src/app.go")
+
+		content, err := afero.ReadFile(fs, "flags.json")
+		require.NoError(t, err)
+		assert.Equal(t, `{
+  "flags": {
+    "checkout-v2": {"flagType": "boolean", "defaultValue": false},
+    "greeting": {"flagType": "string", "defaultValue": "Hi"}
+  }
+}
+`, string(content))
+		code, err := afero.ReadFile(fs, "src/app.go")
+		require.NoError(t, err)
+		assert.Equal(t, "if client.Boolean(\"checkout-v2\", false) {\n}\n", string(code))
+	})
+
+	t.Run("leaves the references without --refactor", func(t *testing.T) {
+		fs := setup(t)
+		_, err := run("new-checkout", "checkout-v2")
+		require.NoError(t, err)
+
+		code, err := afero.ReadFile(fs, "src/app.go")
+		require.NoError(t, err)
+		assert.Equal(t, source, string(code))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		fs := setup(t)
+		out, err := run("new-checkout", "checkout-v2", "--refactor", "--dry-run")
+		require.NoError(t, err)
+		assert.Contains(t, out, "+++ b/src/app.go")
+
+		content, err := afero.ReadFile(fs, "flags.json")
+		require.NoError(t, err)
+		assert.Equal(t, existingManifest, string(content))
+		code, err := afero.ReadFile(fs, "src/app.go")
+		require.NoError(t, err)
+		assert.Equal(t, source, string(code))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		setup(t)
+		_, err := run("missing", "checkout-v2")
+		assert.EqualError(t, err, "flag 'missing' not found in manifest")
+
+		_, err = run("new-checkout", "greeting")
+		assert.EqualError(t, err, "flag 'greeting' already exists in the manifest")
+
+		_, err = run("greeting", "newCheckout")
+		assert.ErrorContains(t, err, "the keys only differ in case or separators")
+
+		// A key may be renamed to a spelling of itself
+		_, err = run("new-checkout", "newCheckout")
+		assert.NoError(t, err)
+	})
+}
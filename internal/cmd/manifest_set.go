@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+func GetManifestSetCmd() *cobra.Command {
+	manifestSetCmd := &cobra.Command{
+		Use:   "set <flag-key>",
+		Short: "Change the default value, description or expiry of a flag",
+		Long: `Change the default value, description or expiry of a flag in the manifest.
+
+Only the given fields change: the formatting of the manifest and the order of its flags and fields
+are kept, so the change reviews as a small diff. The default value is parsed as the type of the flag,
+and --expiry takes a date or a time from today, as in manifest add. Use manifest edit to be prompted
+for the fields instead.
+
+Examples:
+  # Turn a flag on by default
+  openfeature manifest set new-checkout --default true
+
+  # Change the description and push back the expiry
+  openfeature manifest set summer-sale --description "Summer sale banner" --expiry 2026-09-30`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.set")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flagKey := args[0]
+			manifestPath := config.GetManifestPath(cmd)
+
+			flag, fs, err := loadFlagToEdit(manifestPath, flagKey)
+			if err != nil {
+				return err
+			}
+
+			var edits []manifest.FieldEdit
+			if cmd.Flags().Changed(config.DefaultValueFlagName) {
+				defaultValueStr, _ := cmd.Flags().GetString(config.DefaultValueFlagName)
+				value, err := parseDefaultValue(defaultValueStr, flag.Type)
+				if err != nil {
+					return fmt.Errorf("invalid default value for type %s: %w", flag.Type, err)
+				}
+				edits = append(edits, manifest.FieldEdit{Name: "defaultValue", Value: value})
+			}
+			if cmd.Flags().Changed(config.DescriptionFlagName) {
+				description, _ := cmd.Flags().GetString(config.DescriptionFlagName)
+				edits = append(edits, manifest.FieldEdit{Name: "description", Value: description})
+			}
+			if expiry := config.GetExpiry(cmd); expiry != "" {
+				until, err := parseFlagExpiry(fs, expiry)
+				if err != nil {
+					return err
+				}
+				edits = append(edits, manifest.FieldEdit{Name: "activeUntil", Value: until})
+			}
+			if len(edits) == 0 {
+				return errors.New("nothing to change: set --default-value, --description or --expiry")
+			}
+
+			return editFlag(manifestPath, flagKey, edits)
+		},
+	}
+
+	config.AddManifestSetFlags(manifestSetCmd)
+	addStabilityInfo(manifestSetCmd)
+
+	return manifestSetCmd
+}
+
+// loadFlagToEdit loads the manifest and returns the flag with the key, whose default value must be
+// in the manifest to be edited
+func loadFlagToEdit(manifestPath string, key string) (*flagset.Flag, *flagset.Flagset, error) {
+	exists, err := afero.Exists(filesystem.FileSystem(), manifestPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check manifest existence: %w", err)
+	}
+	if !exists {
+		return nil, nil, fmt.Errorf("manifest file does not exist: %s", manifestPath)
+	}
+	fs, err := manifest.LoadFlagSet(manifestPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load manifest: %w", err)
+	}
+
+	index := slices.IndexFunc(fs.Flags, func(flag flagset.Flag) bool {
+		return flag.Key == key
+	})
+	if index < 0 {
+		return nil, nil, fmt.Errorf("flag '%s' not found in manifest", key)
+	}
+	return &fs.Flags[index], fs, nil
+}
+
+// parseFlagExpiry parses an expiry in the timezone of the manifest and formats it as an activeUntil time
+func parseFlagExpiry(fs *flagset.Flagset, expiry string) (string, error) {
+	loc, err := flagset.Location(fs.Timezone)
+	if err != nil {
+		return "", err
+	}
+	until, err := parseExpiry(expiry, time.Now(), loc)
+	if err != nil {
+		return "", err
+	}
+	return until.Format(time.RFC3339), nil
+}
+
+// editFlag applies the edits to the flag in the manifest, keeping the formatting of the manifest,
+// once the edited flag passes the policy of .openfeature.yaml
+func editFlag(manifestPath string, key string, edits []manifest.FieldEdit) error {
+	flag, _, err := loadFlagToEdit(manifestPath, key)
+	if err != nil {
+		return err
+	}
+	if flag.DefaultValueFile != "" && slices.ContainsFunc(edits, func(edit manifest.FieldEdit) bool {
+		return edit.Name == "defaultValue"
+	}) {
+		return fmt.Errorf("the default value of flag '%s' is in %s: edit that file instead", key, flag.DefaultValueFile)
+	}
+
+	// The flag is edited in the file that defines it, which may be included by the manifest
+	file, err := manifest.FileOf(manifestPath, key)
+	if err != nil {
+		return err
+	}
+	data, err := manifest.ReadData(file)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest: %w", err)
+	}
+	edited, err := manifest.EditFlag(data, key, edits)
+	if err != nil {
+		return err
+	}
+
+	editedFlags, err := manifest.ParseFlagSet(edited)
+	if err != nil {
+		return fmt.Errorf("the edited manifest is invalid: %w", err)
+	}
+	v, err := readConfigFile()
+	if err != nil {
+		return err
+	}
+	policy, err := loadPolicy(v)
+	if err != nil {
+		return err
+	}
+	if err := policyError(policy.Check(slices.DeleteFunc(editedFlags.Flags, func(flag flagset.Flag) bool {
+		return flag.Key != key
+	}))); err != nil {
+		return err
+	}
+
+	if err := manifest.WriteData(file, edited); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	fields := make([]string, 0, len(edits))
+	for _, edit := range edits {
+		fields = append(fields, edit.Name)
+	}
+	pterm.Success.Printfln("Flag '%s' updated in %s (%s)", key, file, strings.Join(fields, ", "))
+	logger.Default.Debug(fmt.Sprintf("Edited flag: key=%s, edits=%v", key, edits))
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestSetCmd(t *testing.T) {
+	const existingManifest = `{
+  "flags": {
+    "summer-sale": {"flagType": "boolean", "defaultValue": false, "description": "Summer sale banner"},
+    "max-items": {"flagType": "integer", "defaultValue": 10}
+  }
+}
+`
+	tests := []struct {
+		name          string
+		args          []string
+		expected      string
+		expectedError string
+	}{
+		{
+			name: "set the default value and description, keeping the formatting",
+			args: []string{"set", "summer-sale", "--default", "true", "--description", "Sale banner"},
+			expected: `{
+  "flags": {
+    "summer-sale": {"flagType": "boolean", "defaultValue": true, "description": "Sale banner"},
+    "max-items": {"flagType": "integer", "defaultValue": 10}
+  }
+}
+`,
+		},
+		{
+			name: "set the expiry",
+			args: []string{"set", "max-items", "--expiry", "2026-12-31"},
+			expected: `{
+  "flags": {
+    "summer-sale": {"flagType": "boolean", "defaultValue": false, "description": "Summer sale banner"},
+    "max-items": {"flagType": "integer", "defaultValue": 10, "activeUntil": "2026-12-31T00:00:00Z"}
+  }
+}
+`,
+		},
+		{
+			name:          "default value of the wrong type",
+			args:          []string{"set", "max-items", "--default-value", "many"},
+			expectedError: "invalid default value for type integer",
+		},
+		{
+			name:          "unknown flag",
+			args:          []string{"set", "missing", "--default-value", "true"},
+			expectedError: "flag 'missing' not found in manifest",
+		},
+		{
+			name:          "nothing to change",
+			args:          []string{"set", "max-items"},
+			expectedError: "nothing to change",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			filesystem.SetFileSystem(fs)
+			require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(existingManifest), 0o644))
+
+			cmd := GetManifestCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append(tt.args, "-m", "flags.json"))
+
+			err := cmd.Execute()
+
+			content, readErr := afero.ReadFile(fs, "flags.json")
+			require.NoError(t, readErr)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Equal(t, existingManifest, string(content), "Manifest should be unchanged")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+}
+
+func TestManifestSetCmd_YAMLManifest(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.yaml", []byte(`flags:
+  # Shown from June to August
+  summer-sale:
+    flagType: boolean
+    defaultValue: false # until the launch
+    activeUntil: 2026-08-31
+`), 0o644))
+
+	cmd := GetManifestCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs([]string{"set", "summer-sale", "--default", "true", "--description", "Summer sale banner", "-m", "flags.yaml"})
+	require.NoError(t, cmd.Execute())
+
+	content, err := afero.ReadFile(fs, "flags.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, `flags:
+  # Shown from June to August
+  summer-sale:
+    flagType: boolean
+    defaultValue: true # until the launch
+    activeUntil: 2026-08-31
+    description: Summer sale banner
+`, string(content))
+}
+
+func TestManifestSetCmd_IncludedFile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(`{"include": ["teams/*.json"], "flags": {}}`), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "teams/checkout.json", []byte(`{
+  "flags": {
+    "new-checkout": {"flagType": "boolean", "defaultValue": false},
+    "summer-sale": {"flagType": "boolean", "defaultValue": false}
+  }
+}
+`), 0o644))
+
+	run := func(args ...string) error {
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs(append(args, "-m", "flags.json", "--no-input"))
+		return cmd.Execute()
+	}
+	require.NoError(t, run("set", "new-checkout", "--default", "true"))
+	require.NoError(t, run("remove", "summer-sale", "--scan=false"))
+	assert.EqualError(t, run("add", "new-checkout", "--default-value", "true"), "flag 'new-checkout' already exists in the manifest")
+
+	content, err := afero.ReadFile(fs, "teams/checkout.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "flags": {
+    "new-checkout": {"flagType": "boolean", "defaultValue": true}
+  }
+}
+`, string(content))
+	content, err = afero.ReadFile(fs, "flags.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"include": ["teams/*.json"], "flags": {}}`, string(content))
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func GetManifestValidateCmd() *cobra.Command {
+	manifestValidateCmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check the manifest and report every problem with its position",
+		Long: `Check the manifest against the flag manifest JSON Schema, and report every problem found with the
+line and column of the value it is about, as file:line:column: message.
+
+Besides the schema, the checks cover duplicate flag keys, default values that don't match the type
+of their flag, and activeFrom and activeUntil times that are not a date (2026-12-31) or an RFC 3339
+timestamp, or that don't make a window.
+
+The files the manifest includes are checked too, and a flag defined in more than one of them is a
+problem.
+
+The command exits with a non-zero status if the manifest has problems, to fail CI jobs. Use
+--output json for the problems as JSON, with their file, type, path, line and column.
+
+Examples:
+  # Check the manifest
+  openfeature manifest validate
+
+  # Check another manifest and report the problems as JSON
+  openfeature manifest validate --manifest services/checkout/flags.json --output json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "manifest.validate")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			outputFormat := config.GetOutputPath(cmd)
+			if outputFormat != "text" && outputFormat != "json" {
+				return fmt.Errorf("invalid output format: %q. Valid formats are: text, json", outputFormat)
+			}
+
+			if _, err := filesystem.ReadFile(manifestPath); err != nil {
+				return fmt.Errorf("error reading manifest %s: %w", manifestPath, err)
+			}
+			issues, err := manifest.ValidateFiles(manifestPath)
+			if err != nil {
+				return err
+			}
+
+			if outputFormat == "json" {
+				if issues == nil {
+					issues = []manifest.FileValidationError{}
+				}
+				out, err := json.MarshalIndent(issues, "", "  ")
+				if err != nil {
+					return fmt.Errorf("error marshaling validation issues: %w", err)
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(out))
+			} else {
+				for _, issue := range issues {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s:%d:%d: %s (%s)\n", issue.File, issue.Line, issue.Column, issue.Message, issue.Path)
+				}
+			}
+
+			if len(issues) > 0 {
+				return fmt.Errorf("%s has %d problem(s)", manifestPath, len(issues))
+			}
+			if outputFormat == "text" {
+				pterm.Success.Printfln("%s is valid", manifestPath)
+			}
+			return nil
+		},
+	}
+
+	config.AddManifestValidateFlags(manifestValidateCmd)
+	addStabilityInfo(manifestValidateCmd)
+
+	return manifestValidateCmd
+}
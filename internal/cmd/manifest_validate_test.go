@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestValidateCmd(t *testing.T) {
+	const invalidManifest = `{
+  "flags": {
+    "new-checkout": {"flagType": "boolean", "defaultValue": "yes"},
+    "summer-sale": {"flagType": "boolean", "defaultValue": false, "activeUntil": "31/12/2026"}
+  }
+}
+`
+	run := func(t *testing.T, content string, args ...string) (string, error) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(content), 0o644))
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs(append([]string{"validate", "-m", "flags.json"}, args...))
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	t.Run("reports every problem with its position", func(t *testing.T) {
+		out, err := run(t, invalidManifest)
+		assert.EqualError(t, err, "flags.json has 2 problem(s)")
+		assert.Equal(t, `flags.json:3:61: defaultValue must be a boolean for a boolean flag, got "yes" (flags.new-checkout.defaultValue)
+flags.json:4:82: activeUntil must be a date (e.g., 2026-12-31) or an RFC 3339 timestamp (e.g., 2026-12-31T09:00:00Z), got "31/12/2026" (flags.summer-sale.activeUntil)
+`, out)
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		out, err := run(t, invalidManifest, "--output", "json")
+		require.Error(t, err)
+		var issues []manifest.ValidationError
+		require.NoError(t, json.Unmarshal([]byte(out), &issues))
+		require.Len(t, issues, 2)
+		assert.Equal(t, "type_mismatch", issues[0].Type)
+		assert.Equal(t, 3, issues[0].Line)
+	})
+
+	t.Run("valid manifest", func(t *testing.T) {
+		out, err := run(t, `{"flags": {"new-checkout": {"flagType": "boolean", "defaultValue": true}}}`, "--output", "json")
+		require.NoError(t, err)
+		assert.Equal(t, "[]\n", out)
+	})
+
+	t.Run("positions in a YAML manifest", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		require.NoError(t, afero.WriteFile(fs, "flags.yaml", []byte(`flags:
+  new-checkout:
+    flagType: boolean
+    defaultValue: "yes"
+`), 0o644))
+
+		cmd := GetManifestCmd()
+		config.AddRootFlags(cmd)
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"validate", "-m", "flags.yaml"})
+		assert.EqualError(t, cmd.Execute(), "flags.yaml has 1 problem(s)")
+		assert.Equal(t, `flags.yaml:4:19: defaultValue must be a boolean for a boolean flag, got "yes" (flags.new-checkout.defaultValue)
+`, out.String())
+	})
+
+	t.Run("invalid output format", func(t *testing.T) {
+		_, err := run(t, invalidManifest, "--output", "yaml")
+		assert.EqualError(t, err, `invalid output format: "yaml". Valid formats are: text, json`)
+	})
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/viper"
+)
+
+// loadPolicy fetches the organization policy configured in the policy block of the config.
+// Returns nil if no policy is configured.
+func loadPolicy(v *viper.Viper) (*manifest.Policy, error) {
+	source, err := config.LoadPolicySource(v)
+	if err != nil || source == nil {
+		return nil, err
+	}
+
+	policy, err := manifest.LoadPolicy(context.Background(), source.URL, source.PublicKey, source.CacheTTL)
+	if err != nil {
+		return nil, fmt.Errorf("error loading policy: %w", err)
+	}
+	return policy, nil
+}
+
+// policyError returns an error listing the policy violations, or nil if there are none
+func policyError(issues []manifest.ValidationError) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("flags violate the organization policy:")
+	for _, issue := range issues {
+		sb.WriteString("\n  - " + issue.Message)
+	}
+	return fmt.Errorf("%s", sb.String())
+}
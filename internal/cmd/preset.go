@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/viper"
+)
+
+// presetsConfigKey is the key of the flag presets in the config file
+const presetsConfigKey = "presets"
+
+// flagPreset is a reusable set of values for new flags, defined in the presets block of the config file
+type flagPreset struct {
+	Type         string `mapstructure:"type"`
+	DefaultValue any    `mapstructure:"default-value"`
+	Description  string `mapstructure:"description"`
+	ExpiresIn    string `mapstructure:"expires-in"`
+	KillSwitch   bool   `mapstructure:"kill-switch"`
+}
+
+// loadPreset reads the named preset from the config, falling back to the presets of the organization policy
+func loadPreset(v *viper.Viper, policy *manifest.Policy, name string) (*flagPreset, error) {
+	var preset flagPreset
+	key := presetsConfigKey + "." + name
+	if v.IsSet(key) {
+		if err := v.UnmarshalKey(key, &preset); err != nil {
+			return nil, fmt.Errorf("invalid preset %q: %w", name, err)
+		}
+		return &preset, nil
+	}
+
+	if policy != nil {
+		if values, ok := policy.Presets[name]; ok {
+			if err := mapstructure.Decode(values, &preset); err != nil {
+				return nil, fmt.Errorf("invalid preset %q in policy: %w", name, err)
+			}
+			return &preset, nil
+		}
+	}
+
+	return nil, fmt.Errorf("preset %q is not defined in the %s block of .openfeature.yaml", name, presetsConfigKey)
+}
+
+// defaultValueString returns the default value of the preset in the form accepted by --default-value,
+// and whether the preset has a default value
+func (p *flagPreset) defaultValueString() (string, bool, error) {
+	switch v := p.DefaultValue.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return v, true, nil
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", false, fmt.Errorf("invalid default value in preset: %w", err)
+		}
+		return string(data), true, nil
+	}
+}
+
+// activeUntil returns the end of the activation window of a flag created at the given time,
+// or nil if the preset does not expire flags
+func (p *flagPreset) activeUntil(now time.Time) (*time.Time, error) {
+	if p.ExpiresIn == "" {
+		return nil, nil
+	}
+	d, err := parseExpiresIn(p.ExpiresIn)
+	if err != nil {
+		return nil, err
+	}
+	until := now.Add(d).UTC().Truncate(time.Second)
+	return &until, nil
+}
+
+// parseExpiresIn parses a duration such as 60d or 12h, accepting days in addition to Go durations
+func parseExpiresIn(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid expires-in %q: expected a positive number of days (e.g., 60d)", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid expires-in %q: expected a positive duration (e.g., 60d, 12h)", s)
+	}
+	return d, nil
+}
@@ -4,14 +4,19 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/open-feature/cli/internal/config"
 	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
 	"github.com/open-feature/cli/internal/manifest"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
 
+// pullMetadataFieldsConfigKey is the key of the provider fields captured as flag metadata in the config file
+const pullMetadataFieldsConfigKey = "pull.metadata-fields"
+
 func GetPullCmd() *cobra.Command {
 	pullCmd := &cobra.Command{
 		Use:   "pull",
@@ -32,6 +37,30 @@ How it works:
 4. Prompts for missing default values (unless --no-prompt is used)
 5. Writes the complete manifest to the local file system
 
+Set --cache-ttl to reuse flags pulled from the same remote source and auth token
+within the given duration, for example when several CI steps pull the same flags.
+Cached flags are stored in the user cache directory. Use --no-cache to bypass the cache.
+Only pull uses the cache, and status reads it: compare and usage work on the pulled manifest,
+so pull once and run them against it, while push always fetches the current remote flags,
+since it creates, updates and deletes flags based on them.
+
+A pulled default value that conflicts with the type declared in the local manifest (or the
+pulled type for new flags), such as the string "true" for a boolean flag, fails the pull.
+Use --on-type-mismatch coerce to convert such values to the declared type when no
+information is lost, or --on-type-mismatch keep-local to keep the local default value.
+
+Provider-side information about the flags, such as their maintainer, tags, or creation date,
+can be captured into the metadata of the flags in the manifest. List the fields to capture in
+the pull block of .openfeature.yaml, as name=path (path is dot-separated for nested fields) or
+just the path to keep the name of the field. Without metadata-fields, no metadata is written.
+
+  pull:
+    metadata-fields:
+      - maintainer=owner.email
+      - tags
+      - createdAt=created_at
+      - archived
+
 Why pull from a remote source:
 - Centralized flag management: Keep all flag definitions in a central repository or service
 - Team collaboration: Share flag configurations across team members and environments
@@ -46,10 +75,15 @@ Why pull from a remote source:
 			manifestPath := config.GetManifestPath(cmd)
 			authToken := config.GetAuthToken(cmd)
 			noPrompt := config.GetNoPrompt(cmd)
+			onTypeMismatch := config.GetOnTypeMismatch(cmd)
 
 			if providerURL == "" {
 				return fmt.Errorf("provider URL not set in config. Please provide --provider-url or set 'provider' in .openfeature.yaml")
 			}
+			if !manifest.IsValidTypeMismatchStrategy(onTypeMismatch) {
+				return fmt.Errorf("invalid type mismatch strategy: %s. Valid strategies are: %s",
+					onTypeMismatch, strings.Join(manifest.GetValidTypeMismatchStrategies(), ", "))
+			}
 
 			// fetch the flags from the remote source
 			parsedURL, err := url.Parse(providerURL)
@@ -66,26 +100,55 @@ Why pull from a remote source:
 				}
 				flags = loadedFlags
 			case "http", "https":
-				urlContainsAFileExtension := manifest.URLLooksLikeAFile(parsedURL.String())
-				if urlContainsAFileExtension {
-					// Use direct HTTP requests for pulling flags from file-like URLs
-					loadedFlags, err := manifest.LoadFromRemote(providerURL, authToken)
-					if err != nil {
-						return fmt.Errorf("error fetching flags from remote source: %w", err)
+				cacheOpts := manifest.CacheOptions{
+					TTL:      config.GetCacheTTL(cmd),
+					Disabled: config.GetNoCache(cmd),
+				}
+				ctx, cancel := remoteContext(cmd)
+				defer cancel()
+
+				loadedFlags, err := manifest.LoadWithCache(providerURL, authToken, cacheOpts, func() (*flagset.Flagset, error) {
+					if manifest.URLLooksLikeAFile(parsedURL.String()) {
+						// Use direct HTTP requests for pulling flags from file-like URLs
+						return manifest.LoadFromRemote(ctx, providerURL, authToken)
 					}
-					flags = loadedFlags
-				} else {
 					// Use the sync API client for pulling flags
-					loadedFlags, err := manifest.LoadFromSyncAPI(providerURL, authToken)
-					if err != nil {
-						return fmt.Errorf("error fetching flags from remote source: %w", err)
-					}
-					flags = loadedFlags
+					return manifest.LoadFromSyncAPI(ctx, providerURL, authToken)
+				})
+				if err != nil {
+					return fmt.Errorf("error fetching flags from remote source: %w", timeoutError(cmd, err))
 				}
+				flags = loadedFlags
 			default:
 				return fmt.Errorf("unsupported URL scheme: %s. Supported schemes are file://, http://, and https://", parsedURL.Scheme)
 			}
 
+			// Capture the configured provider-side fields as flag metadata
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+			metadataFields, err := manifest.ParseMetadataFields(v.GetStringSlice(pullMetadataFieldsConfigKey))
+			if err != nil {
+				return fmt.Errorf("invalid %s in config: %w", pullMetadataFieldsConfigKey, err)
+			}
+			manifest.MapMetadata(flags, metadataFields)
+
+			// Don't silently write default values that conflict with the declared flag types.
+			// The local manifest declares the types of existing flags; it may not exist yet.
+			localFlags, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				logger.Default.Debug(fmt.Sprintf("Not checking pulled flags against the local manifest: %v", err))
+				localFlags = nil
+			}
+			mismatches, err := manifest.ResolveTypeMismatches(localFlags, flags, manifest.TypeMismatchStrategy(onTypeMismatch))
+			for _, mismatch := range mismatches {
+				pterm.Warning.Println(mismatch.String())
+			}
+			if err != nil {
+				return fmt.Errorf("%w. Use --on-type-mismatch coerce or keep-local to resolve them", err)
+			}
+
 			// Check each flag for null defaultValue
 			for index := range flags.Flags {
 				flag := &flags.Flags[index]
@@ -101,6 +164,11 @@ Why pull from a remote source:
 				}
 			}
 
+			// Keep the timezone of the local manifest, which remote flags don't carry
+			if localFlags != nil {
+				flags.Timezone = localFlags.Timezone
+			}
+
 			pterm.Success.Printfln("Successfully fetched flags from %s", providerURL)
 			if err := manifest.Write(manifestPath, *flags); err != nil {
 				return fmt.Errorf("error writing manifest: %w", err)
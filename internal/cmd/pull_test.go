@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/h2non/gock"
 	"github.com/open-feature/cli/internal/config"
@@ -400,4 +403,167 @@ func TestPull(t *testing.T) {
 		_, exists := flags["backwardCompatFlag"]
 		assert.True(t, exists, "Flag backwardCompatFlag should exist in manifest")
 	})
+
+	t.Run("pull with cache ttl reuses cached flags", func(t *testing.T) {
+		fs := setupTest(t)
+		defer gock.Off()
+
+		manifestResponse := map[string]any{
+			"flags": []map[string]any{
+				{
+					"key":          "cachedFlag",
+					"type":         "boolean",
+					"defaultValue": true,
+				},
+			},
+		}
+
+		pull := func(extraArgs ...string) error {
+			cmd := GetPullCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append([]string{
+				"--provider-url", "https://cache.example.com",
+				"--manifest", "manifest/path.json",
+				"--cache-ttl", "5m",
+			}, extraArgs...))
+			return cmd.Execute()
+		}
+
+		// The first pull fetches the flags from the remote source
+		gock.New("https://cache.example.com").
+			Get("/openfeature/v0/manifest").
+			Reply(200).
+			JSON(manifestResponse)
+		assert.NoError(t, pull())
+		assert.True(t, gock.IsDone())
+
+		// The second pull is served from the cache, any request would fail to match
+		assert.NoError(t, fs.Remove("manifest/path.json"))
+		assert.NoError(t, pull())
+		content, err := afero.ReadFile(fs, "manifest/path.json")
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "cachedFlag")
+
+		// --no-cache fetches the flags from the remote source again
+		gock.New("https://cache.example.com").
+			Get("/openfeature/v0/manifest").
+			Reply(200).
+			JSON(manifestResponse)
+		assert.NoError(t, pull("--no-cache"))
+		assert.True(t, gock.IsDone())
+	})
+
+	t.Run("pull with a default value that conflicts with the declared type", func(t *testing.T) {
+		fs := setupTest(t)
+		defer gock.Off()
+
+		pull := func(extraArgs ...string) error {
+			gock.New("https://mismatch.example.com").
+				Get("/openfeature/v0/manifest").
+				Reply(200).
+				JSON(map[string]any{
+					"flags": []map[string]any{
+						{"key": "mismatchedFlag", "type": "boolean", "defaultValue": "true"},
+					},
+				})
+
+			cmd := GetPullCmd()
+			config.AddRootFlags(cmd)
+			cmd.SetArgs(append([]string{
+				"--provider-url", "https://mismatch.example.com",
+				"--manifest", "manifest/path.json",
+			}, extraArgs...))
+			return cmd.Execute()
+		}
+
+		// By default the pull fails instead of writing a mismatched default value
+		err := pull()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "conflict with the declared flag types: mismatchedFlag")
+
+		// Coercing converts the value to the declared type
+		assert.NoError(t, pull("--on-type-mismatch", "coerce"))
+		content, err := afero.ReadFile(fs, "manifest/path.json")
+		assert.NoError(t, err)
+
+		var written map[string]any
+		assert.NoError(t, json.Unmarshal(content, &written))
+		flag := written["flags"].(map[string]any)["mismatchedFlag"].(map[string]any)
+		assert.Equal(t, true, flag["defaultValue"])
+	})
+
+	t.Run("pull captures the configured provider fields as metadata", func(t *testing.T) {
+		fs := setupTest(t)
+		defer gock.Off()
+		setupConfigFileForTest(t, `
+pull:
+  metadata-fields:
+    - maintainer=owner.email
+    - tags
+    - archived
+`)
+
+		gock.New("https://metadata.example.com").
+			Get("/openfeature/v0/manifest").
+			Reply(200).
+			JSON(map[string]any{
+				"flags": []map[string]any{
+					{
+						"key":          "searchRollout",
+						"type":         "boolean",
+						"defaultValue": false,
+						"owner":        map[string]any{"email": "search@example.com"},
+						"tags":         []string{"search", "rollout"},
+						"createdAt":    "2024-03-02T09:45:03.000Z",
+					},
+				},
+			})
+
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{
+			"--provider-url", "https://metadata.example.com",
+			"--manifest", "manifest/path.json",
+		})
+		assert.NoError(t, cmd.Execute())
+
+		content, err := afero.ReadFile(fs, "manifest/path.json")
+		assert.NoError(t, err)
+
+		var written map[string]any
+		assert.NoError(t, json.Unmarshal(content, &written))
+		flag := written["flags"].(map[string]any)["searchRollout"].(map[string]any)
+		assert.Equal(t, map[string]any{
+			"maintainer": "search@example.com",
+			"tags":       []any{"search", "rollout"},
+		}, flag["metadata"])
+	})
+
+	t.Run("pull with timeout cancels a slow remote source", func(t *testing.T) {
+		setupTest(t)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}))
+		defer server.Close()
+
+		cmd := GetPullCmd()
+		config.AddRootFlags(cmd)
+
+		cmd.SetArgs([]string{
+			"pull",
+			"--provider-url", server.URL,
+			"--manifest", "manifest/path.json",
+			"--timeout", "50ms",
+		})
+
+		start := time.Now()
+		err := cmd.Execute()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "operation timed out after 50ms")
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
 }
@@ -2,11 +2,18 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"os"
+	"os/user"
+	"strings"
+	"time"
 
 	"github.com/open-feature/cli/internal/api/sync"
 	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
 	"github.com/open-feature/cli/internal/manifest"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
@@ -22,10 +29,17 @@ func GetPushCmd() *cobra.Command {
 This command reads your local flag manifest and intelligently pushes it to a specified
 remote destination. It performs a smart push by:
 
-1. Fetching existing flags from the remote
+1. Fetching existing flags from the remote (never from the cache of 'openfeature pull --cache-ttl')
 2. Comparing local flags with remote flags
 3. Creating new flags that don't exist remotely
 4. Updating existing flags that have changed
+5. Deleting remote flags that are not in the manifest (only with --prune)
+
+Pruning is destructive, so it asks for confirmation before any change is made.
+Use --yes to skip the confirmation (required when prompts are disabled), and
+combine --prune with --dry-run to preview which flags would be deleted.
+With --plan, pruning is restricted to the flags listed for the provider in a plan
+written (and reviewed) with 'openfeature flags prune-plan'.
 
 This approach ensures idempotent operations and prevents conflicts.
 
@@ -35,11 +49,34 @@ api/v0/sync.yaml
 The API uses individual flag endpoints:
 - POST /openfeature/v0/manifest/flags - Creates new flags
 - PUT /openfeature/v0/manifest/flags/{key} - Updates existing flags
+- DELETE /openfeature/v0/manifest/flags/{key} - Deletes (or archives) flags when pruning
 - GET /openfeature/v0/manifest - Fetches existing flags for comparison
 
 Remote services implementing this API should accept the flag data in the format
 specified by the OpenFeature flag manifest schema.
 
+Pushing and pruning are refused in read-only mode (OPENFEATURE_READ_ONLY=1 or 'read-only'
+in .openfeature.yaml) and when the selected target restricts its 'allowed-operations'.
+They are also refused during the freeze windows of the target, unless --override-freeze gives the
+reason, which is recorded in the audit log. Dry runs are always allowed.
+
+Pushes are refused when descriptions or default values contain secret-looking values,
+such as API keys, private keys or high-entropy tokens. The scan is configured in the
+'secrets' block of .openfeature.yaml.
+
+Changing the default value of a flag with "risk": "high" needs an extra confirmation, which
+--yes doesn't give: type the confirmation phrase when asked, repeat it with --confirm-phrase,
+or reference a second approver with --approved-by (e.g., their name or the URL of their review).
+Each confirmed change is recorded in the audit log (--audit-log, openfeature-audit.jsonl by default).
+
+Flags whose compliance metadata lists allowed regions can only be pushed when
+--target-region (or 'target-region' in the push block of .openfeature.yaml) names
+one of those regions.
+
+When the provider serves flags to client-side code (--client-exposed, or 'client-exposed'
+in a target of .openfeature.yaml), a warning lists the flags with "visibility": "server"
+that are pushed to it.
+
 Note: The file:// scheme is not supported for push operations.
 For local file operations, use standard shell commands like cp or mv.`,
 		Example: `  # Push flags to a remote HTTPS endpoint (smart push: creates and updates as needed)
@@ -49,7 +86,19 @@ For local file operations, use standard shell commands like cp or mv.`,
   openfeature push --provider-url http://localhost:8080
 
   # Dry run to preview what would be sent
-  openfeature push --provider-url https://api.example.com --dry-run`,
+  openfeature push --provider-url https://api.example.com --dry-run
+
+  # Preview which remote flags would be deleted because they were removed from the manifest
+  openfeature push --provider-url https://api.example.com --prune --dry-run
+
+  # Delete remote flags that were removed from the manifest without prompting (CI)
+  openfeature push --provider-url https://api.example.com --prune --yes
+
+  # Delete only the remote flags listed in a reviewed prune plan
+  openfeature push --target prod --prune --plan prune-plan.json
+
+  # Change the default value of high-risk flags in CI, approved in a reviewed pull request
+  openfeature push --target prod --approved-by https://github.com/acme/flags/pull/42`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initializeConfig(cmd, "push")
 		},
@@ -59,6 +108,9 @@ For local file operations, use standard shell commands like cp or mv.`,
 			manifestPath := config.GetManifestPath(cmd)
 			authToken := config.GetAuthToken(cmd)
 			dryRun := config.GetDryRun(cmd)
+			prune := config.GetPrune(cmd)
+			yes := config.GetYes(cmd)
+			noInput := config.ShouldDisableInteractivePrompts(cmd)
 
 			// Validate destination URL is provided
 			if providerURL == "" {
@@ -79,16 +131,126 @@ For local file operations, use standard shell commands like cp or mv.`,
 
 			// Validation of required fields is handled by manifest.LoadFlagSet
 
+			// Enforce the organization policy, if one is configured
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+			policy, err := loadPolicy(v)
+			if err != nil {
+				return err
+			}
+			if err := policyError(policy.Check(flags.Flags)); err != nil {
+				return err
+			}
+
+			// Refuse to push secret-looking values, which would leak through the flag payloads
+			scanner, err := loadSecretScanner(v)
+			if err != nil {
+				return err
+			}
+			if err := secretsError(scanner.Scan(flags.Flags)); err != nil {
+				return err
+			}
+
 			// Handle URL schemes
 			switch parsedURL.Scheme {
 			case "file":
 				return fmt.Errorf("file:// scheme is not supported for push. Use standard shell commands (cp, mv) for local file operations")
 			case "http", "https":
+				// Refuse to push flags to a target outside the regions they are allowed in
+				if err := manifest.CheckTargetRegion(flags, config.GetTargetRegion(cmd)); err != nil {
+					return err
+				}
+
+				// Server-only flags should not reach a provider that serves client-side code
+				if config.GetClientExposed(cmd) {
+					warnServerOnlyFlags(flags)
+				}
+
+				// Refuse changes that read-only mode or the target don't allow, whatever the flags passed
+				if !dryRun {
+					operations := []string{config.OperationPush}
+					if prune {
+						operations = append(operations, config.OperationPrune)
+					}
+					if err := checkOperations(cmd, operations...); err != nil {
+						return err
+					}
+				}
+
+				// Refuse to change a frozen target, unless the freeze is overridden with a reason
+				if !dryRun {
+					entry := manifest.AuditEntry{Operation: "push", ProviderURL: providerURL, Target: config.GetTarget(cmd)}
+					if err := checkFreeze(entry, config.GetOverrideFreeze(cmd), config.GetAuditLogPath(cmd), time.Now()); err != nil {
+						return err
+					}
+				}
+
+				ctx, cancel := remoteContext(cmd)
+				defer cancel()
+
+				// Render a live progress bar while flags are written, unless output is not interactive
+				var progress pushProgress
+				defer progress.stop()
+
+				// The confirmed changes to the defaults of high-risk flags are recorded in the audit log
+				var audit *manifest.AuditEntry
+				opts := manifest.PushOptions{
+					DryRun: dryRun,
+					Prune:  prune,
+					ConfirmPrune: func(toDelete []flagset.Flag) (bool, error) {
+						return confirmPrune(toDelete, yes, noInput)
+					},
+					ConfirmHighRisk: func(changes []sync.DefaultChange) error {
+						entry, err := confirmHighRiskChanges(changes, config.GetConfirmPhrase(cmd), config.GetApprovedBy(cmd), noInput)
+						if err != nil {
+							return err
+						}
+						entry.ProviderURL = providerURL
+						entry.Target = config.GetTarget(cmd)
+						audit = &entry
+						return nil
+					},
+				}
+				if !noInput {
+					opts.Progress = progress.report
+				}
+				if planPath := config.GetPlanPath(cmd); planPath != "" {
+					if !prune {
+						return fmt.Errorf("--plan can only be used with --prune")
+					}
+					plan, err := manifest.LoadPrunePlan(planPath)
+					if err != nil {
+						return err
+					}
+					keys, ok := plan.Keys(providerURL)
+					if !ok {
+						return fmt.Errorf("prune plan %s has no target with provider URL %s", planPath, providerURL)
+					}
+					opts.PruneKeys = keys
+				}
+
 				// Perform smart push (fetches remote, compares, and creates/updates as needed)
 				// In dry run mode, performs comparison but skips actual API calls
-				result, err := manifest.SaveToRemote(providerURL, flags, authToken, dryRun)
+				result, err := manifest.SaveToRemote(ctx, providerURL, flags, authToken, opts)
+				progress.stop()
+				if audit != nil {
+					if err != nil {
+						audit.Error = err.Error()
+					}
+					auditLogPath := config.GetAuditLogPath(cmd)
+					if auditErr := manifest.AppendAuditLog(auditLogPath, *audit); auditErr != nil {
+						if err == nil {
+							return fmt.Errorf("flags were pushed, but recording the high-risk changes failed: %w", auditErr)
+						}
+						pterm.Warning.Printfln("Recording the high-risk changes failed: %v", auditErr)
+					} else {
+						logger.Default.Info(fmt.Sprintf("Recorded the high-risk changes in %s", auditLogPath))
+					}
+				}
 				if err != nil {
-					return fmt.Errorf("error pushing flags to remote destination: %w", err)
+					return fmt.Errorf("error pushing flags to remote destination: %w", timeoutError(cmd, err))
 				}
 
 				// Display the results
@@ -110,10 +272,179 @@ For local file operations, use standard shell commands like cp or mv.`,
 	return pushCmd
 }
 
+// warnServerOnlyFlags warns about the flags that are pushed to a client-exposed provider
+// although their visibility is server only
+func warnServerOnlyFlags(flags *flagset.Flagset) {
+	var keys []string
+	for _, flag := range flags.Flags {
+		if !flag.IsClientVisible() {
+			keys = append(keys, flag.Key)
+		}
+	}
+	if len(keys) > 0 {
+		pterm.Warning.Printf("Pushing server-only flags to a client-exposed provider: %s\n", strings.Join(keys, ", "))
+	}
+}
+
+// confirmPrune asks the user to confirm the deletion of the given remote flags.
+// When prompts are disabled, the deletion must be confirmed up front with --yes.
+func confirmPrune(toDelete []flagset.Flag, yes bool, noInput bool) (bool, error) {
+	if yes {
+		return true, nil
+	}
+
+	if noInput {
+		return false, fmt.Errorf("refusing to delete %d remote flag(s) without confirmation. Re-run with --yes to confirm", len(toDelete))
+	}
+
+	pterm.Warning.Printf("The following %d remote flag(s) are not in the manifest and will be deleted:\n", len(toDelete))
+	for _, flag := range toDelete {
+		pterm.FgRed.Printf("  - %s\n", flag.Key)
+	}
+
+	confirmed, err := pterm.DefaultInteractiveConfirm.Show("Delete these flags from the remote?")
+	if err != nil {
+		return false, fmt.Errorf("failed to show confirmation prompt: %w", err)
+	}
+	return confirmed, nil
+}
+
+// highRiskConfirmationPhrase is typed, or passed with --confirm-phrase, to change the default value
+// of high-risk flags
+const highRiskConfirmationPhrase = "change high-risk defaults"
+
+// confirmHighRiskChanges confirms the changes to the default values of high-risk flags, with a
+// second approver, the confirmation phrase or, if prompts are enabled, by asking for the phrase.
+// Returns the audit entry recording how the changes were confirmed.
+func confirmHighRiskChanges(changes []sync.DefaultChange, phrase string, approvedBy string, noInput bool) (manifest.AuditEntry, error) {
+	entry := manifest.AuditEntry{Time: time.Now().UTC(), Operation: "push", User: auditUser()}
+	keys := make([]string, 0, len(changes))
+	for _, change := range changes {
+		keys = append(keys, change.Flag.Key)
+		entry.Changes = append(entry.Changes, manifest.AuditFlagChange{
+			Key:  change.Flag.Key,
+			Risk: change.Flag.Risk,
+			From: change.Previous,
+			To:   change.Flag.DefaultValue,
+		})
+	}
+
+	switch {
+	case approvedBy != "":
+		entry.ConfirmedBy = manifest.AuditConfirmedByApprover
+		entry.ApprovedBy = approvedBy
+		pterm.Info.Printfln("Changing the default value of high-risk flag(s) %s, approved by %s", strings.Join(keys, ", "), approvedBy)
+		return entry, nil
+	case phrase != "":
+		if phrase != highRiskConfirmationPhrase {
+			return entry, fmt.Errorf("push aborted: the confirmation phrase is %q", highRiskConfirmationPhrase)
+		}
+		entry.ConfirmedBy = manifest.AuditConfirmedByPhrase
+		return entry, nil
+	case noInput:
+		return entry, fmt.Errorf("refusing to change the default value of high-risk flag(s) %s without confirmation. Re-run with --confirm-phrase %q or --approved-by <reference of the second approver>",
+			strings.Join(keys, ", "), highRiskConfirmationPhrase)
+	}
+
+	pterm.Warning.Printf("The default value of the following %d high-risk flag(s) will change:\n", len(changes))
+	for _, change := range changes {
+		from, _ := json.Marshal(change.Previous)
+		to, _ := json.Marshal(change.Flag.DefaultValue)
+		pterm.FgRed.Printf("  ~ %s: %s → %s\n", change.Flag.Key, from, to)
+	}
+
+	typed, err := pterm.DefaultInteractiveTextInput.Show(fmt.Sprintf("Type %q to confirm", highRiskConfirmationPhrase))
+	if err != nil {
+		return entry, fmt.Errorf("failed to show confirmation prompt: %w", err)
+	}
+	if strings.TrimSpace(typed) != highRiskConfirmationPhrase {
+		return entry, errors.New("push aborted: the change to high-risk flags was not confirmed")
+	}
+	entry.ConfirmedBy = manifest.AuditConfirmedByPhrase
+	return entry, nil
+}
+
+// auditUser returns the name of the local user, for the audit log
+func auditUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// checkFreeze returns an error if a freeze window of the config applies to the target of the entry at
+// the given time. When overrideReason is set, a warning with the reason is printed instead, and the
+// override is recorded in the audit log at auditLogPath.
+func checkFreeze(entry manifest.AuditEntry, overrideReason string, auditLogPath string, now time.Time) error {
+	v, err := readConfigFile()
+	if err != nil {
+		return err
+	}
+
+	windows, err := config.LoadFreezeWindows(v)
+	if err != nil {
+		return err
+	}
+
+	window := config.ActiveFreeze(windows, entry.Target, now)
+	if window == nil {
+		return nil
+	}
+
+	description := fmt.Sprintf("frozen until %s", window.Until.Format(time.RFC3339))
+	if window.Reason != "" {
+		description += fmt.Sprintf(" (%s)", window.Reason)
+	}
+
+	if overrideReason == "" {
+		return fmt.Errorf("push refused: the target is %s. Use --override-freeze \"<reason>\" to push anyway", description)
+	}
+
+	entry.Time = now.UTC()
+	entry.User = auditUser()
+	entry.ConfirmedBy = manifest.AuditConfirmedByOverrideFreeze
+	entry.Reason = overrideReason
+	if err := manifest.AppendAuditLog(auditLogPath, entry); err != nil {
+		return fmt.Errorf("recording the freeze override failed: %w", err)
+	}
+
+	pterm.Warning.Printfln("Overriding freeze: the target is %s. Reason: %s", description, overrideReason)
+	return nil
+}
+
+// pushProgress renders the progress of a push as a live progress bar.
+// A new bar is started for each step of the push (writing flags, deleting flags).
+type pushProgress struct {
+	bar *pterm.ProgressbarPrinter
+}
+
+// report advances the progress bar for the given event
+func (p *pushProgress) report(event sync.ProgressEvent) {
+	if event.Done == 1 {
+		p.stop()
+		p.bar, _ = pterm.DefaultProgressbar.WithTotal(event.Total).WithTitle("Pushing flags").WithRemoveWhenDone().Start()
+	}
+	if p.bar == nil {
+		return
+	}
+
+	verb := map[string]string{"create": "Created", "update": "Updated", "delete": "Deleted"}[event.Operation]
+	p.bar.UpdateTitle(fmt.Sprintf("%s %s", verb, event.Flag.Key))
+	p.bar.Increment()
+}
+
+// stop stops the current progress bar, for example when the push fails part way
+func (p *pushProgress) stop() {
+	if p.bar != nil && p.bar.IsActive {
+		_, _ = p.bar.Stop()
+	}
+	p.bar = nil
+}
+
 // displayPushResults renders the push operation results with color-coded output
 // If dryRun is true, displays what would be pushed instead of what was pushed
 func displayPushResults(result *sync.PushResult, destination string, dryRun bool) {
-	totalChanges := len(result.Created) + len(result.Updated)
+	totalChanges := len(result.Created) + len(result.Updated) + len(result.Deleted)
 
 	// Extract just the base URL (domain) for cleaner display
 	displayURL := destination
@@ -197,4 +528,23 @@ func displayPushResults(result *sync.PushResult, destination string, dryRun bool
 		}
 		fmt.Println()
 	}
+
+	// Display deleted flags
+	if len(result.Deleted) > 0 {
+		if dryRun {
+			pterm.FgRed.Printf("◆ Would Delete (%d):\n", len(result.Deleted))
+		} else {
+			pterm.FgRed.Printf("◆ Deleted (%d):\n", len(result.Deleted))
+		}
+
+		for _, flag := range result.Deleted {
+			pterm.FgRed.Printf("  - %s", flag.Key)
+
+			if flag.Description != "" {
+				fmt.Printf(" - %s", flag.Description)
+			}
+			fmt.Println()
+		}
+		fmt.Println()
+	}
 }
@@ -0,0 +1,320 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/api/sync"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// reconcileTarget is a target of the reconcile file, with its resolved provider settings
+type reconcileTarget struct {
+	Name        string
+	ProviderURL string
+	AuthToken   string
+	Prune       bool
+	// RequiredApprovals is the number of distinct approvers the changes to the target need
+	RequiredApprovals int
+	// Plan is the result of the dry run against the target
+	Plan *sync.PushResult
+}
+
+// changes returns the number of changes the plan of the target makes
+func (t reconcileTarget) changes() int {
+	return len(t.Plan.Created) + len(t.Plan.Updated) + len(t.Plan.Deleted)
+}
+
+func GetReconcileCmd() *cobra.Command {
+	reconcileCmd := &cobra.Command{
+		Use:   "reconcile",
+		Short: "Bring every target to the state declared in a reconcile file",
+		Long: `Bring the flags of every target to the state declared in a reconcile file (reconcile.yaml by default).
+
+The reconcile file lists the targets that should have the flags of the manifest, and the policies
+that apply to them:
+
+  manifest: flags.json          # relative to the reconcile file, defaults to --manifest
+  targets:
+    staging: {}                 # provider settings from the staging target of .openfeature.yaml
+    prod: {}
+    web:
+      provider-url: https://flags.example.com/web
+      prune: false              # overrides the prune policy
+  policies:
+    prune: true                 # delete the remote flags that are not in the manifest
+    protected: [prod]           # changes to these targets need approvals
+    approvals-required: 2       # distinct --approved-by references, 1 by default
+
+Targets take their provider-url and auth-token from the reconcile file, or from the target of the same
+name in .openfeature.yaml. The read-only mode, allowed operations, target regions and freeze windows of
+.openfeature.yaml apply to them as they do to push.
+
+reconcile first computes the plan: the flags created, updated and deleted on every target. It then
+checks every change is allowed, that protected targets with changes have enough approvers, and asks
+for confirmation (--yes skips it) before applying the plan target by target. Nothing is applied if a
+check fails. Use --dry-run to only show the plan.
+
+Changing the default value of high-risk flags needs an extra confirmation, as with push: the
+approvers, the confirmation phrase (--confirm-phrase) or the phrase typed when asked. These changes
+are recorded in the audit log, as are the freeze windows overridden with --override-freeze.`,
+		Example: `  # Show the plan
+  openfeature reconcile --dry-run
+
+  # Apply the plan, approved by two reviewers of the pull request changing the manifest
+  openfeature reconcile --yes --approved-by alice --approved-by bob
+
+  # Use another reconcile file
+  openfeature reconcile -f infra/flags.yaml`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "reconcile")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reconcilePath := config.GetReconcilePath(cmd)
+			dryRun := config.GetDryRun(cmd)
+			noInput := config.ShouldDisableInteractivePrompts(cmd)
+			approvers := distinctApprovers(config.GetApprovers(cmd))
+
+			data, err := filesystem.ReadFile(reconcilePath)
+			if err != nil {
+				return fmt.Errorf("error reading reconcile file %s: %w", reconcilePath, err)
+			}
+			reconcile, err := config.ParseReconcile(data)
+			if err != nil {
+				return fmt.Errorf("%s: %w", reconcilePath, err)
+			}
+
+			manifestPath := config.GetManifestPath(cmd)
+			if reconcile.Manifest != "" {
+				manifestPath = filepath.Join(filepath.Dir(reconcilePath), reconcile.Manifest)
+			}
+			flags, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("error loading manifest from %s: %w", manifestPath, err)
+			}
+
+			// The manifest is checked once, as push checks it for a single target
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+			policy, err := loadPolicy(v)
+			if err != nil {
+				return err
+			}
+			if err := policyError(policy.Check(flags.Flags)); err != nil {
+				return err
+			}
+			scanner, err := loadSecretScanner(v)
+			if err != nil {
+				return err
+			}
+			if err := secretsError(scanner.Scan(flags.Flags)); err != nil {
+				return err
+			}
+
+			// Plan: a dry run against every target
+			targets := make([]reconcileTarget, 0, len(reconcile.Targets))
+			for _, name := range reconcile.TargetNames() {
+				target := reconcileTarget{
+					Name:              name,
+					ProviderURL:       reconcile.Targets[name].ProviderURL,
+					AuthToken:         reconcile.Targets[name].AuthToken,
+					Prune:             reconcile.Prunes(name),
+					RequiredApprovals: reconcile.RequiredApprovals(name),
+				}
+				prefix := "targets." + name + "."
+				if target.ProviderURL == "" {
+					target.ProviderURL = v.GetString(prefix + config.ProviderURLFlagName)
+				}
+				if target.AuthToken == "" {
+					target.AuthToken = v.GetString(prefix + config.AuthTokenFlagName)
+				}
+				if target.ProviderURL == "" {
+					return fmt.Errorf("target %s has no provider-url in the reconcile file or the targets block of .openfeature.yaml", name)
+				}
+				if parsedURL, err := url.Parse(target.ProviderURL); err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+					return fmt.Errorf("target %s: invalid provider-url %q. Supported schemes are http:// and https://", name, target.ProviderURL)
+				}
+
+				if err := manifest.CheckTargetRegion(flags, v.GetString(prefix+config.TargetRegionFlagName)); err != nil {
+					return fmt.Errorf("target %s: %w", name, err)
+				}
+				if v.GetBool(prefix + config.ClientExposedFlagName) {
+					warnServerOnlyFlags(flags)
+				}
+
+				ctx, cancel := remoteContext(cmd)
+				target.Plan, err = manifest.SaveToRemote(ctx, target.ProviderURL, flags, target.AuthToken, manifest.PushOptions{
+					DryRun: true,
+					Prune:  target.Prune,
+				})
+				cancel()
+				if err != nil {
+					return fmt.Errorf("error planning target %s: %w", name, timeoutError(cmd, err))
+				}
+				targets = append(targets, target)
+			}
+
+			changed := slices.DeleteFunc(slices.Clone(targets), func(target reconcileTarget) bool {
+				return target.changes() == 0
+			})
+			displayReconcilePlan(targets)
+			if len(changed) == 0 {
+				pterm.Success.Println("Every target is up to date")
+				return nil
+			}
+			if dryRun {
+				return nil
+			}
+
+			// Check the whole plan before applying any of it
+			now := time.Now()
+			for _, target := range changed {
+				operations := []string{config.OperationPush}
+				if len(target.Plan.Deleted) > 0 {
+					operations = append(operations, config.OperationPrune)
+				}
+				for _, operation := range operations {
+					if err := config.CheckOperation(v, os.Getenv, target.Name, operation); err != nil {
+						return err
+					}
+				}
+				entry := manifest.AuditEntry{Operation: "reconcile", ProviderURL: target.ProviderURL, Target: target.Name}
+				if err := checkFreeze(entry, config.GetOverrideFreeze(cmd), config.GetAuditLogPath(cmd), now); err != nil {
+					return fmt.Errorf("target %s: %w", target.Name, err)
+				}
+				if len(approvers) < target.RequiredApprovals {
+					return fmt.Errorf("target %s is protected: its %d change(s) need %d approval(s), got %d. Pass each approver with --approved-by",
+						target.Name, target.changes(), target.RequiredApprovals, len(approvers))
+				}
+			}
+
+			if !config.GetYes(cmd) {
+				if noInput {
+					return fmt.Errorf("refusing to apply the plan to %d target(s) without confirmation. Re-run with --yes to confirm", len(changed))
+				}
+				confirmed, err := pterm.DefaultInteractiveConfirm.Show(fmt.Sprintf("Apply the plan to %d target(s)?", len(changed)))
+				if err != nil {
+					return fmt.Errorf("failed to show confirmation prompt: %w", err)
+				}
+				if !confirmed {
+					pterm.Info.Println("Nothing was applied")
+					return nil
+				}
+			}
+
+			// The changes to high-risk flags are confirmed once for the whole plan
+			phrase := config.GetConfirmPhrase(cmd)
+			for i, target := range changed {
+				var audit *manifest.AuditEntry
+				pruneKeys := map[string]bool{}
+				for _, flag := range target.Plan.Deleted {
+					pruneKeys[flag.Key] = true
+				}
+
+				ctx, cancel := remoteContext(cmd)
+				result, err := manifest.SaveToRemote(ctx, target.ProviderURL, flags, target.AuthToken, manifest.PushOptions{
+					Prune: target.Prune,
+					// Only the flags of the reviewed plan are deleted, even if the remote changed since
+					PruneKeys: pruneKeys,
+					ConfirmHighRisk: func(changes []sync.DefaultChange) error {
+						entry, err := confirmHighRiskChanges(changes, phrase, strings.Join(approvers, ", "), noInput)
+						if err != nil {
+							return err
+						}
+						if entry.ConfirmedBy == manifest.AuditConfirmedByPhrase {
+							phrase = highRiskConfirmationPhrase
+						}
+						entry.Operation = "reconcile"
+						entry.ProviderURL = target.ProviderURL
+						entry.Target = target.Name
+						audit = &entry
+						return nil
+					},
+				})
+				cancel()
+				if audit != nil {
+					if err != nil {
+						audit.Error = err.Error()
+					}
+					if auditErr := manifest.AppendAuditLog(config.GetAuditLogPath(cmd), *audit); auditErr != nil {
+						pterm.Warning.Printfln("Recording the high-risk changes to %s failed: %v", target.Name, auditErr)
+					}
+				}
+				if err != nil {
+					applied := make([]string, 0, i)
+					for _, done := range changed[:i] {
+						applied = append(applied, done.Name)
+					}
+					if len(applied) > 0 {
+						return fmt.Errorf("error applying the plan to %s (already applied to %s): %w", target.Name, strings.Join(applied, ", "), timeoutError(cmd, err))
+					}
+					return fmt.Errorf("error applying the plan to %s: %w", target.Name, timeoutError(cmd, err))
+				}
+				logger.Default.Success(fmt.Sprintf("Applied the plan to %s: %d created, %d updated, %d deleted",
+					target.Name, len(result.Created), len(result.Updated), len(result.Deleted)))
+			}
+			return nil
+		},
+	}
+
+	config.AddReconcileFlags(reconcileCmd)
+	addStabilityInfo(reconcileCmd)
+
+	return reconcileCmd
+}
+
+// distinctApprovers returns the approvers without blanks and duplicates, so that an approver
+// passed twice is counted once
+func distinctApprovers(approvers []string) []string {
+	var distinct []string
+	for _, approver := range approvers {
+		approver = strings.TrimSpace(approver)
+		if approver != "" && !slices.Contains(distinct, approver) {
+			distinct = append(distinct, approver)
+		}
+	}
+	return distinct
+}
+
+// displayReconcilePlan prints the changes the plan makes to each target
+func displayReconcilePlan(targets []reconcileTarget) {
+	for _, target := range targets {
+		heading := fmt.Sprintf("%s (%s)", target.Name, target.ProviderURL)
+		if target.RequiredApprovals > 0 {
+			heading += fmt.Sprintf(", protected: %d approval(s) required", target.RequiredApprovals)
+		}
+		pterm.DefaultSection.Println(heading)
+		if target.changes() == 0 {
+			pterm.Success.Println("Up to date")
+			continue
+		}
+
+		for _, change := range []struct {
+			symbol string
+			flags  []flagset.Flag
+			color  pterm.Color
+		}{
+			{"+", target.Plan.Created, pterm.FgGreen},
+			{"~", target.Plan.Updated, pterm.FgYellow},
+			{"-", target.Plan.Deleted, pterm.FgRed},
+		} {
+			for _, flag := range change.flags {
+				change.color.Printfln("  %s %s", change.symbol, flag.Key)
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const reconcileManifest = `{
+	"flags": {
+		"new-checkout": {"flagType": "boolean", "defaultValue": true, "description": "New checkout"}
+	}
+}`
+
+const reconcileFile = `
+targets:
+  staging: {}
+  prod: {}
+policies:
+  prune: true
+  protected: [prod]
+`
+
+func setupReconcileTest(t *testing.T) {
+	setupConfigFileForTest(t, `
+targets:
+  staging:
+    provider-url: https://staging.example.com
+  prod:
+    provider-url: https://prod.example.com
+`)
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(reconcileManifest), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "reconcile.yaml", []byte(reconcileFile), 0o644))
+}
+
+// mockReconcileRemotes mocks the flags of the staging and prod targets, times times each
+func mockReconcileRemotes(times int) {
+	gock.New("https://staging.example.com").
+		Get("/openfeature/v0/manifest").
+		Times(times).
+		Reply(200).
+		JSON(map[string]any{"flags": []map[string]any{
+			{"key": "new-checkout", "type": "boolean", "defaultValue": true, "description": "New checkout"},
+			{"key": "old-checkout", "type": "boolean", "defaultValue": false},
+		}})
+	gock.New("https://prod.example.com").
+		Get("/openfeature/v0/manifest").
+		Times(times).
+		Reply(200).
+		JSON(map[string]any{"flags": []map[string]any{}})
+}
+
+func executeReconcileCmd(args ...string) error {
+	cmd := GetReconcileCmd()
+	config.AddRootFlags(cmd)
+	cmd.SetArgs(append(args, "--manifest", "flags.json", "--no-input"))
+	return cmd.Execute()
+}
+
+func TestReconcile(t *testing.T) {
+	t.Run("dry run only plans", func(t *testing.T) {
+		setupReconcileTest(t)
+		defer gock.Off()
+		mockReconcileRemotes(1)
+
+		require.NoError(t, executeReconcileCmd("--dry-run"))
+		assert.True(t, gock.IsDone(), "Not all expected HTTP requests were made")
+	})
+
+	t.Run("protected targets need approvals", func(t *testing.T) {
+		setupReconcileTest(t)
+		defer gock.Off()
+		mockReconcileRemotes(1)
+
+		err := executeReconcileCmd("--yes")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "target prod is protected: its 1 change(s) need 1 approval(s), got 0")
+		assert.True(t, gock.IsDone(), "Nothing is applied when a check fails")
+	})
+
+	t.Run("applying needs a confirmation", func(t *testing.T) {
+		setupReconcileTest(t)
+		defer gock.Off()
+		mockReconcileRemotes(1)
+
+		err := executeReconcileCmd("--approved-by", "alice")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Re-run with --yes to confirm")
+	})
+
+	t.Run("applies the plan to every target", func(t *testing.T) {
+		setupReconcileTest(t)
+		defer gock.Off()
+		mockReconcileRemotes(2)
+		gock.New("https://prod.example.com").
+			Post("/openfeature/v0/manifest/flags").
+			Reply(201).
+			JSON(map[string]any{"flag": map[string]any{"key": "new-checkout"}})
+		gock.New("https://staging.example.com").
+			Delete("/openfeature/v0/manifest/flags/old-checkout").
+			Reply(204)
+
+		require.NoError(t, executeReconcileCmd("--yes", "--approved-by", "alice"))
+		assert.True(t, gock.IsDone(), "Not all expected HTTP requests were made")
+	})
+
+	t.Run("read-only mode refuses the plan", func(t *testing.T) {
+		setupReconcileTest(t)
+		defer gock.Off()
+		mockReconcileRemotes(1)
+		t.Setenv("OPENFEATURE_READ_ONLY", "1")
+
+		err := executeReconcileCmd("--yes", "--approved-by", "alice")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "read-only mode is enabled")
+	})
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func GetRefactorCmd() *cobra.Command {
+	refactorCmd := &cobra.Command{
+		Use:   "refactor",
+		Short: "Refactor flags across manifests and code",
+		Long:  `Commands for coordinated changes to flags across the manifests of a workspace, the code referencing them and the remote providers.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceErrors:              true,
+		SilenceUsage:               true,
+		DisableSuggestions:         false,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	refactorCmd.AddCommand(GetRefactorRenamePrefixCmd())
+
+	addStabilityInfo(refactorCmd)
+
+	return refactorCmd
+}
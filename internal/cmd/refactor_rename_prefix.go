@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/terminal"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// workspaceManifestsConfigKey lists the manifests of the workspace, for commands that change all of them
+const workspaceManifestsConfigKey = "manifests"
+
+// renamedManifest is a manifest with the flags renamed by refactor rename-prefix
+type renamedManifest struct {
+	Path    string
+	Data    []byte
+	Renames map[string]string
+}
+
+func GetRefactorRenamePrefixCmd() *cobra.Command {
+	renamePrefixCmd := &cobra.Command{
+		Use:   "rename-prefix",
+		Short: "Rename the flags whose key starts with a prefix, in every manifest and in the code",
+		Long: `Rename the flags whose key starts with a prefix in every manifest of the workspace, and the
+references to them in the code.
+
+The manifests are listed in the manifests block of .openfeature.yaml, or default to --manifest:
+
+  manifests:
+    - services/checkout/flags.json
+    - web/flags.json
+
+The references are found like with the usage command: a flag key in quotes, as in a string literal.
+Generated code is rewritten like any other code, but identifiers derived from the keys are only
+renamed by regenerating it.
+
+Nothing is renamed if a new key is already used by another flag. Renaming remote flags deletes the
+old keys, so for the named targets of .openfeature.yaml, a plan deleting the old keys is written.
+Push each manifest with the plan to create the renamed flags and delete the old ones:
+
+  openfeature push --target prod --prune --plan rename-plan.json`,
+		Example: `  # Preview the renames
+  openfeature refactor rename-prefix --from exp- --to experiment- --dry-run
+
+  # Rename the flags and their references in the src directory
+  openfeature refactor rename-prefix --from exp- --to experiment- --dir src`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "refactor.rename-prefix")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from := config.GetFrom(cmd)
+			to := config.GetTo(cmd)
+			dir := config.GetDir(cmd)
+			planPath := config.GetPlanPath(cmd)
+			dryRun := config.GetDryRun(cmd)
+			if from == "" {
+				return fmt.Errorf("--%s is required", config.FromFlagName)
+			}
+
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+			manifestPaths := v.GetStringSlice(workspaceManifestsConfigKey)
+			if len(manifestPaths) == 0 {
+				manifestPaths = []string{config.GetManifestPath(cmd)}
+			}
+
+			// Every manifest is renamed before any is written, so nothing changes if one can't be renamed
+			var manifests []renamedManifest
+			renames := make(map[string]string)
+			types := make(map[string]string)
+			for _, path := range manifestPaths {
+				data, err := manifest.ReadData(path)
+				if err != nil {
+					return fmt.Errorf("error reading manifest %s: %w", path, err)
+				}
+				fs, err := manifest.ParseFlagSet(data)
+				if err != nil {
+					return fmt.Errorf("failed to load manifest %s: %w", path, err)
+				}
+				renamed, manifestRenames, err := manifest.RenamePrefix(data, from, to)
+				if err != nil {
+					return fmt.Errorf("error renaming the flags of %s: %w", path, err)
+				}
+
+				manifests = append(manifests, renamedManifest{Path: path, Data: renamed, Renames: manifestRenames})
+				maps.Copy(renames, manifestRenames)
+				for _, flag := range fs.Flags {
+					if _, ok := manifestRenames[flag.Key]; ok {
+						types[flag.Key] = flag.Type.String()
+					}
+				}
+			}
+
+			if len(renames) == 0 {
+				pterm.Info.Printf("No flag key starts with %q\n", from)
+				return nil
+			}
+
+			// The manifests aren't references
+			var exclude []string
+			for _, path := range manifestPaths {
+				exclude = append(exclude, manifestExcludes(dir, path)...)
+			}
+			oldKeys := slices.Sorted(maps.Keys(renames))
+			refs, err := usage.Scan(dir, oldKeys, usage.Options{Exclude: exclude})
+			if err != nil {
+				return fmt.Errorf("error scanning %s: %w", dir, err)
+			}
+
+			displayRenames(manifests, refs, dir)
+			if dryRun {
+				pterm.Info.Println("Dry run: no files were changed")
+				return nil
+			}
+
+			for _, m := range manifests {
+				if len(m.Renames) == 0 {
+					continue
+				}
+				if err := manifest.WriteData(m.Path, m.Data); err != nil {
+					return fmt.Errorf("error writing manifest %s: %w", m.Path, err)
+				}
+				logger.Default.Success(fmt.Sprintf("Renamed %d flag(s) in %s", len(m.Renames), m.Path))
+			}
+
+			files, err := usage.Rename(dir, refs, renames)
+			if err != nil {
+				return fmt.Errorf("error renaming references in %s: %w", dir, err)
+			}
+			if len(files) > 0 {
+				logger.Default.Success(fmt.Sprintf("Renamed %d reference(s) in %d file(s)", len(refs), len(files)))
+			}
+
+			plan := manifest.PrunePlan{CreatedAt: time.Now().UTC(), Manifest: strings.Join(manifestPaths, ", "), Targets: []manifest.PrunePlanTarget{}}
+			for _, name := range sortedKeys(v.GetStringMap("targets")) {
+				providerURL := v.GetString("targets." + name + "." + config.ProviderURLFlagName)
+				if providerURL == "" {
+					continue
+				}
+				flags := make([]manifest.PrunePlanFlag, 0, len(oldKeys))
+				for _, key := range oldKeys {
+					flags = append(flags, manifest.PrunePlanFlag{Key: key, Type: types[key], Age: manifest.PruneAgeUnknown})
+				}
+				plan.Targets = append(plan.Targets, manifest.PrunePlanTarget{Name: name, ProviderURL: providerURL, Flags: flags})
+			}
+			if len(plan.Targets) == 0 {
+				return nil
+			}
+
+			if err := manifest.WritePrunePlan(planPath, plan); err != nil {
+				return err
+			}
+			logger.Default.FileCreated(planPath)
+			pterm.Info.Printf("Push each manifest to each target with the plan to create the renamed flags and delete the old keys, e.g.:\n  openfeature push --target %s --prune --plan %s\n", plan.Targets[0].Name, planPath)
+			return nil
+		},
+	}
+
+	config.AddRefactorRenamePrefixFlags(renamePrefixCmd)
+	addStabilityInfo(renamePrefixCmd)
+
+	return renamePrefixCmd
+}
+
+// displayRenames prints the renamed flags of each manifest and the references to them
+func displayRenames(manifests []renamedManifest, refs []usage.Reference, dir string) {
+	tableData := pterm.TableData{{"Manifest", "Key", "New key"}}
+	for _, m := range manifests {
+		for _, key := range slices.Sorted(maps.Keys(m.Renames)) {
+			tableData = append(tableData, []string{m.Path, key, m.Renames[key]})
+		}
+	}
+	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+
+	if len(refs) == 0 {
+		pterm.Info.Printf("No references to the renamed flags in %s\n", dir)
+		return
+	}
+	pterm.DefaultSection.Println(fmt.Sprintf("References (%s)", display.count(len(refs))))
+	for _, ref := range refs {
+		pterm.Printf("%s %s\n", terminal.FileLink(filepath.Join(dir, ref.File), ref.Line), ref.Key)
+	}
+}
@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefactorRenamePrefixCmd(t *testing.T) {
+	setup := func(t *testing.T) afero.Fs {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		files := map[string]string{
+			"svc/flags.json": `{"flags": {"exp-checkout": {"flagType": "boolean", "defaultValue": false}, "greeting": {"flagType": "string", "defaultValue": "Hi"}}}`,
+			"web/flags.json": `{"flags": {"exp-banner": {"flagType": "integer", "defaultValue": 1}}}`,
+			"src/app.ts":     "client.getBooleanValue(\"exp-checkout\", false);\nuseFlag('exp-banner', 1);\n",
+		}
+		for path, content := range files {
+			require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0o644))
+		}
+		setupConfigFileForTest(t, `
+manifests:
+  - svc/flags.json
+  - web/flags.json
+targets:
+  prod:
+    provider-url: "https://flags.example.com"
+  local:
+    auth-token: "token"
+`)
+		return fs
+	}
+	run := func(args ...string) error {
+		cmd := GetRefactorRenamePrefixCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs(args)
+		return cmd.Execute()
+	}
+
+	t.Run("renames the flags of every manifest and their references", func(t *testing.T) {
+		fs := setup(t)
+		require.NoError(t, run("--from", "exp-", "--to", "experiment-"))
+
+		svc, err := manifest.LoadFlagSet("svc/flags.json")
+		require.NoError(t, err)
+		keys := []string{}
+		for _, flag := range svc.Flags {
+			keys = append(keys, flag.Key)
+		}
+		assert.ElementsMatch(t, []string{"experiment-checkout", "greeting"}, keys)
+
+		web, err := manifest.LoadFlagSet("web/flags.json")
+		require.NoError(t, err)
+		require.Len(t, web.Flags, 1)
+		assert.Equal(t, "experiment-banner", web.Flags[0].Key)
+
+		content, err := afero.ReadFile(fs, "src/app.ts")
+		require.NoError(t, err)
+		assert.Equal(t, "client.getBooleanValue(\"experiment-checkout\", false);\nuseFlag('experiment-banner', 1);\n", string(content))
+
+		plan, err := manifest.LoadPrunePlan("rename-plan.json")
+		require.NoError(t, err)
+		assert.Equal(t, "svc/flags.json, web/flags.json", plan.Manifest)
+		require.Len(t, plan.Targets, 1, "targets without a provider URL are skipped")
+		assert.Equal(t, "prod", plan.Targets[0].Name)
+		assert.Equal(t, []manifest.PrunePlanFlag{
+			{Key: "exp-banner", Type: "integer", Age: manifest.PruneAgeUnknown},
+			{Key: "exp-checkout", Type: "boolean", Age: manifest.PruneAgeUnknown},
+		}, plan.Targets[0].Flags)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		fs := setup(t)
+		require.NoError(t, run("--from", "exp-", "--to", "experiment-", "--dry-run"))
+
+		content, err := afero.ReadFile(fs, "src/app.ts")
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"exp-checkout"`)
+		exists, err := afero.Exists(fs, "rename-plan.json")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("conflict leaves every file as it is", func(t *testing.T) {
+		fs := setup(t)
+		require.NoError(t, afero.WriteFile(fs, "web/flags.json",
+			[]byte(`{"flags": {"exp-banner": {"flagType": "integer", "defaultValue": 1}, "experiment-banner": {"flagType": "integer", "defaultValue": 2}}}`), 0o644))
+
+		err := run("--from", "exp-", "--to", "experiment-")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `error renaming the flags of web/flags.json: cannot rename "exp-banner" to "experiment-banner"`)
+
+		svc, err := manifest.LoadFlagSet("svc/flags.json")
+		require.NoError(t, err)
+		assert.Contains(t, []string{svc.Flags[0].Key, svc.Flags[1].Key}, "exp-checkout")
+	})
+
+	t.Run("prefix is required", func(t *testing.T) {
+		setup(t)
+		err := run("--to", "experiment-")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "--from is required")
+	})
+}
@@ -5,10 +5,15 @@ import (
 	"os"
 
 	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/flagset"
 	"github.com/open-feature/cli/internal/logger"
 	"github.com/spf13/cobra"
 )
 
+// stdioAnnotation marks commands that use stdin and stdout for a protocol, so any
+// log output is written to stderr instead
+const stdioAnnotation = "stdio"
+
 var (
 	Version = "dev"
 	Commit  string
@@ -36,10 +41,33 @@ func GetRootCmd() *cobra.Command {
 		Short: "CLI for OpenFeature.",
 		Long:  `CLI for OpenFeature related functionalities.`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Commands that speak a protocol over stdout must keep it free of log output
+			if _, ok := cmd.Annotations[stdioAnnotation]; ok {
+				logger.RedirectOutput(os.Stderr)
+			}
 			debug, _ := cmd.Flags().GetBool("debug")
 			logger.Default.SetDebug(debug)
 			logger.Default.Debug("Debug logging enabled")
-			return initializeConfig(cmd, "")
+			if err := initializeConfig(cmd, ""); err != nil {
+				return err
+			}
+
+			// Dates without a time in manifests that don't set a timezone start in this timezone
+			if timezone := config.GetTimezone(cmd); timezone != "" {
+				loc, err := flagset.Location(timezone)
+				if err != nil {
+					return err
+				}
+				flagset.SetDefaultTimezone(loc)
+			}
+
+			// Format the dates and counts of tables and summaries for the locale
+			format, err := newDisplayFormat(config.GetDateFormat(cmd), os.Getenv)
+			if err != nil {
+				return err
+			}
+			display = format
+			return nil
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			printBanner()
@@ -65,6 +93,23 @@ func GetRootCmd() *cobra.Command {
 	rootCmd.AddCommand(GetPullCmd())
 	rootCmd.AddCommand(GetPushCmd())
 	rootCmd.AddCommand(GetManifestCmd())
+	rootCmd.AddCommand(GetCICmd())
+	rootCmd.AddCommand(GetKillSwitchCmd())
+	rootCmd.AddCommand(GetExportCmd())
+	rootCmd.AddCommand(GetUsageCmd())
+	rootCmd.AddCommand(GetExplainCmd())
+	rootCmd.AddCommand(GetStatusCmd())
+	rootCmd.AddCommand(GetFlagsCmd())
+	rootCmd.AddCommand(GetRefactorCmd())
+	rootCmd.AddCommand(GetBotCmd())
+	rootCmd.AddCommand(GetInitiativesCmd())
+	rootCmd.AddCommand(GetLSPCmd())
+	rootCmd.AddCommand(GetLearnCmd())
+	rootCmd.AddCommand(GetListenCmd())
+	rootCmd.AddCommand(GetReconcileCmd())
+
+	// Run the pre and post hooks of the config around every command
+	addCommandHooks(rootCmd)
 
 	// Add a custom error handler after the command is created
 	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/viper"
+)
+
+// loadSecretScanner creates the scanner configured in the secrets block of the config.
+// Returns nil if the scan is disabled.
+func loadSecretScanner(v *viper.Viper) (*manifest.SecretScanner, error) {
+	secrets, err := config.LoadSecretsConfig(v)
+	if err != nil || !secrets.Enabled {
+		return nil, err
+	}
+
+	scanner, err := manifest.NewSecretScanner(secrets.Patterns, secrets.Allow, secrets.Entropy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s block in config: %w", config.SecretsConfigKey, err)
+	}
+	return scanner, nil
+}
+
+// secretsError returns an error listing the secret-looking values, or nil if there are none
+func secretsError(issues []manifest.ValidationError) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("flags contain secret-looking values:")
+	for _, issue := range issues {
+		sb.WriteString("\n  - " + issue.Message)
+	}
+	sb.WriteString("\nRemove them, or add a pattern to the allow list of the secrets block of .openfeature.yaml if they are not secrets")
+	return fmt.Errorf("%s", sb.String())
+}
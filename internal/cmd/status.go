@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// statusSummary is the hygiene of the manifest, computed from local state only
+type statusSummary struct {
+	Flags   int
+	Expired int
+	// Drifted are the keys of the flags that differ between the manifest and at least one cached target
+	Drifted map[string]bool
+	Targets []statusTarget
+}
+
+// statusTarget is the drift of a target, as of the last cached pull
+type statusTarget struct {
+	Name      string
+	FetchedAt *time.Time
+	Drift     int
+}
+
+func GetStatusCmd() *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show a quick summary of expired flags and drift",
+		Long: `Show a quick summary of the hygiene of the manifest: the number of flags, the number of
+expired flags (whose activeUntil time has passed), and the number of flags that drifted from
+the named targets of .openfeature.yaml.
+
+The status is computed from local state only, so it is fast enough for shell prompts: no requests
+are made and the code is not scanned. Drift is computed against the flags last pulled from each
+target (see --cache-ttl on pull); targets that were never pulled are ignored.
+
+With --porcelain, a single line of stable key=value pairs is printed:
+
+  flags=12 expired=1 drift=3
+
+drift is "?" when no target has been pulled yet.
+
+With --badge, an SVG "flag hygiene" badge with the expired and drift counts is written as well,
+for example to be published by a scheduled pipeline and embedded in the README of the repository.`,
+		Example: `  # Show the status of the manifest
+  openfeature status
+
+  # Write a flag hygiene badge
+  openfeature status --badge flag-hygiene.svg
+
+  # Starship custom segment
+  [custom.openfeature]
+  command = "openfeature status --porcelain"
+  when = "test -f flags.json"`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "status")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+
+			fs, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			v, err := readConfigFile()
+			if err != nil {
+				return err
+			}
+
+			summary := statusSummary{Flags: len(fs.Flags), Drifted: make(map[string]bool)}
+			now := time.Now()
+			for _, flag := range fs.Flags {
+				if flag.ActiveUntil != nil && !now.Before(*flag.ActiveUntil) {
+					summary.Expired++
+				}
+			}
+
+			for _, name := range sortedKeys(v.GetStringMap("targets")) {
+				prefix := "targets." + name + "."
+				providerURL := v.GetString(prefix + config.ProviderURLFlagName)
+				target := statusTarget{Name: name}
+				if providerURL != "" {
+					if remote, fetchedAt, ok := manifest.CachedFlags(providerURL, v.GetString(prefix+config.AuthTokenFlagName)); ok {
+						target.FetchedAt = &fetchedAt
+						for _, key := range driftedFlags(fs, remote) {
+							target.Drift++
+							summary.Drifted[key] = true
+						}
+					}
+				}
+				summary.Targets = append(summary.Targets, target)
+			}
+
+			if badgePath := config.GetBadgePath(cmd); badgePath != "" {
+				if err := filesystem.WriteFile(badgePath, renderStatusBadge(summary)); err != nil {
+					return fmt.Errorf("error writing badge: %w", err)
+				}
+			}
+
+			if config.GetPorcelain(cmd) {
+				_, err := fmt.Fprintln(cmd.OutOrStdout(), formatPorcelainStatus(summary))
+				return err
+			}
+
+			displayStatus(summary)
+			return nil
+		},
+	}
+
+	config.AddStatusFlags(statusCmd)
+	addStabilityInfo(statusCmd)
+
+	return statusCmd
+}
+
+// driftedFlags returns the keys of the flags that differ between the local and the remote flags,
+// including flags that only exist on one side
+func driftedFlags(local *flagset.Flagset, remote *flagset.Flagset) []string {
+	var keys []string
+	localKeys := make(map[string]bool, len(local.Flags))
+	for _, flag := range local.Flags {
+		localKeys[flag.Key] = true
+		if status, _ := compareRemoteFlag(flag, remote); status != explainInSync {
+			keys = append(keys, flag.Key)
+		}
+	}
+	for _, flag := range remote.Flags {
+		if !localKeys[flag.Key] {
+			keys = append(keys, flag.Key)
+		}
+	}
+	return keys
+}
+
+// formatPorcelainStatus formats the summary as a single line of key=value pairs
+func formatPorcelainStatus(summary statusSummary) string {
+	return fmt.Sprintf("flags=%d expired=%d drift=%s", summary.Flags, summary.Expired, summary.drift())
+}
+
+// drift returns the number of drifted flags, or "?" if no target has been pulled
+func (s statusSummary) drift() string {
+	for _, target := range s.Targets {
+		if target.FetchedAt != nil {
+			return strconv.Itoa(len(s.Drifted))
+		}
+	}
+	return "?"
+}
+
+// displayStatus prints the summary for humans
+func displayStatus(summary statusSummary) {
+	pterm.Printf("Flags:   %s\n", display.count(summary.Flags))
+	pterm.Printf("Expired: %s\n", display.count(summary.Expired))
+	pterm.Printf("Drift:   %s\n", display.count(len(summary.Drifted)))
+
+	if len(summary.Targets) == 0 {
+		return
+	}
+
+	tableData := pterm.TableData{{"Target", "Drift", "Fetched"}}
+	for _, target := range summary.Targets {
+		drift, fetched := "-", explainNotCached
+		if target.FetchedAt != nil {
+			drift = display.count(target.Drift)
+			fetched = display.dateTime(*target.FetchedAt)
+		}
+		tableData = append(tableData, []string{target.Name, drift, fetched})
+	}
+	pterm.Println()
+	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+}
+
+// Colors of the status badge
+const (
+	badgeColorHealthy = "#4c1"
+	badgeColorDrift   = "#dfb317"
+	badgeColorExpired = "#e05d44"
+)
+
+// renderStatusBadge renders the summary as an SVG badge in the flat style of common badge services
+func renderStatusBadge(summary statusSummary) []byte {
+	label := "flag hygiene"
+	message := fmt.Sprintf("%d expired, %s drift", summary.Expired, summary.drift())
+
+	color := badgeColorHealthy
+	if summary.Expired > 0 {
+		color = badgeColorExpired
+	} else if len(summary.Drifted) > 0 {
+		color = badgeColorDrift
+	}
+
+	// Approximate the text width, as the font metrics are not known
+	labelWidth := len(label)*6 + 10
+	messageWidth := len(message)*6 + 10
+	width := labelWidth + messageWidth
+
+	return []byte(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%[1]d" height="20" role="img" aria-label="%[2]s: %[3]s">
+  <title>%[2]s: %[3]s</title>
+  <rect width="%[4]d" height="20" fill="#555"/>
+  <rect x="%[4]d" width="%[5]d" height="20" fill="%[6]s"/>
+  <g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">
+    <text x="%[7]d" y="14">%[2]s</text>
+    <text x="%[8]d" y="14">%[3]s</text>
+  </g>
+</svg>
+`, width, label, message, labelWidth, messageWidth, color, labelWidth/2, labelWidth+messageWidth/2))
+}
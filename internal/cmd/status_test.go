@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusCmd(t *testing.T) {
+	setupStatusTest := func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(`{
+			"flags": {
+				"new-checkout": {"flagType": "boolean", "defaultValue": false},
+				"sale-banner": {"flagType": "boolean", "defaultValue": true, "activeUntil": "2020-01-01T00:00:00Z"},
+				"max-items": {"flagType": "integer", "defaultValue": 10}
+			}
+		}`), 0o644))
+
+		setupConfigFileForTest(t, `
+targets:
+  staging:
+    provider-url: "https://flags.staging.example.com"
+  prod:
+    provider-url: "https://flags.example.com"
+`)
+	}
+
+	runStatus := func(t *testing.T, args ...string) string {
+		cmd := GetStatusCmd()
+		config.AddRootFlags(cmd)
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs(append([]string{"--porcelain"}, args...))
+		require.NoError(t, cmd.Execute())
+		return out.String()
+	}
+
+	t.Run("reports unknown drift when no target was pulled", func(t *testing.T) {
+		setupStatusTest(t)
+		assert.Equal(t, "flags=3 expired=1 drift=?\n", runStatus(t))
+	})
+
+	t.Run("counts the flags that drifted from any cached target", func(t *testing.T) {
+		setupStatusTest(t)
+
+		_, err := manifest.LoadWithCache("https://flags.staging.example.com", "", manifest.CacheOptions{TTL: time.Hour}, func() (*flagset.Flagset, error) {
+			return &flagset.Flagset{Flags: []flagset.Flag{
+				{Key: "new-checkout", Type: flagset.BoolType, DefaultValue: true},
+				{Key: "sale-banner", Type: flagset.BoolType, DefaultValue: true},
+				{Key: "max-items", Type: flagset.IntType, DefaultValue: int64(10)},
+				{Key: "legacy-search", Type: flagset.BoolType, DefaultValue: false},
+			}}, nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "flags=3 expired=1 drift=2\n", runStatus(t))
+	})
+
+	t.Run("writes a badge", func(t *testing.T) {
+		setupStatusTest(t)
+		runStatus(t, "--badge", "badges/flag-hygiene.svg")
+
+		badge, err := afero.ReadFile(filesystem.FileSystem(), "badges/flag-hygiene.svg")
+		require.NoError(t, err)
+		assert.Contains(t, string(badge), "<title>flag hygiene: 1 expired, ? drift</title>")
+		assert.Contains(t, string(badge), badgeColorExpired)
+	})
+}
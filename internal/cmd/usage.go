@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/internal/usage"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// usageTeamsConfigKey is the key of the team to directory mapping in the config file
+const usageTeamsConfigKey = "usage.teams"
+
+// usageReport is the JSON output of the usage command
+type usageReport struct {
+	By         string            `json:"by"`
+	Areas      []usage.Area      `json:"areas"`
+	References []usage.Reference `json:"references"`
+}
+
+func GetUsageCmd() *cobra.Command {
+	usageCmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Show which parts of the codebase reference flags the most",
+		Long: `Scan the codebase for references to the flags in the manifest and aggregate them into a heatmap.
+
+A reference is a flag key used as a string literal (in double quotes, single quotes or backticks).
+References are grouped by directory, or by team when teams are mapped to directories in
+the usage block of .openfeature.yaml:
+
+  usage:
+    teams:
+      payments: ["services/payments", "web/checkout"]
+      search: ["services/search"]
+
+The areas most entangled with flags are listed first, which helps to prioritize flag cleanup.`,
+		Example: `  # Show the heatmap by top-level directory
+  openfeature usage
+
+  # Group by the second directory level of the src directory
+  openfeature usage --dir src --depth 2
+
+  # Group by team and write the report as JSON
+  openfeature usage --by team --output json > flag-usage.json`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initializeConfig(cmd, "usage")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifestPath := config.GetManifestPath(cmd)
+			dir := config.GetDir(cmd)
+			by := config.GetBy(cmd)
+			outputFormat := config.GetOutputPath(cmd)
+
+			if by != "directory" && by != "team" {
+				return fmt.Errorf("invalid grouping: %q. Valid groupings are: directory, team", by)
+			}
+			if outputFormat != "table" && outputFormat != "json" {
+				return fmt.Errorf("invalid output format: %q. Valid formats are: table, json", outputFormat)
+			}
+
+			fs, err := manifest.LoadFlagSet(manifestPath)
+			if err != nil {
+				return fmt.Errorf("failed to load manifest: %w", err)
+			}
+
+			keys := make([]string, 0, len(fs.Flags))
+			for _, flag := range fs.Flags {
+				keys = append(keys, flag.Key)
+			}
+
+			// Don't count the manifest itself as a reference
+			exclude := manifestExcludes(dir, manifestPath)
+
+			refs, err := usage.Scan(dir, keys, usage.Options{Exclude: exclude})
+			if err != nil {
+				return fmt.Errorf("error scanning %s: %w", dir, err)
+			}
+
+			var areas []usage.Area
+			if by == "team" {
+				v, err := readConfigFile()
+				if err != nil {
+					return err
+				}
+				teams := v.GetStringMapStringSlice(usageTeamsConfigKey)
+				if len(teams) == 0 {
+					return fmt.Errorf("no teams mapped to directories. Add a %s block to .openfeature.yaml", usageTeamsConfigKey)
+				}
+				areas = usage.ByTeam(refs, teams)
+			} else {
+				areas = usage.ByDirectory(refs, config.GetDepth(cmd))
+			}
+
+			if outputFormat == "json" {
+				if refs == nil {
+					refs = []usage.Reference{}
+				}
+				data, err := json.MarshalIndent(usageReport{By: by, Areas: areas, References: refs}, "", "  ")
+				if err != nil {
+					return fmt.Errorf("error marshaling usage report: %w", err)
+				}
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(data))
+				return err
+			}
+
+			displayUsageHeatmap(areas, by, len(refs))
+			return nil
+		},
+	}
+
+	config.AddUsageFlags(usageCmd)
+	addStabilityInfo(usageCmd)
+
+	return usageCmd
+}
+
+// displayUsageHeatmap prints the areas as a table with a bar scaled to the most referenced area
+func displayUsageHeatmap(areas []usage.Area, by string, total int) {
+	if len(areas) == 0 {
+		pterm.Info.Println("No flag references found")
+		return
+	}
+
+	pterm.DefaultSection.Println(fmt.Sprintf("Flag references by %s (%s)", by, display.count(total)))
+
+	const barWidth = 20
+	most := areas[0].References
+
+	title := "Directory"
+	if by == "team" {
+		title = "Team"
+	}
+	tableData := pterm.TableData{{title, "References", "Flags", "Heat"}}
+	for _, area := range areas {
+		bar := strings.Repeat("█", max(1, area.References*barWidth/most))
+		tableData = append(tableData, []string{
+			area.Name,
+			display.count(area.References),
+			display.count(len(area.Flags)),
+			heatColor(area.References, most).Sprint(bar),
+		})
+	}
+
+	_ = pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+}
+
+// heatColor returns the color of a bar, from green for rarely to red for heavily referenced areas
+func heatColor(references int, most int) pterm.Color {
+	switch ratio := float64(references) / float64(most); {
+	case ratio > 0.66:
+		return pterm.FgRed
+	case ratio > 0.33:
+		return pterm.FgYellow
+	default:
+		return pterm.FgGreen
+	}
+}
+
+// manifestExcludes returns the files of the manifest, including the files it includes, relative to
+// the directory, so that scans of the directory don't count them as references to the flags
+func manifestExcludes(dir string, manifestPath string) []string {
+	files, err := manifest.Files(manifestPath)
+	if err != nil {
+		files = []string{manifestPath}
+	}
+	var exclude []string
+	for _, file := range files {
+		if rel, err := filepath.Rel(dir, file); err == nil {
+			exclude = append(exclude, rel)
+		}
+	}
+	return exclude
+}
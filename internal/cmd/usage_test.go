@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageCmd(t *testing.T) {
+	setupUsageTest := func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		readOsFileAndWriteToMemMap(t, "testdata/success_manifest.golden", "flags.json", fs)
+		require.NoError(t, afero.WriteFile(fs, "src/checkout/cart.go", []byte(`if client.Boolean(ctx, "enableFeatureA", false, nil) {}`), 0o644))
+		require.NoError(t, afero.WriteFile(fs, "src/profile/user.go", []byte(`client.Int(ctx, "usernameMaxLength", 10, nil)`), 0o644))
+	}
+
+	t.Run("writes a JSON report grouped by directory", func(t *testing.T) {
+		setupUsageTest(t)
+
+		cmd := GetUsageCmd()
+		config.AddRootFlags(cmd)
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{"-m", "flags.json", "--depth", "2", "--output", "json"})
+		require.NoError(t, cmd.Execute())
+
+		var report usageReport
+		require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+		assert.Equal(t, "directory", report.By)
+		assert.Len(t, report.References, 2)
+		require.Len(t, report.Areas, 2)
+		assert.Equal(t, "src/checkout", report.Areas[0].Name)
+		assert.Equal(t, []string{"enableFeatureA"}, report.Areas[0].Flags)
+	})
+
+	t.Run("requires a team mapping to group by team", func(t *testing.T) {
+		setupUsageTest(t)
+		t.Chdir(t.TempDir())
+
+		cmd := GetUsageCmd()
+		config.AddRootFlags(cmd)
+		cmd.SetArgs([]string{"-m", "flags.json", "--by", "team"})
+
+		err := cmd.Execute()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no teams mapped to directories")
+	})
+}
@@ -1,6 +1,14 @@
 package cmd
 
-import "github.com/pterm/pterm"
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
 
 func printBanner() {
 	ivrit := `
@@ -18,3 +26,25 @@ func printBanner() {
 	pterm.Printf("version: %s | compiled: %s\n", pterm.LightGreen(Version), pterm.LightGreen(Date))
 	pterm.Println(pterm.Cyan("🔗 https://openfeature.dev | https://github.com/open-feature/cli"))
 }
+
+// remoteContext returns the context for calls to a remote service.
+// The context has a deadline when --timeout is set on the command.
+func remoteContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if timeout := config.GetTimeout(cmd); timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// timeoutError replaces err with a clearer message when the --timeout deadline was exceeded
+func timeoutError(cmd *cobra.Command, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("operation timed out after %s: %w", config.GetTimeout(cmd), err)
+	}
+	return err
+}
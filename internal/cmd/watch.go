@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/logger"
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/spf13/cobra"
+)
+
+// watchDebounce is how long to wait for more changes before regenerating, since editors often
+// write a file in several steps
+const watchDebounce = 200 * time.Millisecond
+
+// addWatchMode lets every generate command run again whenever the manifest changes, with --watch
+func addWatchMode(cmd *cobra.Command) {
+	for _, child := range cmd.Commands() {
+		if child.RunE != nil {
+			child.RunE = withWatch(child.RunE)
+		}
+	}
+}
+
+// withWatch wraps the RunE of a generate command so that, with --watch, it runs again whenever the
+// manifest or one of the default value files it references changes, until it is interrupted.
+// Errors are reported without stopping the watch, so the manifest can be fixed while it runs.
+func withWatch(run func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if !config.GetWatch(cmd) {
+			return run(cmd, args)
+		}
+
+		manifestPath := config.GetManifestPath(cmd)
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		generate := func() {
+			err := run(cmd, args)
+			if err == nil {
+				// Post hooks, such as formatters, apply to every regeneration
+				err = runCommandHooks(cmd, args, hookPost)
+			}
+			if err != nil {
+				logger.Default.Error(err.Error())
+			}
+		}
+
+		generate()
+		logger.Default.Info(fmt.Sprintf("Watching %s for changes. Press Ctrl+C to stop.", manifestPath))
+		return watchFiles(ctx, func() []string { return manifest.SourceFiles(manifestPath) }, watchDebounce, func() {
+			logger.Default.Info(fmt.Sprintf("%s changed, regenerating", manifestPath))
+			generate()
+		})
+	}
+}
+
+// watchFiles calls onChange when one of the files returned by paths changes, once no more changes
+// happened for the debounce duration. The paths are listed again after each change. The directories
+// of the files are watched rather than the files, since editors often replace a file when saving it.
+// Returns when the context is done.
+func watchFiles(ctx context.Context, paths func() []string, debounce time.Duration, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("error watching files: %w", err)
+	}
+	defer watcher.Close()
+
+	watched := map[string]bool{}
+	dirs := map[string]bool{}
+	refresh := func() error {
+		clear(watched)
+		for _, path := range paths() {
+			abs, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("error watching %s: %w", path, err)
+			}
+			watched[abs] = true
+
+			dir := filepath.Dir(abs)
+			if dirs[dir] {
+				continue
+			}
+			if err := watcher.Add(dir); err != nil {
+				return fmt.Errorf("error watching %s: %w", dir, err)
+			}
+			dirs[dir] = true
+		}
+		return nil
+	}
+	if err := refresh(); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if watched[filepath.Clean(event.Name)] && event.Op != fsnotify.Chmod {
+				timer.Reset(debounce)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			logger.Default.Warning(fmt.Sprintf("Error watching files: %v", err))
+		case <-timer.C:
+			onChange()
+			if err := refresh(); err != nil {
+				return err
+			}
+		}
+	}
+}
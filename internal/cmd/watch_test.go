@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchFiles(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "flags.json")
+	otherPath := filepath.Join(dir, "generated.go")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(`{"flags": {}}`), 0o644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan struct{}, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- watchFiles(ctx, func() []string { return []string{manifestPath} }, 10*time.Millisecond, func() {
+			changes <- struct{}{}
+		})
+	}()
+
+	// Files other than the watched ones, such as the generated code, don't trigger a change
+	require.NoError(t, os.WriteFile(otherPath, []byte("package openfeature"), 0o644))
+
+	// Keep writing until the watcher has started and reported the change
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(5 * time.Second)
+wait:
+	for {
+		select {
+		case <-changes:
+			break wait
+		case <-ticker.C:
+			require.NoError(t, os.WriteFile(manifestPath, []byte(`{"flags": {"a": {}}}`), 0o644))
+		case <-timeout:
+			t.Fatal("the change of the manifest was not reported")
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watchFiles did not return once the context was done")
+	}
+}
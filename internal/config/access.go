@@ -0,0 +1,105 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ReadOnlyEnvVar is the environment variable that enables read-only mode (e.g., OPENFEATURE_READ_ONLY=1)
+const ReadOnlyEnvVar = "OPENFEATURE_READ_ONLY"
+
+// ReadOnlyConfigKey is the key of the read-only switch in the config file
+const ReadOnlyConfigKey = "read-only"
+
+// AllowedOperationsConfigKey is the key of the operations a target allows, in a target of the config file
+const AllowedOperationsConfigKey = "allowed-operations"
+
+// Operations that change a remote provider
+const (
+	OperationPush           = "push"
+	OperationPrune          = "prune"
+	OperationKillSwitchTrip = "killswitch-trip"
+)
+
+// Operations lists every operation that changes a remote provider
+var Operations = []string{OperationPush, OperationPrune, OperationKillSwitchTrip}
+
+// ReadOnly returns where read-only mode is enabled: the environment variable or the config file.
+// Returns an empty string if it is not enabled. Either one enables it, so an environment variable
+// set for shared CI credentials can't be turned off by the config file, and vice versa.
+func ReadOnly(v *viper.Viper, getenv func(string) string) (string, error) {
+	if value := strings.TrimSpace(getenv(ReadOnlyEnvVar)); value != "" {
+		readOnly, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s value %q: expected a boolean (e.g., 1 or true)", ReadOnlyEnvVar, value)
+		}
+		if readOnly {
+			return ReadOnlyEnvVar, nil
+		}
+	}
+
+	if v.IsSet(ReadOnlyConfigKey) {
+		readOnly, err := strconv.ParseBool(fmt.Sprint(v.Get(ReadOnlyConfigKey)))
+		if err != nil {
+			return "", fmt.Errorf("invalid %s in config: expected a boolean", ReadOnlyConfigKey)
+		}
+		if readOnly {
+			return ReadOnlyConfigKey + " in the config", nil
+		}
+	}
+
+	return "", nil
+}
+
+// LoadAllowedOperations reads the operations the target allows from the target of the config.
+// Returns nil if the target doesn't restrict its operations.
+func LoadAllowedOperations(v *viper.Viper, target string) ([]string, error) {
+	key := "targets." + target + "." + AllowedOperationsConfigKey
+	if target == "" || !v.IsSet(key) {
+		return nil, nil
+	}
+
+	var allowed []string
+	if err := v.UnmarshalKey(key, &allowed); err != nil {
+		return nil, fmt.Errorf("invalid %s of target %q in config: %w", AllowedOperationsConfigKey, target, err)
+	}
+	for _, operation := range allowed {
+		if !slices.Contains(Operations, operation) {
+			return nil, fmt.Errorf("invalid %s of target %q in config: unknown operation %q. Valid operations are: %s",
+				AllowedOperationsConfigKey, target, operation, strings.Join(Operations, ", "))
+		}
+	}
+
+	// An empty list allows nothing, unlike a target without a list
+	if allowed == nil {
+		allowed = []string{}
+	}
+	return allowed, nil
+}
+
+// CheckOperation returns an error if read-only mode is enabled, or if the target doesn't allow the operation
+func CheckOperation(v *viper.Viper, getenv func(string) string, target string, operation string) error {
+	source, err := ReadOnly(v, getenv)
+	if err != nil {
+		return err
+	}
+	if source != "" {
+		return fmt.Errorf("%s refused: read-only mode is enabled (%s)", operation, source)
+	}
+
+	allowed, err := LoadAllowedOperations(v, target)
+	if err != nil {
+		return err
+	}
+	if allowed != nil && !slices.Contains(allowed, operation) {
+		if len(allowed) == 0 {
+			return fmt.Errorf("%s refused: target %q allows no operations", operation, target)
+		}
+		return fmt.Errorf("%s refused: target %q only allows %s", operation, target, strings.Join(allowed, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckOperation(t *testing.T) {
+	const targets = `
+targets:
+  prod:
+    provider-url: https://flags.example.com
+    allowed-operations: [push]
+  locked:
+    allowed-operations: []
+  staging:
+    provider-url: https://flags.staging.example.com
+`
+	noEnv := func(string) string { return "" }
+
+	tests := []struct {
+		name      string
+		config    string
+		env       map[string]string
+		target    string
+		operation string
+		wantErr   string
+	}{
+		{
+			name:      "allowed without restrictions",
+			config:    targets,
+			target:    "staging",
+			operation: OperationPrune,
+		},
+		{
+			name:      "allowed by the target",
+			config:    targets,
+			target:    "prod",
+			operation: OperationPush,
+		},
+		{
+			name:      "not allowed by the target",
+			config:    targets,
+			target:    "prod",
+			operation: OperationPrune,
+			wantErr:   `prune refused: target "prod" only allows push`,
+		},
+		{
+			name:      "target that allows nothing",
+			config:    targets,
+			target:    "locked",
+			operation: OperationPush,
+			wantErr:   `push refused: target "locked" allows no operations`,
+		},
+		{
+			name:      "read-only environment variable",
+			config:    targets,
+			env:       map[string]string{ReadOnlyEnvVar: "1"},
+			target:    "prod",
+			operation: OperationPush,
+			wantErr:   "push refused: read-only mode is enabled (OPENFEATURE_READ_ONLY)",
+		},
+		{
+			name:      "read-only config",
+			config:    "read-only: true",
+			operation: OperationKillSwitchTrip,
+			wantErr:   "killswitch-trip refused: read-only mode is enabled (read-only in the config)",
+		},
+		{
+			name:      "environment variable can't disable the read-only config",
+			config:    "read-only: true",
+			env:       map[string]string{ReadOnlyEnvVar: "0"},
+			operation: OperationPush,
+			wantErr:   "read-only mode is enabled",
+		},
+		{
+			name:      "invalid environment variable",
+			env:       map[string]string{ReadOnlyEnvVar: "yes please"},
+			operation: OperationPush,
+			wantErr:   `invalid OPENFEATURE_READ_ONLY value "yes please"`,
+		},
+		{
+			name: "unknown operation in the target",
+			config: `
+targets:
+  prod:
+    allowed-operations: [push, delete]
+`,
+			target:    "prod",
+			operation: OperationPush,
+			wantErr:   `unknown operation "delete"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := noEnv
+			if tt.env != nil {
+				getenv = func(name string) string { return tt.env[name] }
+			}
+
+			err := CheckOperation(readTestConfig(t, tt.config), getenv, tt.target, tt.operation)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
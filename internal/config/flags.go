@@ -2,68 +2,288 @@ package config
 
 import (
 	"os"
+	"time"
 
+	"github.com/open-feature/cli/internal/filesystem"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"golang.org/x/term"
 )
 
 // Flag name constants to avoid duplication
 const (
-	DebugFlagName         = "debug"
-	ManifestFlagName      = "manifest"
-	OutputFlagName        = "output"
-	NoInputFlagName       = "no-input"
-	GoPackageFlagName     = "package-name"
-	CSharpNamespaceName   = "namespace"
-	OverrideFlagName      = "override"
-	JavaPackageFlagName   = "package-name"
-	ProviderURLFlagName   = "provider-url"
-	FlagSourceURLFlagName = "flag-source-url" // Deprecated: use ProviderFlagName instead
-	AuthTokenFlagName     = "auth-token"
-	NoPromptFlagName      = "no-prompt"
-	DryRunFlagName        = "dry-run"
-	TypeFlagName          = "type"
-	DefaultValueFlagName  = "default-value"
-	DescriptionFlagName   = "description"
-	TemplateFlagName      = "template"
+	DebugFlagName            = "debug"
+	ManifestFlagName         = "manifest"
+	OutputFlagName           = "output"
+	NoInputFlagName          = "no-input"
+	GoPackageFlagName        = "package-name"
+	CSharpNamespaceName      = "namespace"
+	OverrideFlagName         = "override"
+	JavaPackageFlagName      = "package-name"
+	ProviderURLFlagName      = "provider-url"
+	FlagSourceURLFlagName    = "flag-source-url" // Deprecated: use ProviderFlagName instead
+	AuthTokenFlagName        = "auth-token"
+	NoPromptFlagName         = "no-prompt"
+	DryRunFlagName           = "dry-run"
+	TypeFlagName             = "type"
+	DefaultValueFlagName     = "default-value"
+	DescriptionFlagName      = "description"
+	TemplateFlagName         = "template"
+	AgainstFlagName          = "against"
+	SummaryFlagName          = "summary"
+	FailOnDriftFlagName      = "fail-on-drift"
+	FailOnUnusedFlagName     = "fail-on-unused"
+	ReportFormatFlagName     = "report-format"
+	ReportFileFlagName       = "report-file"
+	PruneFlagName            = "prune"
+	YesFlagName              = "yes"
+	LocaleFlagName           = "locale"
+	CacheTTLFlagName         = "cache-ttl"
+	NoCacheFlagName          = "no-cache"
+	TimeoutFlagName          = "timeout"
+	TargetRegionFlagName     = "target-region"
+	FormatFlagName           = "format"
+	TargetFlagName           = "target"
+	OverrideFreezeFlagName   = "override-freeze"
+	DirFlagName              = "dir"
+	DepthFlagName            = "depth"
+	ByFlagName               = "by"
+	OnTypeMismatchFlagName   = "on-type-mismatch"
+	PresetFlagName           = "preset"
+	PorcelainFlagName        = "porcelain"
+	BadgeFlagName            = "badge"
+	NgModuleFlagName         = "ng-module"
+	PlanFlagName             = "plan"
+	GoOTelHooksFlagName      = "otel-hooks"
+	RuntimeFlagName          = "runtime"
+	LanguageFlagName         = "language"
+	TemplateDirFlagName      = "template-dir"
+	ClientExposedFlagName    = "client-exposed"
+	VisibilityFlagName       = "visibility"
+	TemplateOverrideFlagName = "template-override"
+	BundleReportFlagName     = "bundle-report"
+	MaxDefaultSizeFlagName   = "max-default-size"
+	WithTestsFlagName        = "with-tests"
+	DateFormatFlagName       = "date-format"
+	ExpiryFlagName           = "expiry"
+	RelativeFlagName         = "relative"
+	ConstantsOnlyFlagName    = "constants-only"
+	TimezoneFlagName         = "timezone"
+	SuspenseFlagName         = "suspense"
+	ProviderWrapperFlagName  = "provider-wrapper"
+	FlagsModuleFlagName      = "flags-module"
+	DIExtensionsFlagName     = "di-extensions"
+	NullableFlagName         = "nullable"
+	XMLDocsFlagName          = "xml-docs"
+	InitiativeFlagName       = "initiative"
+	SpringBootFlagName       = "spring-boot"
+	ModelsFlagName           = "models"
+	StubsFlagName            = "stubs"
+	RedactFlagName           = "redact"
+	WatchFlagName            = "watch"
+	CopyFlagName             = "copy"
+	CheckFlagName            = "check"
+	FromFlagName             = "from"
+	ToFlagName               = "to"
+	DeprecateWithinFlagName  = "deprecate-within"
+	DeprecateAsOfFlagName    = "deprecate-as-of"
+	ResetFlagName            = "reset"
+	RiskFlagName             = "risk"
+	ConfirmPhraseFlagName    = "confirm-phrase"
+	ApprovedByFlagName       = "approved-by"
+	AuditLogFlagName         = "audit-log"
+	EnvPrefixFlagName        = "env-prefix"
+	AddressFlagName          = "address"
+	SecretFlagName           = "secret"
+	InsecureFlagName         = "insecure"
+	FileFlagName             = "file"
+	ForceFlagName            = "force"
+	ScanFlagName             = "scan"
+	RefactorFlagName         = "refactor"
 )
 
 // Default values for flags
 const (
 	DefaultManifestPath    = "flags.json"
+	DefaultYAMLManifest    = "flags.yaml" // used when there is no manifest at DefaultManifestPath
 	DefaultOutputPath      = ""
 	DefaultGoPackageName   = "openfeature"
 	DefaultCSharpNamespace = "OpenFeature"
 	DefaultJavaPackageName = "com.example.openfeature"
+	DefaultMaxDefaultSize  = 1024
+	DefaultDateFormat      = "iso"
+	DefaultDeprecateWithin = 30
+	DefaultAuditLogPath    = "openfeature-audit.jsonl"
+	DefaultEnvPrefix       = "OPENFEATURE_FLAG_"
+	DefaultListenAddress   = "localhost:8787"
 )
 
 // AddRootFlags adds the common flags to the given command
 func AddRootFlags(cmd *cobra.Command) {
-	cmd.PersistentFlags().StringP(ManifestFlagName, "m", DefaultManifestPath, "Path to the flag manifest")
+	cmd.PersistentFlags().StringP(ManifestFlagName, "m", DefaultManifestPath, "Path to the flag manifest, in JSON, in YAML (.yaml, .yml) or in TOML (.toml)")
 	cmd.PersistentFlags().Bool(NoInputFlagName, false, "Disable interactive prompts")
 	cmd.PersistentFlags().Bool(DebugFlagName, false, "Enable debug logging")
+	cmd.PersistentFlags().String(TimezoneFlagName, "", "IANA timezone (e.g., Europe/Berlin) of activeFrom and activeUntil dates without a time, for manifests that don't set a timezone. Defaults to UTC")
+	cmd.PersistentFlags().String(DateFormatFlagName, DefaultDateFormat, "Format of the dates in tables and summaries: iso (2006-01-02), locale (from LC_TIME or LANG), or a Go time layout (e.g., '02 Jan 2006')")
 }
 
 // AddGenerateFlags adds the common generate flags to the given command
 func AddGenerateFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().StringP(OutputFlagName, "o", DefaultOutputPath, "Path to where the generated files should be saved")
-	cmd.PersistentFlags().StringP(TemplateFlagName, "t", "", "Path to a custom template file. If not specified, the default template is used")
+	cmd.PersistentFlags().StringP(TemplateFlagName, "t", "", "Path or http(s) URL of a custom template file. If not specified, the default template is used")
+	cmd.PersistentFlags().StringToString(TemplateOverrideFlagName, nil, "Replace a named template of the generator template with a template file or http(s) URL, as <name>=<path> (e.g., hook=./hook.tmpl). Can be repeated")
+	cmd.PersistentFlags().String(LocaleFlagName, "", "Locale of the flag descriptions used in the generated code (e.g., de, fr-CA). Falls back to the default description")
+	cmd.PersistentFlags().Bool(WatchFlagName, false, "Keep running and regenerate whenever the manifest changes")
+	cmd.PersistentFlags().Bool(CheckFlagName, false, "Don't write the generated files, and fail if they differ from the files on disk")
+	cmd.PersistentFlags().Int(DeprecateWithinFlagName, DefaultDeprecateWithin, "Mark the accessors of flags expiring within this number of days of --deprecate-as-of as deprecated. 0 marks only expired flags")
+	cmd.PersistentFlags().String(DeprecateAsOfFlagName, "", "Date (YYYY-MM-DD) that --deprecate-within is counted from. If empty, the accessors of all flags with an activeUntil date are marked deprecated")
+}
+
+// GetDeprecateWithin gets the number of days before their expiry that flags are marked deprecated from the given command
+func GetDeprecateWithin(cmd *cobra.Command) int {
+	days, _ := cmd.Flags().GetInt(DeprecateWithinFlagName)
+	return days
+}
+
+// GetDeprecateAsOf gets the date that the deprecation window is counted from from the given command
+func GetDeprecateAsOf(cmd *cobra.Command) string {
+	date, _ := cmd.Flags().GetString(DeprecateAsOfFlagName)
+	return date
+}
+
+// GetWatch gets whether to regenerate whenever the manifest changes from the given command
+func GetWatch(cmd *cobra.Command) bool {
+	watch, _ := cmd.Flags().GetBool(WatchFlagName)
+	return watch
+}
+
+// GetCheck gets whether to check the generated files on disk instead of writing them from the given command
+func GetCheck(cmd *cobra.Command) bool {
+	check, _ := cmd.Flags().GetBool(CheckFlagName)
+	return check
 }
 
 // AddGoGenerateFlags adds the go generator specific flags to the given command
 func AddGoGenerateFlags(cmd *cobra.Command) {
 	cmd.Flags().String(GoPackageFlagName, DefaultGoPackageName, "Name of the generated Go package")
+	cmd.Flags().Bool(GoOTelHooksFlagName, false, "Add the OpenTelemetry tracing and metrics hooks and the logging hook to the generated client")
+	cmd.Flags().Bool(WithTestsFlagName, false, "Also generate a test of the generated flags and the snapshot provider it runs against")
+	cmd.Flags().Bool(ConstantsOnlyFlagName, false, "Generate only typed flag key constants and the flag types, without a client, for use with your own evaluation wrapper")
+}
+
+// GetConstantsOnly gets whether to generate only the flag key constants from the given command
+func GetConstantsOnly(cmd *cobra.Command) bool {
+	constantsOnly, _ := cmd.Flags().GetBool(ConstantsOnlyFlagName)
+	return constantsOnly
+}
+
+// AddNodeJSGenerateFlags adds the nodejs generator specific flags to the given command
+func AddNodeJSGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(RuntimeFlagName, "node", "JavaScript runtime to generate the client for (node, deno, bun)")
+	cmd.Flags().Bool(WithTestsFlagName, false, "Also generate a node:test suite of the generated client and the snapshot provider it runs against")
+}
+
+// GetWithTests gets whether to generate tests along with the generated code from the given command
+func GetWithTests(cmd *cobra.Command) bool {
+	withTests, _ := cmd.Flags().GetBool(WithTestsFlagName)
+	return withTests
+}
+
+// AddSnapshotGenerateFlags adds the snapshot generator specific flags to the given command
+func AddSnapshotGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(LanguageFlagName, "", "Language of the generated provider (go, nodejs, web)")
+	cmd.Flags().String(GoPackageFlagName, DefaultGoPackageName, "Name of the generated Go package")
+}
+
+// AddEnvGenerateFlags adds the env generator specific flags to the given command
+func AddEnvGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(LanguageFlagName, "", "Language of the generated provider (go, nodejs)")
+	cmd.Flags().String(GoPackageFlagName, DefaultGoPackageName, "Name of the generated Go package")
+	cmd.Flags().String(EnvPrefixFlagName, DefaultEnvPrefix, "Prefix of the environment variables holding the flag values, followed by the flag key in SCREAMING_SNAKE_CASE")
+}
+
+// GetEnvPrefix gets the prefix of the environment variables holding the flag values from the given command
+func GetEnvPrefix(cmd *cobra.Command) string {
+	prefix, _ := cmd.Flags().GetString(EnvPrefixFlagName)
+	return prefix
+}
+
+// AddCustomGenerateFlags adds the custom generator specific flags to the given command
+func AddCustomGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(TemplateDirFlagName, "", "Directory of the templates to render against the flags")
 }
 
 // AddCSharpGenerateFlags adds the C# generator specific flags to the given command
 func AddCSharpGenerateFlags(cmd *cobra.Command) {
 	cmd.Flags().String(CSharpNamespaceName, DefaultCSharpNamespace, "Namespace for the generated C# code")
+	cmd.Flags().Bool(DIExtensionsFlagName, true, "Add IServiceCollection extension methods that register the generated client (requires Microsoft.Extensions.DependencyInjection)")
+	cmd.Flags().Bool(NullableFlagName, true, "Enable nullable reference types and annotate the optional parameters (requires C# 8)")
+	cmd.Flags().Bool(XMLDocsFlagName, true, "Add XML documentation comments, from the descriptions of the flags")
+}
+
+// GetDIExtensions gets the di-extensions flag from the given command
+func GetDIExtensions(cmd *cobra.Command) bool {
+	diExtensions, _ := cmd.Flags().GetBool(DIExtensionsFlagName)
+	return diExtensions
+}
+
+// GetNullable gets the nullable flag from the given command
+func GetNullable(cmd *cobra.Command) bool {
+	nullable, _ := cmd.Flags().GetBool(NullableFlagName)
+	return nullable
+}
+
+// GetXMLDocs gets the xml-docs flag from the given command
+func GetXMLDocs(cmd *cobra.Command) bool {
+	xmlDocs, _ := cmd.Flags().GetBool(XMLDocsFlagName)
+	return xmlDocs
 }
 
 // AddJavaGenerateFlags adds the Java generator specific flags to the given command
 func AddJavaGenerateFlags(cmd *cobra.Command) {
 	cmd.Flags().String(JavaPackageFlagName, DefaultJavaPackageName, "Name of the generated Java package")
+	cmd.Flags().Bool(SpringBootFlagName, false, "Also generate a Spring @Configuration registering the generated client as a bean, and a Flags record of typed accessors (requires Java 17)")
+}
+
+// AddPythonGenerateFlags adds the python generator specific flags to the given command
+func AddPythonGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().String(ModelsFlagName, "", "Generate classes for the values of object flags, inferred from their default values (dataclass, pydantic)")
+	cmd.Flags().Bool(StubsFlagName, false, "Also generate a .pyi type stub of the generated client")
+}
+
+// GetModels gets the kind of models to generate for object flags from the given command
+func GetModels(cmd *cobra.Command) string {
+	models, _ := cmd.Flags().GetString(ModelsFlagName)
+	return models
+}
+
+// GetStubs gets whether to generate type stubs from the given command
+func GetStubs(cmd *cobra.Command) bool {
+	stubs, _ := cmd.Flags().GetBool(StubsFlagName)
+	return stubs
+}
+
+// GetSpringBoot gets the spring-boot flag from the given command
+func GetSpringBoot(cmd *cobra.Command) bool {
+	springBoot, _ := cmd.Flags().GetBool(SpringBootFlagName)
+	return springBoot
+}
+
+// AddAngularGenerateFlags adds the Angular generator specific flags to the given command
+func AddAngularGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(NgModuleFlagName, false, "Also generate an NgModule exporting the directives, for applications that don't use standalone components")
+}
+
+// AddReactGenerateFlags adds the React generator specific flags
+func AddReactGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(SuspenseFlagName, false, "Make the generated hooks suspend until the flags are ready, for components rendered inside a Suspense boundary")
+	cmd.Flags().Bool(ProviderWrapperFlagName, false, "Also generate a typed OpenFeatureProvider wrapper whose defaultValues override the flag defaults, e.g., with values evaluated during server-side rendering")
+}
+
+// AddNestJSGenerateFlags adds the NestJS generator specific flags
+func AddNestJSGenerateFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(FlagsModuleFlagName, false, "Also generate a FlagsModule with an injectable FlagsService and typed parameter decorators such as @BooleanFlag('my-flag')")
 }
 
 // AddInitFlags adds the init command specific flags
@@ -81,6 +301,35 @@ func AddPullFlags(cmd *cobra.Command) {
 	_ = cmd.Flags().MarkDeprecated(FlagSourceURLFlagName, "use --provider-url instead")
 	cmd.Flags().String(AuthTokenFlagName, "", "The auth token for the flag provider")
 	cmd.Flags().Bool(NoPromptFlagName, false, "Disable interactive prompts for missing default values")
+	cmd.Flags().Duration(CacheTTLFlagName, 0, "Reuse flags pulled from the same remote source within this duration (e.g., 5m). 0 disables the cache")
+	cmd.Flags().Bool(NoCacheFlagName, false, "Ignore cached flags and fetch them from the remote source")
+	cmd.Flags().Duration(TimeoutFlagName, 0, "Maximum time to wait for the remote source, including retries (e.g., 30s). 0 means no limit")
+	cmd.Flags().String(TargetFlagName, "", "Name of a target defined in the targets block of .openfeature.yaml to use for the provider settings")
+	cmd.Flags().String(OnTypeMismatchFlagName, "fail", "How to handle pulled default values that conflict with the declared flag type (fail, coerce, keep-local)")
+}
+
+// GetOnTypeMismatch gets the type mismatch strategy from the given command
+func GetOnTypeMismatch(cmd *cobra.Command) string {
+	strategy, _ := cmd.Flags().GetString(OnTypeMismatchFlagName)
+	return strategy
+}
+
+// GetCacheTTL gets the cache TTL from the given command
+func GetCacheTTL(cmd *cobra.Command) time.Duration {
+	ttl, _ := cmd.Flags().GetDuration(CacheTTLFlagName)
+	return ttl
+}
+
+// GetTimeout gets the timeout for remote operations from the given command
+func GetTimeout(cmd *cobra.Command) time.Duration {
+	timeout, _ := cmd.Flags().GetDuration(TimeoutFlagName)
+	return timeout
+}
+
+// GetNoCache gets the no-cache flag from the given command
+func GetNoCache(cmd *cobra.Command) bool {
+	noCache, _ := cmd.Flags().GetBool(NoCacheFlagName)
+	return noCache
 }
 
 // AddPushFlags adds the push command specific flags
@@ -90,26 +339,157 @@ func AddPushFlags(cmd *cobra.Command) {
 	_ = cmd.Flags().MarkDeprecated(FlagSourceURLFlagName, "use --provider-url instead")
 	cmd.Flags().String(AuthTokenFlagName, "", "The auth token for the flag provider")
 	cmd.Flags().Bool(DryRunFlagName, false, "Preview changes without pushing")
+	cmd.Flags().Bool(PruneFlagName, false, "Delete remote flags that are not present in the manifest")
+	cmd.Flags().String(PlanFlagName, "", "Path to a prune plan written by 'flags prune-plan'. With --prune, only the flags listed in the plan are deleted")
+	cmd.Flags().BoolP(YesFlagName, "y", false, "Skip the confirmation prompt for destructive changes")
+	cmd.Flags().Duration(TimeoutFlagName, 0, "Maximum time to wait for the remote provider, including retries (e.g., 30s). 0 means no limit")
+	cmd.Flags().String(TargetFlagName, "", "Name of a target defined in the targets block of .openfeature.yaml to use for the provider settings")
+	cmd.Flags().String(TargetRegionFlagName, "", "Region of the remote provider, checked against the regions allowed by each flag's compliance metadata")
+	cmd.Flags().String(OverrideFreezeFlagName, "", "Push during a freeze window of .openfeature.yaml, giving the reason for the override")
+	cmd.Flags().Bool(ClientExposedFlagName, false, "The provider serves flags to client-side code, so pushing server-only flags is warned about")
+	cmd.Flags().String(ConfirmPhraseFlagName, "", "Confirm changing the default value of high-risk flags by repeating the confirmation phrase")
+	cmd.Flags().String(ApprovedByFlagName, "", "Reference of the second approver of changes to the default value of high-risk flags (e.g., their name or the URL of their review)")
+	cmd.Flags().String(AuditLogFlagName, DefaultAuditLogPath, "Path of the audit log recording changes to the default value of high-risk flags and freeze overrides")
+}
+
+// GetConfirmPhrase gets the confirmation phrase for high-risk changes from the given command
+func GetConfirmPhrase(cmd *cobra.Command) string {
+	phrase, _ := cmd.Flags().GetString(ConfirmPhraseFlagName)
+	return phrase
+}
+
+// GetApprovedBy gets the reference of the second approver of high-risk changes from the given command
+func GetApprovedBy(cmd *cobra.Command) string {
+	approvedBy, _ := cmd.Flags().GetString(ApprovedByFlagName)
+	return approvedBy
+}
+
+// GetAuditLogPath gets the path of the audit log from the given command
+func GetAuditLogPath(cmd *cobra.Command) string {
+	path, _ := cmd.Flags().GetString(AuditLogFlagName)
+	return path
+}
+
+// GetClientExposed gets whether the provider serves flags to client-side code from the given command
+func GetClientExposed(cmd *cobra.Command) bool {
+	exposed, _ := cmd.Flags().GetBool(ClientExposedFlagName)
+	return exposed
+}
+
+// GetOverrideFreeze gets the reason for overriding a freeze window from the given command
+func GetOverrideFreeze(cmd *cobra.Command) string {
+	reason, _ := cmd.Flags().GetString(OverrideFreezeFlagName)
+	return reason
+}
+
+// GetTarget gets the name of the selected target from the given command
+func GetTarget(cmd *cobra.Command) string {
+	target, _ := cmd.Flags().GetString(TargetFlagName)
+	return target
+}
+
+// GetTargetRegion gets the target region from the given command
+func GetTargetRegion(cmd *cobra.Command) string {
+	region, _ := cmd.Flags().GetString(TargetRegionFlagName)
+	return region
+}
+
+// AddKillSwitchTripFlags adds the killswitch trip command specific flags
+func AddKillSwitchTripFlags(cmd *cobra.Command) {
+	cmd.Flags().String(ProviderURLFlagName, "", "The URL of the flag provider")
+	cmd.Flags().String(AuthTokenFlagName, "", "The auth token for the flag provider")
+	cmd.Flags().Bool(DryRunFlagName, false, "Preview the change without tripping the kill switch")
+	cmd.Flags().BoolP(YesFlagName, "y", false, "Skip the confirmation prompt")
+	cmd.Flags().Duration(TimeoutFlagName, 0, "Maximum time to wait for the remote provider, including retries (e.g., 30s). 0 means no limit")
+	cmd.Flags().String(TargetFlagName, "", "Name of a target defined in the targets block of .openfeature.yaml to use for the provider settings")
 }
 
 // GetManifestPath gets the manifest path from the given command
 func GetManifestPath(cmd *cobra.Command) string {
 	manifestPath, _ := cmd.Flags().GetString(ManifestFlagName)
+	// Without a manifest set, a YAML manifest is used when there is no JSON one
+	if manifestPath == DefaultManifestPath && !cmd.Flags().Changed(ManifestFlagName) {
+		if exists, _ := filesystem.Exists(DefaultManifestPath); !exists {
+			if yamlExists, _ := filesystem.Exists(DefaultYAMLManifest); yamlExists {
+				return DefaultYAMLManifest
+			}
+		}
+	}
 	return manifestPath
 }
 
+// GetTimezone gets the default timezone of manifest dates from the given command
+func GetTimezone(cmd *cobra.Command) string {
+	timezone, _ := cmd.Flags().GetString(TimezoneFlagName)
+	return timezone
+}
+
+// GetDateFormat gets the format of displayed dates from the given command
+func GetDateFormat(cmd *cobra.Command) string {
+	dateFormat, _ := cmd.Flags().GetString(DateFormatFlagName)
+	return dateFormat
+}
+
 // GetOutputPath gets the output path from the given command
 func GetOutputPath(cmd *cobra.Command) string {
 	outputPath, _ := cmd.Flags().GetString(OutputFlagName)
 	return outputPath
 }
 
+// GetSuspense gets the suspense flag from the given command
+func GetSuspense(cmd *cobra.Command) bool {
+	suspense, _ := cmd.Flags().GetBool(SuspenseFlagName)
+	return suspense
+}
+
+// GetProviderWrapper gets the provider-wrapper flag from the given command
+func GetProviderWrapper(cmd *cobra.Command) bool {
+	providerWrapper, _ := cmd.Flags().GetBool(ProviderWrapperFlagName)
+	return providerWrapper
+}
+
+// GetFlagsModule gets the flags-module flag from the given command
+func GetFlagsModule(cmd *cobra.Command) bool {
+	flagsModule, _ := cmd.Flags().GetBool(FlagsModuleFlagName)
+	return flagsModule
+}
+
+// GetNgModule gets the ng-module flag from the given command
+func GetNgModule(cmd *cobra.Command) bool {
+	ngModule, _ := cmd.Flags().GetBool(NgModuleFlagName)
+	return ngModule
+}
+
 // GetGoPackageName gets the Go package name from the given command
 func GetGoPackageName(cmd *cobra.Command) string {
 	goPackageName, _ := cmd.Flags().GetString(GoPackageFlagName)
 	return goPackageName
 }
 
+// GetGoOTelHooks gets the otel-hooks flag from the given command
+func GetGoOTelHooks(cmd *cobra.Command) bool {
+	otelHooks, _ := cmd.Flags().GetBool(GoOTelHooksFlagName)
+	return otelHooks
+}
+
+// GetRuntime gets the JavaScript runtime from the given command
+func GetRuntime(cmd *cobra.Command) string {
+	runtime, _ := cmd.Flags().GetString(RuntimeFlagName)
+	return runtime
+}
+
+// GetLanguage gets the language from the given command
+func GetLanguage(cmd *cobra.Command) string {
+	language, _ := cmd.Flags().GetString(LanguageFlagName)
+	return language
+}
+
+// GetTemplateDir gets the template directory from the given command
+func GetTemplateDir(cmd *cobra.Command) string {
+	templateDir, _ := cmd.Flags().GetString(TemplateDirFlagName)
+	return templateDir
+}
+
 // GetCSharpNamespace gets the C# namespace from the given command
 func GetCSharpNamespace(cmd *cobra.Command) string {
 	namespace, _ := cmd.Flags().GetString(CSharpNamespaceName)
@@ -128,6 +508,36 @@ func GetTemplatePath(cmd *cobra.Command) string {
 	return templatePath
 }
 
+// AddBundleReportFlags adds the bundle size flags of the web generators to the given command
+func AddBundleReportFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(BundleReportFlagName, false, "Print the estimated bundle size of the generated code, per flag")
+	cmd.Flags().Int(MaxDefaultSizeFlagName, DefaultMaxDefaultSize, "Warn about object flags whose JSON encoded default value is larger than this many bytes. 0 disables the warning")
+}
+
+// GetBundleReport gets whether to print the bundle size report from the given command
+func GetBundleReport(cmd *cobra.Command) bool {
+	report, _ := cmd.Flags().GetBool(BundleReportFlagName)
+	return report
+}
+
+// GetMaxDefaultSize gets the maximum size of object default values, in bytes, from the given command
+func GetMaxDefaultSize(cmd *cobra.Command) int {
+	size, _ := cmd.Flags().GetInt(MaxDefaultSizeFlagName)
+	return size
+}
+
+// GetTemplateOverrides gets the template overrides, keyed by template name, from the given command
+func GetTemplateOverrides(cmd *cobra.Command) map[string]string {
+	overrides, _ := cmd.Flags().GetStringToString(TemplateOverrideFlagName)
+	return overrides
+}
+
+// GetLocale gets the description locale from the given command
+func GetLocale(cmd *cobra.Command) string {
+	locale, _ := cmd.Flags().GetString(LocaleFlagName)
+	return locale
+}
+
 // GetNoInput gets the no-input flag from the given command
 func GetNoInput(cmd *cobra.Command) bool {
 	noInput, _ := cmd.Flags().GetBool(NoInputFlagName)
@@ -190,21 +600,317 @@ func GetDryRun(cmd *cobra.Command) bool {
 	return dryRun
 }
 
+// GetPrune gets the prune flag from the given command
+func GetPrune(cmd *cobra.Command) bool {
+	prune, _ := cmd.Flags().GetBool(PruneFlagName)
+	return prune
+}
+
+// GetYes gets the yes flag from the given command
+func GetYes(cmd *cobra.Command) bool {
+	yes, _ := cmd.Flags().GetBool(YesFlagName)
+	return yes
+}
+
 // AddManifestAddFlags adds the manifest add command specific flags
 func AddManifestAddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP(TypeFlagName, "t", "boolean", "Type of the flag (boolean, string, integer, float, object)")
 	cmd.Flags().StringP(DefaultValueFlagName, "d", "", "Default value for the flag (required)")
 	cmd.Flags().String(DescriptionFlagName, "", "Description of the flag")
+	cmd.Flags().String(PresetFlagName, "", "Name of a preset from the presets block of .openfeature.yaml to create the flag from")
+	cmd.Flags().String(VisibilityFlagName, "", "Where the flag may be evaluated (client, server, both)")
+	cmd.Flags().String(ExpiryFlagName, "", "When the flag expires, as a date (e.g., 2026-12-31) or a time from today (e.g., +90d, \"in 3 months\"). Sets activeUntil")
+	cmd.Flags().String(InitiativeFlagName, "", "Epic or initiative the flag is delivered as part of (e.g., checkout-redesign)")
+	cmd.Flags().String(RiskFlagName, "", "How risky changing the flag is (low, medium, high)")
+	cmd.Flags().SetNormalizeFunc(normalizeDefaultValueFlag)
+}
+
+// AddManifestSetFlags adds the manifest set command specific flags
+func AddManifestSetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(DefaultValueFlagName, "d", "", "New default value of the flag, parsed as the type of the flag")
+	cmd.Flags().String(DescriptionFlagName, "", "New description of the flag")
+	cmd.Flags().String(ExpiryFlagName, "", "When the flag expires, as a date (e.g., 2026-12-31) or a time from today (e.g., +90d, \"in 3 months\"). Sets activeUntil")
+	cmd.Flags().SetNormalizeFunc(normalizeDefaultValueFlag)
+}
+
+// AddManifestValidateFlags adds the manifest validate command specific flags
+func AddManifestValidateFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(OutputFlagName, "o", "text", "Output format (text, json)")
+}
+
+// AddManifestRenameFlags adds the manifest rename command specific flags
+func AddManifestRenameFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(RefactorFlagName, false, "Also rename the references to the flag in the code of --dir")
+	cmd.Flags().String(DirFlagName, ".", "Directory to scan for references to the renamed flag")
+	cmd.Flags().Bool(DryRunFlagName, false, "Preview the changes without changing any file")
+}
+
+// GetRefactor gets whether to rename the references to a renamed flag from the given command
+func GetRefactor(cmd *cobra.Command) bool {
+	refactor, _ := cmd.Flags().GetBool(RefactorFlagName)
+	return refactor
+}
+
+// normalizeDefaultValueFlag accepts --default for --default-value
+func normalizeDefaultValueFlag(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+	if name == "default" {
+		name = DefaultValueFlagName
+	}
+	return pflag.NormalizedName(name)
+}
+
+// GetRisk gets the flag risk from the given command
+func GetRisk(cmd *cobra.Command) string {
+	risk, _ := cmd.Flags().GetString(RiskFlagName)
+	return risk
+}
+
+// GetInitiative gets the flag initiative from the given command
+func GetInitiative(cmd *cobra.Command) string {
+	initiative, _ := cmd.Flags().GetString(InitiativeFlagName)
+	return initiative
+}
+
+// GetExpiry gets the flag expiry from the given command
+func GetExpiry(cmd *cobra.Command) string {
+	expiry, _ := cmd.Flags().GetString(ExpiryFlagName)
+	return expiry
+}
+
+// GetVisibility gets the flag visibility from the given command
+func GetVisibility(cmd *cobra.Command) string {
+	visibility, _ := cmd.Flags().GetString(VisibilityFlagName)
+	return visibility
+}
+
+// GetPreset gets the preset name from the given command
+func GetPreset(cmd *cobra.Command) string {
+	preset, _ := cmd.Flags().GetString(PresetFlagName)
+	return preset
 }
 
 // AddManifestListFlags adds the manifest list command specific flags
 func AddManifestListFlags(cmd *cobra.Command) {
-	// Currently no specific flags for list command, but function exists for consistency
+	cmd.Flags().Bool(RelativeFlagName, false, "Show the activation window relative to now (e.g., expires in 12 days) instead of dates")
+}
+
+// GetRelative gets whether to show dates relative to now from the given command
+func GetRelative(cmd *cobra.Command) bool {
+	relative, _ := cmd.Flags().GetBool(RelativeFlagName)
+	return relative
 }
 
 // AddManifestDeleteFlags adds the manifest delete command specific flags
 func AddManifestDeleteFlags(cmd *cobra.Command) {
-	// Currently no specific flags for delete command, but function exists for consistency
+	cmd.Flags().Bool(ScanFlagName, false, "Scan --dir for references to the flag before deleting it")
+	cmd.Flags().String(DirFlagName, ".", "Directory to scan for references to the flag")
+	cmd.Flags().Bool(ForceFlagName, false, "With --scan, delete the flag even if references to it remain in the codebase")
+}
+
+// GetScan gets whether to scan for references to a flag before deleting it from the given command
+func GetScan(cmd *cobra.Command) bool {
+	scan, _ := cmd.Flags().GetBool(ScanFlagName)
+	return scan
+}
+
+// GetForce gets whether to delete a flag despite remaining references from the given command
+func GetForce(cmd *cobra.Command) bool {
+	force, _ := cmd.Flags().GetBool(ForceFlagName)
+	return force
+}
+
+// AddManifestApplyPatchFlags adds the manifest apply-patch command specific flags
+func AddManifestApplyPatchFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(DryRunFlagName, false, "Preview the patched manifest without writing it")
+}
+
+// AddManifestExportFlags adds the manifest export command specific flags
+func AddManifestExportFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(OutputFlagName, "o", "", "Path to write the exported manifest to. Writes to stdout if empty")
+	cmd.Flags().Bool(RedactFlagName, false, "Strip or mask the sensitive fields configured in the redact block of .openfeature.yaml")
+}
+
+// GetRedact gets the redact flag from the given command
+func GetRedact(cmd *cobra.Command) bool {
+	redact, _ := cmd.Flags().GetBool(RedactFlagName)
+	return redact
+}
+
+// AddCIFlags adds the ci command specific flags
+func AddCIFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(AgainstFlagName, "a", "", "Path to a manifest to check for drift against (skips the compare step if empty)")
+	cmd.Flags().String(SummaryFlagName, "", "Path to write a machine-readable JSON summary of the run")
+	cmd.Flags().Bool(FailOnDriftFlagName, false, "Fail the run when the compare step finds differences")
+	cmd.Flags().String(DirFlagName, ".", "Directory to scan for flag references in the usage step")
+	cmd.Flags().Bool(FailOnUnusedFlagName, false, "Fail the run when the usage step finds flags that are not referenced in the code")
+	cmd.Flags().String(ReportFormatFlagName, "", "Format of the CI platform report to write (gitlab, bitbucket)")
+	cmd.Flags().String(ReportFileFlagName, "", "Path to write the CI platform report to")
+}
+
+// GetAgainstPath gets the against manifest path from the given command
+func GetAgainstPath(cmd *cobra.Command) string {
+	againstPath, _ := cmd.Flags().GetString(AgainstFlagName)
+	return againstPath
+}
+
+// GetSummaryPath gets the summary path from the given command
+func GetSummaryPath(cmd *cobra.Command) string {
+	summaryPath, _ := cmd.Flags().GetString(SummaryFlagName)
+	return summaryPath
+}
+
+// GetFailOnDrift gets the fail-on-drift flag from the given command
+func GetFailOnDrift(cmd *cobra.Command) bool {
+	failOnDrift, _ := cmd.Flags().GetBool(FailOnDriftFlagName)
+	return failOnDrift
+}
+
+// GetFailOnUnused gets the fail-on-unused flag from the given command
+func GetFailOnUnused(cmd *cobra.Command) bool {
+	failOnUnused, _ := cmd.Flags().GetBool(FailOnUnusedFlagName)
+	return failOnUnused
+}
+
+// GetReportFormat gets the report format from the given command
+func GetReportFormat(cmd *cobra.Command) string {
+	reportFormat, _ := cmd.Flags().GetString(ReportFormatFlagName)
+	return reportFormat
+}
+
+// GetReportPath gets the report file path from the given command
+func GetReportPath(cmd *cobra.Command) string {
+	reportPath, _ := cmd.Flags().GetString(ReportFileFlagName)
+	return reportPath
+}
+
+// AddExportFlags adds the export command specific flags
+func AddExportFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(FormatFlagName, "f", "", "Format of the export (cyclonedx)")
+	cmd.Flags().StringP(OutputFlagName, "o", "", "Path to write the export to. Writes to stdout if empty")
+}
+
+// GetFormat gets the format from the given command
+func GetFormat(cmd *cobra.Command) string {
+	format, _ := cmd.Flags().GetString(FormatFlagName)
+	return format
+}
+
+// AddUsageFlags adds the usage command specific flags
+func AddUsageFlags(cmd *cobra.Command) {
+	cmd.Flags().String(DirFlagName, ".", "Directory to scan for flag references")
+	cmd.Flags().Int(DepthFlagName, 1, "Number of directory levels to group references by")
+	cmd.Flags().String(ByFlagName, "directory", "Group references by directory or team (teams are mapped in the usage.teams block of .openfeature.yaml)")
+	cmd.Flags().StringP(OutputFlagName, "o", "table", "Output format (table, json)")
+}
+
+// AddBotSuggestRemovalsFlags adds the bot suggest-removals command specific flags
+func AddBotSuggestRemovalsFlags(cmd *cobra.Command) {
+	cmd.Flags().String(DirFlagName, ".", "Directory to scan for references to the expired flags")
+	cmd.Flags().StringP(OutputFlagName, "o", "table", "Output format (table, json)")
+}
+
+// AddInitiativesStatusFlags adds the initiatives status command specific flags
+func AddInitiativesStatusFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(OutputFlagName, "o", "table", "Output format (table, json)")
+}
+
+// GetDir gets the directory to scan from the given command
+func GetDir(cmd *cobra.Command) string {
+	dir, _ := cmd.Flags().GetString(DirFlagName)
+	return dir
+}
+
+// GetDepth gets the directory depth from the given command
+func GetDepth(cmd *cobra.Command) int {
+	depth, _ := cmd.Flags().GetInt(DepthFlagName)
+	return depth
+}
+
+// GetBy gets the grouping from the given command
+func GetBy(cmd *cobra.Command) string {
+	by, _ := cmd.Flags().GetString(ByFlagName)
+	return by
+}
+
+// AddExplainFlags adds the explain command specific flags
+func AddExplainFlags(cmd *cobra.Command) {
+	cmd.Flags().String(DirFlagName, ".", "Directory to scan for references to the flag")
+	cmd.Flags().StringP(OutputFlagName, "o", "table", "Output format (table, json)")
+	AddCopyFlag(cmd, "Copy the dossier to the clipboard, as JSON with --output json or as Markdown otherwise")
+}
+
+// AddCopyFlag adds the flag that copies the result of the command to the clipboard
+func AddCopyFlag(cmd *cobra.Command, usage string) {
+	cmd.Flags().Bool(CopyFlagName, false, usage)
+}
+
+// GetCopy gets whether to copy the result to the clipboard from the given command
+func GetCopy(cmd *cobra.Command) bool {
+	copyResult, _ := cmd.Flags().GetBool(CopyFlagName)
+	return copyResult
+}
+
+// AddStatusFlags adds the status command specific flags
+func AddStatusFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(PorcelainFlagName, false, "Print a single line of stable key=value pairs, for shell prompts and scripts")
+	cmd.Flags().String(BadgeFlagName, "", "Path to write an SVG flag hygiene badge with the expired and drift counts to")
+}
+
+// GetBadgePath gets the badge path from the given command
+func GetBadgePath(cmd *cobra.Command) string {
+	badgePath, _ := cmd.Flags().GetString(BadgeFlagName)
+	return badgePath
+}
+
+// GetPorcelain gets the porcelain flag from the given command
+func GetPorcelain(cmd *cobra.Command) bool {
+	porcelain, _ := cmd.Flags().GetBool(PorcelainFlagName)
+	return porcelain
+}
+
+// AddFlagsPrunePlanFlags adds the flags prune-plan command specific flags
+func AddFlagsPrunePlanFlags(cmd *cobra.Command) {
+	cmd.Flags().String(PlanFlagName, "prune-plan.json", "Path to write the prune plan to")
+	cmd.Flags().Duration(TimeoutFlagName, 0, "Maximum time to wait for each remote provider, including retries (e.g., 30s). 0 means no limit")
+}
+
+// GetPlanPath gets the prune plan path from the given command
+func GetPlanPath(cmd *cobra.Command) string {
+	planPath, _ := cmd.Flags().GetString(PlanFlagName)
+	return planPath
+}
+
+// AddRefactorRenamePrefixFlags adds the refactor rename-prefix command specific flags
+func AddRefactorRenamePrefixFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FromFlagName, "", "Prefix of the flag keys to rename (e.g., exp-)")
+	cmd.Flags().String(ToFlagName, "", "Prefix replacing it (e.g., experiment-)")
+	cmd.Flags().String(DirFlagName, ".", "Directory to scan for references to the renamed flags")
+	cmd.Flags().String(PlanFlagName, "rename-plan.json", "Path to write the plan deleting the old keys from the remote providers to")
+	cmd.Flags().Bool(DryRunFlagName, false, "Preview the renames without changing any file")
+}
+
+// GetFrom gets the prefix to rename from the given command
+func GetFrom(cmd *cobra.Command) string {
+	from, _ := cmd.Flags().GetString(FromFlagName)
+	return from
+}
+
+// GetTo gets the prefix replacing the renamed prefix from the given command
+func GetTo(cmd *cobra.Command) string {
+	to, _ := cmd.Flags().GetString(ToFlagName)
+	return to
+}
+
+// AddLearnFlags adds the learn command specific flags
+func AddLearnFlags(cmd *cobra.Command) {
+	cmd.Flags().String(LanguageFlagName, "go", "Language of the code generated in the tutorial (go, nodejs)")
+	cmd.Flags().Bool(ResetFlagName, false, "Delete the workspace of the tutorial in progress and start over")
+}
+
+// GetReset gets the reset flag from the given command
+func GetReset(cmd *cobra.Command) bool {
+	reset, _ := cmd.Flags().GetBool(ResetFlagName)
+	return reset
 }
 
 // ShouldDisableInteractivePrompts returns true if interactive prompts should be disabled
@@ -219,3 +925,52 @@ func ShouldDisableInteractivePrompts(cmd *cobra.Command) bool {
 	// Automatically disable prompting if stdin is not a terminal
 	return !term.IsTerminal(int(os.Stdin.Fd()))
 }
+
+// AddListenFlags adds the listen flags to the given command
+func AddListenFlags(cmd *cobra.Command) {
+	cmd.Flags().String(AddressFlagName, DefaultListenAddress, "Address the webhook listener binds to, as host:port")
+	cmd.Flags().String(SecretFlagName, "", "Shared secret of the webhook. Payloads must carry its HMAC-SHA256 signature in the X-OpenFeature-Signature header")
+	cmd.Flags().Bool(InsecureFlagName, false, "Accept unsigned payloads when no --secret is set")
+}
+
+// GetAddress gets the address the webhook listener binds to from the given command
+func GetAddress(cmd *cobra.Command) string {
+	address, _ := cmd.Flags().GetString(AddressFlagName)
+	return address
+}
+
+// GetSecret gets the shared secret of the webhook from the given command
+func GetSecret(cmd *cobra.Command) string {
+	secret, _ := cmd.Flags().GetString(SecretFlagName)
+	return secret
+}
+
+// GetInsecure gets the insecure flag from the given command
+func GetInsecure(cmd *cobra.Command) bool {
+	insecure, _ := cmd.Flags().GetBool(InsecureFlagName)
+	return insecure
+}
+
+// AddReconcileFlags adds the reconcile flags to the given command
+func AddReconcileFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(FileFlagName, "f", DefaultReconcilePath, "Path of the reconcile file describing the desired targets and policies")
+	cmd.Flags().Bool(DryRunFlagName, false, "Show the plan without applying it")
+	cmd.Flags().BoolP(YesFlagName, "y", false, "Apply the plan without asking for confirmation")
+	cmd.Flags().StringSlice(ApprovedByFlagName, nil, "Reference of an approver of the plan (e.g., their name or the URL of their review), counted for protected targets and high-risk flags. Can be repeated")
+	cmd.Flags().String(ConfirmPhraseFlagName, "", "Confirm changing the default value of high-risk flags by repeating the confirmation phrase")
+	cmd.Flags().String(OverrideFreezeFlagName, "", "Apply the plan during a freeze window of .openfeature.yaml, giving the reason for the override")
+	cmd.Flags().String(AuditLogFlagName, DefaultAuditLogPath, "Path of the audit log recording changes to the default value of high-risk flags and freeze overrides")
+	cmd.Flags().Duration(TimeoutFlagName, 0, "Maximum time to wait for each remote provider, including retries (e.g., 30s). 0 means no limit")
+}
+
+// GetReconcilePath gets the path of the reconcile file from the given command
+func GetReconcilePath(cmd *cobra.Command) string {
+	path, _ := cmd.Flags().GetString(FileFlagName)
+	return path
+}
+
+// GetApprovers gets the references of the approvers of a plan from the given command
+func GetApprovers(cmd *cobra.Command) []string {
+	approvers, _ := cmd.Flags().GetStringSlice(ApprovedByFlagName)
+	return approvers
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/viper"
+)
+
+// FreezeConfigKey is the key of the freeze windows in the config file
+const FreezeConfigKey = "freeze"
+
+// FreezeWindow is a period during which pushes to the listed targets are refused
+type FreezeWindow struct {
+	// From is the start of the window
+	From time.Time `mapstructure:"from"`
+	// Until is the end of the window, which is not part of it
+	Until time.Time `mapstructure:"until"`
+	// Targets are the names of the frozen targets. If empty, every push is frozen
+	Targets []string `mapstructure:"targets"`
+	// Reason explains why the window exists (e.g., end of year release freeze)
+	Reason string `mapstructure:"reason"`
+}
+
+// Applies reports whether the window freezes pushes to the given target at the given time
+func (w FreezeWindow) Applies(target string, now time.Time) bool {
+	if now.Before(w.From) || !now.Before(w.Until) {
+		return false
+	}
+	return len(w.Targets) == 0 || slices.Contains(w.Targets, target)
+}
+
+// LoadFreezeWindows reads the freeze windows from the freeze block of the config.
+// Timestamps are RFC 3339 (e.g., 2026-12-20T00:00:00Z).
+func LoadFreezeWindows(v *viper.Viper) ([]FreezeWindow, error) {
+	var windows []FreezeWindow
+	err := v.UnmarshalKey(FreezeConfigKey, &windows, viper.DecodeHook(
+		mapstructure.StringToTimeHookFunc(time.RFC3339),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s block in config: %w", FreezeConfigKey, err)
+	}
+
+	for i, window := range windows {
+		if window.From.IsZero() || window.Until.IsZero() {
+			return nil, fmt.Errorf("invalid %s block in config: window %d requires both from and until", FreezeConfigKey, i+1)
+		}
+		if !window.Until.After(window.From) {
+			return nil, fmt.Errorf("invalid %s block in config: window %d ends before it starts", FreezeConfigKey, i+1)
+		}
+	}
+
+	return windows, nil
+}
+
+// ActiveFreeze returns the first window that freezes pushes to the given target at the given time,
+// or nil if pushes are allowed
+func ActiveFreeze(windows []FreezeWindow, target string, now time.Time) *FreezeWindow {
+	for i := range windows {
+		if windows[i].Applies(target, now) {
+			return &windows[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestConfig(t *testing.T, content string) *viper.Viper {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(content)))
+	return v
+}
+
+func TestLoadFreezeWindows(t *testing.T) {
+	v := readTestConfig(t, `
+freeze:
+  - from: 2026-12-20T00:00:00Z
+    until: "2027-01-02T00:00:00Z"
+    targets: [prod]
+    reason: End of year freeze
+`)
+
+	windows, err := LoadFreezeWindows(v)
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+
+	from := time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC)
+	assert.True(t, windows[0].From.Equal(from))
+	assert.Equal(t, []string{"prod"}, windows[0].Targets)
+	assert.Equal(t, "End of year freeze", windows[0].Reason)
+
+	assert.NotNil(t, ActiveFreeze(windows, "prod", from))
+	assert.Nil(t, ActiveFreeze(windows, "staging", from), "other targets are not frozen")
+	assert.Nil(t, ActiveFreeze(windows, "prod", from.Add(-time.Second)), "before the window")
+	assert.Nil(t, ActiveFreeze(windows, "prod", windows[0].Until), "the end is not part of the window")
+}
+
+func TestLoadFreezeWindowsWithoutTargetsFreezesEverything(t *testing.T) {
+	v := readTestConfig(t, `
+freeze:
+  - from: 2026-12-20T00:00:00Z
+    until: 2027-01-02T00:00:00Z
+`)
+
+	windows, err := LoadFreezeWindows(v)
+	require.NoError(t, err)
+	assert.NotNil(t, ActiveFreeze(windows, "", time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestLoadFreezeWindowsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:    "missing until",
+			config:  "freeze:\n  - from: 2026-12-20T00:00:00Z\n",
+			wantErr: "window 1 requires both from and until",
+		},
+		{
+			name:    "ends before it starts",
+			config:  "freeze:\n  - from: 2026-12-20T00:00:00Z\n    until: 2026-12-01T00:00:00Z\n",
+			wantErr: "window 1 ends before it starts",
+		},
+		{
+			name:    "not a timestamp",
+			config:  "freeze:\n  - from: christmas\n    until: 2026-12-01T00:00:00Z\n",
+			wantErr: "invalid freeze block in config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadFreezeWindows(readTestConfig(t, tt.config))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
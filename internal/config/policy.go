@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/viper"
+)
+
+// PolicyConfigKey is the key of the organization policy source in the config file
+const PolicyConfigKey = "policy"
+
+// DefaultPolicyCacheTTL is how long a fetched policy bundle is reused when no cache-ttl is configured
+const DefaultPolicyCacheTTL = time.Hour
+
+// PolicySource is where the organization-wide policy bundle is fetched from
+type PolicySource struct {
+	// URL is the http(s) URL of the policy bundle
+	URL string `mapstructure:"url"`
+	// PublicKey is the base64 encoded Ed25519 public key the bundle must be signed with.
+	// If empty, the signature of the bundle is not checked.
+	PublicKey string `mapstructure:"public-key"`
+	// CacheTTL is how long a fetched bundle is reused before it is fetched again
+	CacheTTL time.Duration `mapstructure:"cache-ttl"`
+}
+
+// LoadPolicySource reads the policy block of the config.
+// Returns nil if no policy is configured.
+func LoadPolicySource(v *viper.Viper) (*PolicySource, error) {
+	if !v.IsSet(PolicyConfigKey) {
+		return nil, nil
+	}
+
+	var source PolicySource
+	err := v.UnmarshalKey(PolicyConfigKey, &source, viper.DecodeHook(
+		mapstructure.StringToTimeDurationHookFunc(),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s block in config: %w", PolicyConfigKey, err)
+	}
+	if source.URL == "" {
+		return nil, fmt.Errorf("invalid %s block in config: url is required", PolicyConfigKey)
+	}
+	if source.CacheTTL <= 0 {
+		source.CacheTTL = DefaultPolicyCacheTTL
+	}
+
+	return &source, nil
+}
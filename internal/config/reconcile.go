@@ -0,0 +1,97 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultReconcilePath is the default path of the reconcile file
+const DefaultReconcilePath = "reconcile.yaml"
+
+// Reconcile is the desired state of the flag infrastructure, read from a reconcile file
+type Reconcile struct {
+	// Manifest is the path of the manifest whose flags every target should have, relative to the
+	// reconcile file. Defaults to --manifest.
+	Manifest string `yaml:"manifest"`
+	// Targets are the targets to reconcile by name. Their provider settings default to the target of
+	// the same name in .openfeature.yaml.
+	Targets map[string]ReconcileTarget `yaml:"targets"`
+	// Policies apply to every target
+	Policies ReconcilePolicies `yaml:"policies"`
+}
+
+// ReconcileTarget is a target to reconcile
+type ReconcileTarget struct {
+	ProviderURL string `yaml:"provider-url"`
+	AuthToken   string `yaml:"auth-token"`
+	// Prune overrides the prune policy for the target
+	Prune *bool `yaml:"prune"`
+}
+
+// ReconcilePolicies are the policies of a reconcile file
+type ReconcilePolicies struct {
+	// Prune deletes the remote flags that are not in the manifest
+	Prune bool `yaml:"prune"`
+	// Protected are the names of the targets whose changes need approvals
+	Protected []string `yaml:"protected"`
+	// ApprovalsRequired is the number of distinct approvers the changes to a protected target need.
+	// Defaults to 1.
+	ApprovalsRequired int `yaml:"approvals-required"`
+}
+
+// ParseReconcile reads and checks a reconcile file. Unknown fields are refused, so that a
+// misspelled policy doesn't go unnoticed.
+func ParseReconcile(data []byte) (*Reconcile, error) {
+	var reconcile Reconcile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&reconcile); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid reconcile file: %w", err)
+	}
+
+	if len(reconcile.Targets) == 0 {
+		return nil, fmt.Errorf("invalid reconcile file: no targets")
+	}
+	for _, name := range reconcile.Policies.Protected {
+		if _, ok := reconcile.Targets[name]; !ok {
+			return nil, fmt.Errorf("invalid reconcile file: protected target %q is not in targets", name)
+		}
+	}
+	if reconcile.Policies.ApprovalsRequired < 0 {
+		return nil, fmt.Errorf("invalid reconcile file: approvals-required must not be negative")
+	}
+
+	return &reconcile, nil
+}
+
+// TargetNames returns the names of the targets in alphabetical order
+func (r *Reconcile) TargetNames() []string {
+	names := make([]string, 0, len(r.Targets))
+	for name := range r.Targets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Prunes reports whether the remote flags of the target that are not in the manifest are deleted
+func (r *Reconcile) Prunes(target string) bool {
+	if prune := r.Targets[target].Prune; prune != nil {
+		return *prune
+	}
+	return r.Policies.Prune
+}
+
+// RequiredApprovals returns the number of distinct approvers the changes to the target need,
+// 0 if the target is not protected
+func (r *Reconcile) RequiredApprovals(target string) int {
+	if !slices.Contains(r.Policies.Protected, target) {
+		return 0
+	}
+	return max(r.Policies.ApprovalsRequired, 1)
+}
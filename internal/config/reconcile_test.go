@@ -0,0 +1,53 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReconcile(t *testing.T) {
+	reconcile, err := ParseReconcile([]byte(`
+manifest: flags.json
+targets:
+  staging: {}
+  prod:
+    provider-url: https://flags.example.com
+  web:
+    prune: false
+policies:
+  prune: true
+  protected: [prod]
+  approvals-required: 2
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "flags.json", reconcile.Manifest)
+	assert.Equal(t, []string{"prod", "staging", "web"}, reconcile.TargetNames())
+	assert.Equal(t, "https://flags.example.com", reconcile.Targets["prod"].ProviderURL)
+	assert.True(t, reconcile.Prunes("staging"))
+	assert.False(t, reconcile.Prunes("web"), "targets override the prune policy")
+	assert.Equal(t, 2, reconcile.RequiredApprovals("prod"))
+	assert.Equal(t, 0, reconcile.RequiredApprovals("staging"))
+
+	t.Run("protected targets need an approval by default", func(t *testing.T) {
+		reconcile, err := ParseReconcile([]byte("targets:\n  prod: {}\npolicies:\n  protected: [prod]\n"))
+		require.NoError(t, err)
+		assert.Equal(t, 1, reconcile.RequiredApprovals("prod"))
+		assert.False(t, reconcile.Prunes("prod"))
+	})
+
+	for name, data := range map[string]string{
+		"no targets":               `policies: {prune: true}`,
+		"unknown protected target": "targets:\n  staging: {}\npolicies:\n  protected: [prod]\n",
+		"negative approvals":       "targets:\n  prod: {}\npolicies:\n  approvals-required: -1\n",
+		"not YAML":                 "targets: [",
+		"unknown policy":           "targets:\n  prod: {}\npolicies:\n  protect: [prod]\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseReconcile([]byte(data))
+			assert.ErrorContains(t, err, "invalid reconcile file")
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// RedactConfigKey is the key of the redaction settings of shared manifests in the config file
+const RedactConfigKey = "redact"
+
+// RedactConfig holds the settings of manifest export --redact
+type RedactConfig struct {
+	// ObjectDefaults is how the default values of object flags are redacted: mask, strip or keep.
+	// Defaults to mask.
+	ObjectDefaults string `mapstructure:"object-defaults"`
+	// InternalDomains are the domains whose URLs are removed from descriptions (e.g., corp.example.com)
+	InternalDomains []string `mapstructure:"internal-domains"`
+	// Fields are the fields removed from every flag (e.g., compliance, metadata.owner)
+	Fields []string `mapstructure:"fields"`
+}
+
+// LoadRedactConfig reads the redact block of the config
+func LoadRedactConfig(v *viper.Viper) (RedactConfig, error) {
+	var redact RedactConfig
+	if err := v.UnmarshalKey(RedactConfigKey, &redact); err != nil {
+		return RedactConfig{}, fmt.Errorf("invalid %s block in config: %w", RedactConfigKey, err)
+	}
+	return redact, nil
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/spf13/viper"
+)
+
+// SecretsConfigKey is the key of the secrets scanning settings in the config file
+const SecretsConfigKey = "secrets"
+
+// SecretsConfig holds the settings of the scan for secrets in the descriptions and default values of flags
+type SecretsConfig struct {
+	// Enabled turns the scan on. Defaults to true.
+	Enabled bool `mapstructure:"enabled"`
+	// Entropy reports words that look like random tokens, in addition to the known secret formats.
+	// Defaults to true.
+	Entropy bool `mapstructure:"entropy"`
+	// Patterns are additional regular expressions of secrets, by name (e.g., internal-token)
+	Patterns map[string]string `mapstructure:"patterns"`
+	// Allow are regular expressions of values that are never reported, such as publishable keys
+	Allow []string `mapstructure:"allow"`
+}
+
+// LoadSecretsConfig reads the secrets block of the config.
+// The scan is enabled with the built-in rules if the block is not set.
+func LoadSecretsConfig(v *viper.Viper) (SecretsConfig, error) {
+	secrets := SecretsConfig{Enabled: true, Entropy: true}
+	if !v.IsSet(SecretsConfigKey) {
+		return secrets, nil
+	}
+
+	if err := v.UnmarshalKey(SecretsConfigKey, &secrets); err != nil {
+		return SecretsConfig{}, fmt.Errorf("invalid %s block in config: %w", SecretsConfigKey, err)
+	}
+	return secrets, nil
+}
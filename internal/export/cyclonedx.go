@@ -0,0 +1,96 @@
+package export
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// cycloneDXPropertyPrefix namespaces the properties added by the CLI
+const cycloneDXPropertyPrefix = "openfeature:"
+
+type cycloneDXBOM struct {
+	BOMFormat   string              `json:"bomFormat"`
+	SpecVersion string              `json:"specVersion"`
+	Version     int                 `json:"version"`
+	Metadata    cycloneDXMetadata   `json:"metadata"`
+	Properties  []cycloneDXProperty `json:"properties"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     cycloneDXTools     `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	Type    string          `json:"type"`
+	Name    string          `json:"name"`
+	Version string          `json:"version,omitempty"`
+	Hashes  []cycloneDXHash `json:"hashes,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CycloneDX renders the flag inventory as a standalone CycloneDX bill of materials.
+// The manifest is described as a data component, and each flag is declared with
+// openfeature:flag:<key>:type and openfeature:flag:<key>:sha256 properties, so that
+// a release artifact can state which flags it was built against.
+// Ref: https://cyclonedx.org/docs/1.5/json/
+func CycloneDX(fs *flagset.Flagset, opts Options) ([]byte, error) {
+	manifestSum := sha256.Sum256(opts.ManifestData)
+
+	bom := cycloneDXBOM{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: opts.Timestamp.UTC().Format(time.RFC3339),
+			Tools: cycloneDXTools{
+				Components: []cycloneDXComponent{
+					{Type: "application", Name: "openfeature-cli", Version: opts.ToolVersion},
+				},
+			},
+			Component: cycloneDXComponent{
+				Type:   "data",
+				Name:   filepath.Base(opts.ManifestPath),
+				Hashes: []cycloneDXHash{{Alg: "SHA-256", Content: hex.EncodeToString(manifestSum[:])}},
+			},
+		},
+		Properties: []cycloneDXProperty{
+			{Name: cycloneDXPropertyPrefix + "flag-count", Value: strconv.Itoa(len(fs.Flags))},
+		},
+	}
+
+	for _, flag := range fs.Flags {
+		hash, err := FlagHash(flag)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix := cycloneDXPropertyPrefix + "flag:" + flag.Key + ":"
+		bom.Properties = append(bom.Properties,
+			cycloneDXProperty{Name: prefix + "type", Value: flag.Type.String()},
+			cycloneDXProperty{Name: prefix + "sha256", Value: hash},
+		)
+	}
+
+	return json.MarshalIndent(bom, "", "  ")
+}
@@ -0,0 +1,72 @@
+// Package export converts a flag manifest into formats consumed by other tools,
+// such as software bills of materials.
+package export
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// Format represents the available export formats
+type Format string
+
+const (
+	// FormatCycloneDX represents a CycloneDX bill of materials declaring the flag inventory
+	FormatCycloneDX Format = "cyclonedx"
+)
+
+// IsValidFormat checks if the given format is a valid export format
+func IsValidFormat(format string) bool {
+	switch Format(format) {
+	case FormatCycloneDX:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetValidFormats returns a list of all valid export formats
+func GetValidFormats() []string {
+	return []string{
+		string(FormatCycloneDX),
+	}
+}
+
+// Options holds the context of an export
+type Options struct {
+	// ManifestPath is the path of the exported manifest
+	ManifestPath string
+	// ManifestData is the raw content of the exported manifest
+	ManifestData []byte
+	// ToolVersion is the version of the CLI performing the export
+	ToolVersion string
+	// Timestamp is the time of the export
+	Timestamp time.Time
+}
+
+// Render renders the flagset in the given format
+func Render(format Format, fs *flagset.Flagset, opts Options) ([]byte, error) {
+	switch format {
+	case FormatCycloneDX:
+		return CycloneDX(fs, opts)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// FlagHash returns a stable SHA-256 hash of the definition of a flag, so that two
+// exports can tell whether a flag changed between builds.
+func FlagHash(flag flagset.Flag) (string, error) {
+	data, err := json.Marshal(&flagset.Flagset{Flags: []flagset.Flag{flag}})
+	if err != nil {
+		return "", fmt.Errorf("error marshaling flag %s: %w", flag.Key, err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
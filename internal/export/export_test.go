@@ -0,0 +1,68 @@
+package export
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFlagset = &flagset.Flagset{
+	Flags: []flagset.Flag{
+		{Key: "enable-feature", Type: flagset.BoolType, DefaultValue: true, Description: "Enables the feature"},
+		{Key: "greeting", Type: flagset.StringType, DefaultValue: "hello"},
+	},
+}
+
+func TestCycloneDX(t *testing.T) {
+	data, err := Render(FormatCycloneDX, testFlagset, Options{
+		ManifestPath: "config/flags.json",
+		ManifestData: []byte(`{"flags": {}}`),
+		ToolVersion:  "1.2.3",
+		Timestamp:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	var bom cycloneDXBOM
+	require.NoError(t, json.Unmarshal(data, &bom))
+
+	assert.Equal(t, "CycloneDX", bom.BOMFormat)
+	assert.Equal(t, "2026-01-02T03:04:05Z", bom.Metadata.Timestamp)
+	assert.Equal(t, "1.2.3", bom.Metadata.Tools.Components[0].Version)
+	assert.Equal(t, "flags.json", bom.Metadata.Component.Name)
+	assert.Equal(t, "SHA-256", bom.Metadata.Component.Hashes[0].Alg)
+
+	hash, err := FlagHash(testFlagset.Flags[0])
+	require.NoError(t, err)
+
+	assert.Equal(t, []cycloneDXProperty{
+		{Name: "openfeature:flag-count", Value: "2"},
+		{Name: "openfeature:flag:enable-feature:type", Value: "boolean"},
+		{Name: "openfeature:flag:enable-feature:sha256", Value: hash},
+		{Name: "openfeature:flag:greeting:type", Value: "string"},
+		{Name: "openfeature:flag:greeting:sha256", Value: bom.Properties[4].Value},
+	}, bom.Properties)
+}
+
+func TestFlagHash(t *testing.T) {
+	flag := testFlagset.Flags[0]
+
+	first, err := FlagHash(flag)
+	require.NoError(t, err)
+	second, err := FlagHash(flag)
+	require.NoError(t, err)
+	assert.Equal(t, first, second, "hash should be stable")
+
+	flag.DefaultValue = false
+	changed, err := FlagHash(flag)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, changed, "hash should change with the flag definition")
+}
+
+func TestRenderInvalidFormat(t *testing.T) {
+	_, err := Render(Format("spdx"), testFlagset, Options{})
+	assert.EqualError(t, err, "unsupported export format: spdx")
+}
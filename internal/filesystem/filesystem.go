@@ -70,6 +70,16 @@ func Exists(path string) (bool, error) {
 	return true, nil
 }
 
+// Returns the path of an entry in the OpenFeature CLI cache directory,
+// which is located in the user cache directory (e.g., ~/.cache/openfeature).
+func CachePath(elem ...string) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("error locating the cache directory: %w", err)
+	}
+	return filepath.Join(append([]string{cacheDir, "openfeature"}, elem...)...), nil
+}
+
 func init() {
 	viper.SetDefault(viperKey, afero.NewOsFs())
 }
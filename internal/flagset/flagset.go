@@ -1,9 +1,14 @@
 package flagset
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"math/big"
+	"slices"
 	"sort"
+	"strings"
+	"time"
 )
 
 // FlagType are the primitive types of flags.
@@ -40,11 +45,140 @@ type Flag struct {
 	Key          string
 	Type         FlagType
 	Description  string
+	Descriptions map[string]string
 	DefaultValue any
+	// DefaultValueFile is the path of the file holding the default value of an object flag,
+	// relative to the manifest. DefaultValue holds its contents once resolved.
+	DefaultValueFile string
+	// DefaultValueSha256 is the SHA-256 hash of the contents of DefaultValueFile
+	DefaultValueSha256 string
+	Experiment         *Experiment
+	KillSwitch         bool
+	Compliance         *Compliance
+	ActiveFrom         *time.Time
+	ActiveUntil        *time.Time
+	// Metadata holds provider-side information about the flag, such as its maintainer or tags.
+	// Flags loaded from a remote source carry every field of the source that is not part of the flag itself.
+	Metadata map[string]any
+	// Tags are labels of the flag in the manifest, for example to exclude it from code generation
+	Tags []string
+	// SkipCodegen excludes the flag from code generation ("codegen": false in the manifest)
+	SkipCodegen bool
+	// Visibility is where the flag may be evaluated: client, server or both. Empty if unclassified.
+	Visibility string
+	// Cache holds hints on how long generated clients may cache evaluations of the flag
+	Cache *Cache
+	// Initiative is the epic or initiative the flag is delivered as part of. Empty if unassigned.
+	Initiative string
+	// Risk is how risky changing the flag is: low, medium or high. Empty if unassessed.
+	Risk string
+}
+
+// Visibilities of a flag
+const (
+	VisibilityClient = "client"
+	VisibilityServer = "server"
+	VisibilityBoth   = "both"
+)
+
+// GetValidVisibilities returns a list of all valid flag visibilities
+func GetValidVisibilities() []string {
+	return []string{VisibilityClient, VisibilityServer, VisibilityBoth}
+}
+
+// Risks of changing a flag
+const (
+	RiskLow    = "low"
+	RiskMedium = "medium"
+	RiskHigh   = "high"
+)
+
+// GetValidRisks returns a list of all valid flag risks
+func GetValidRisks() []string {
+	return []string{RiskLow, RiskMedium, RiskHigh}
+}
+
+// IsClientVisible reports whether the flag may be exposed to client-side code.
+// Unclassified flags are client visible.
+func (f Flag) IsClientVisible() bool {
+	return f.Visibility != VisibilityServer
+}
+
+// IsActive reports whether the flag's activation window, if any, contains the given time.
+// The window includes ActiveFrom and excludes ActiveUntil.
+func (f Flag) IsActive(now time.Time) bool {
+	if f.ActiveFrom != nil && now.Before(*f.ActiveFrom) {
+		return false
+	}
+	if f.ActiveUntil != nil && !now.Before(*f.ActiveUntil) {
+		return false
+	}
+	return true
+}
+
+// Experiment holds optional metadata about an experiment that a flag is part of.
+type Experiment struct {
+	ID         string `json:"id,omitempty"`
+	Hypothesis string `json:"hypothesis,omitempty"`
+	Metric     string `json:"metric,omitempty"`
+}
+
+// Compliance holds optional data protection metadata about a flag.
+type Compliance struct {
+	PII           bool     `json:"pii,omitempty"`
+	DataResidency bool     `json:"dataResidency,omitempty"`
+	DPIA          string   `json:"dpia,omitempty"`
+	Regions       []string `json:"regions,omitempty"`
+}
+
+// AllowsRegion reports whether the flag may be pushed to a target in the given region.
+// Flags without a region list may be pushed anywhere.
+func (c *Compliance) AllowsRegion(region string) bool {
+	if c == nil || len(c.Regions) == 0 {
+		return true
+	}
+	for _, allowed := range c.Regions {
+		if strings.EqualFold(allowed, region) {
+			return true
+		}
+	}
+	return false
+}
+
+// Cache holds optional hints on how long generated clients may cache evaluations of a flag.
+// Durations are Go duration strings (e.g., 30s, 5m).
+type Cache struct {
+	TTL                  string `json:"ttl,omitempty"`
+	StaleWhileRevalidate string `json:"staleWhileRevalidate,omitempty"`
+}
+
+// manifestFlag is the representation of a single flag in the manifest file.
+type manifestFlag struct {
+	FlagType           string            `json:"flagType"`
+	Description        string            `json:"description"`
+	Descriptions       map[string]string `json:"descriptions,omitempty"`
+	DefaultValue       any               `json:"defaultValue"`
+	DefaultValueFile   string            `json:"defaultValueFile,omitempty"`
+	DefaultValueSha256 string            `json:"defaultValueSha256,omitempty"`
+	Experiment         *Experiment       `json:"experiment,omitempty"`
+	KillSwitch         bool              `json:"killSwitch,omitempty"`
+	Compliance         *Compliance       `json:"compliance,omitempty"`
+	ActiveFrom         string            `json:"activeFrom,omitempty"`
+	ActiveUntil        string            `json:"activeUntil,omitempty"`
+	Metadata           map[string]any    `json:"metadata,omitempty"`
+	Tags               []string          `json:"tags,omitempty"`
+	Codegen            *bool             `json:"codegen,omitempty"`
+	Visibility         string            `json:"visibility,omitempty"`
+	Cache              *Cache            `json:"cache,omitempty"`
+	Initiative         string            `json:"initiative,omitempty"`
+	Risk               string            `json:"risk,omitempty"`
 }
 
 type Flagset struct {
 	Flags []Flag
+	// Timezone is the IANA timezone (e.g., Europe/Berlin) of the activeFrom and activeUntil dates
+	// without a time in the manifest. Empty for the default timezone.
+	Timezone string
 }
 
 // Filter removes flags from the Flagset that are of unsupported types.
@@ -58,6 +192,54 @@ func (fs *Flagset) Filter(unsupportedFlagTypes map[FlagType]bool) *Flagset {
 	return &filtered
 }
 
+// ForCodegen returns a copy of the Flagset without the flags that are excluded from code generation,
+// either with "codegen": false in the manifest or because they have one of the excluded tags.
+func (fs *Flagset) ForCodegen(excludeTags []string) *Flagset {
+	var included Flagset
+	for _, flag := range fs.Flags {
+		if flag.SkipCodegen || slices.ContainsFunc(flag.Tags, func(tag string) bool {
+			return slices.Contains(excludeTags, tag)
+		}) {
+			continue
+		}
+		included.Flags = append(included.Flags, flag)
+	}
+	return &included
+}
+
+// ClientVisible returns a copy of the Flagset without the server-only flags
+func (fs *Flagset) ClientVisible() *Flagset {
+	var visible Flagset
+	for _, flag := range fs.Flags {
+		if flag.IsClientVisible() {
+			visible.Flags = append(visible.Flags, flag)
+		}
+	}
+	return &visible
+}
+
+// Localize returns a copy of the Flagset where the description of each flag is replaced
+// by its variant for the given locale (e.g., de, fr-CA). A region specific locale falls
+// back to its language (fr-CA to fr). Flags without a matching variant keep their default description.
+func (fs *Flagset) Localize(locale string) *Flagset {
+	if locale == "" {
+		return fs
+	}
+
+	language, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+
+	var localized Flagset
+	for _, flag := range fs.Flags {
+		if description, ok := flag.Descriptions[locale]; ok {
+			flag.Description = description
+		} else if description, ok := flag.Descriptions[language]; ok {
+			flag.Description = description
+		}
+		localized.Flags = append(localized.Flags, flag)
+	}
+	return &localized
+}
+
 // ParseFlagType converts a string flag type to FlagType enum
 func ParseFlagType(typeStr string) (FlagType, error) {
 	switch typeStr {
@@ -79,16 +261,22 @@ func ParseFlagType(typeStr string) (FlagType, error) {
 // UnmarshalJSON unmarshals the JSON data into a Flagset. It is used by json.Unmarshal.
 func (fs *Flagset) UnmarshalJSON(data []byte) error {
 	var manifest struct {
-		Flags map[string]struct {
-			FlagType     string `json:"flagType"`
-			Description  string `json:"description"`
-			DefaultValue any    `json:"defaultValue"`
-		} `json:"flags"`
+		Timezone string                  `json:"timezone"`
+		Flags    map[string]manifestFlag `json:"flags"`
+	}
+
+	// Decode numbers exactly, so that integer defaults don't lose precision as float64
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&manifest); err != nil {
+		return err
 	}
 
-	if err := json.Unmarshal(data, &manifest); err != nil {
+	loc, err := Location(manifest.Timezone)
+	if err != nil {
 		return err
 	}
+	fs.Timezone = manifest.Timezone
 
 	for key, flag := range manifest.Flags {
 		flagType, err := ParseFlagType(flag.FlagType)
@@ -96,11 +284,39 @@ func (fs *Flagset) UnmarshalJSON(data []byte) error {
 			return err
 		}
 
+		defaultValue, err := normalizeDefaultValue(flagType, flag.DefaultValue)
+		if err != nil {
+			return fmt.Errorf("invalid defaultValue for flag %s: %w", key, err)
+		}
+		activeFrom, err := parseSchedule(flag.ActiveFrom, loc)
+		if err != nil {
+			return fmt.Errorf("invalid activeFrom for flag %s: %w", key, err)
+		}
+		activeUntil, err := parseSchedule(flag.ActiveUntil, loc)
+		if err != nil {
+			return fmt.Errorf("invalid activeUntil for flag %s: %w", key, err)
+		}
+
 		fs.Flags = append(fs.Flags, Flag{
-			Key:          key,
-			Type:         flagType,
-			Description:  flag.Description,
-			DefaultValue: flag.DefaultValue,
+			Key:                key,
+			Type:               flagType,
+			Description:        flag.Description,
+			Descriptions:       flag.Descriptions,
+			DefaultValue:       defaultValue,
+			DefaultValueFile:   flag.DefaultValueFile,
+			DefaultValueSha256: flag.DefaultValueSha256,
+			Experiment:         flag.Experiment,
+			KillSwitch:         flag.KillSwitch,
+			Compliance:         flag.Compliance,
+			ActiveFrom:         activeFrom,
+			ActiveUntil:        activeUntil,
+			Metadata:           flag.Metadata,
+			Tags:               flag.Tags,
+			SkipCodegen:        flag.Codegen != nil && !*flag.Codegen,
+			Visibility:         flag.Visibility,
+			Cache:              flag.Cache,
+			Initiative:         flag.Initiative,
+			Risk:               flag.Risk,
 		})
 	}
 
@@ -115,28 +331,37 @@ func (fs *Flagset) UnmarshalJSON(data []byte) error {
 // MarshalJSON marshals a Flagset into JSON format compatible with the manifest structure
 func (fs *Flagset) MarshalJSON() ([]byte, error) {
 	manifest := struct {
-		Flags map[string]struct {
-			FlagType     string `json:"flagType"`
-			Description  string `json:"description"`
-			DefaultValue any    `json:"defaultValue"`
-		} `json:"flags"`
+		Timezone string                  `json:"timezone,omitempty"`
+		Flags    map[string]manifestFlag `json:"flags"`
 	}{
-		Flags: make(map[string]struct {
-			FlagType     string `json:"flagType"`
-			Description  string `json:"description"`
-			DefaultValue any    `json:"defaultValue"`
-		}),
+		Timezone: fs.Timezone,
+		Flags:    make(map[string]manifestFlag),
 	}
 
 	for _, flag := range fs.Flags {
-		manifest.Flags[flag.Key] = struct {
-			FlagType     string `json:"flagType"`
-			Description  string `json:"description"`
-			DefaultValue any    `json:"defaultValue"`
-		}{
-			FlagType:     flag.Type.String(),
-			Description:  flag.Description,
-			DefaultValue: flag.DefaultValue,
+		var codegen *bool
+		if flag.SkipCodegen {
+			codegen = new(bool)
+		}
+		manifest.Flags[flag.Key] = manifestFlag{
+			FlagType:           flag.Type.String(),
+			Description:        flag.Description,
+			Descriptions:       flag.Descriptions,
+			DefaultValue:       flag.DefaultValue,
+			DefaultValueFile:   flag.DefaultValueFile,
+			DefaultValueSha256: flag.DefaultValueSha256,
+			Experiment:         flag.Experiment,
+			KillSwitch:         flag.KillSwitch,
+			Compliance:         flag.Compliance,
+			ActiveFrom:         formatSchedule(flag.ActiveFrom),
+			ActiveUntil:        formatSchedule(flag.ActiveUntil),
+			Metadata:           flag.Metadata,
+			Tags:               flag.Tags,
+			Codegen:            codegen,
+			Visibility:         flag.Visibility,
+			Cache:              flag.Cache,
+			Initiative:         flag.Initiative,
+			Risk:               flag.Risk,
 		}
 	}
 
@@ -145,18 +370,18 @@ func (fs *Flagset) MarshalJSON() ([]byte, error) {
 
 func LoadFromSourceFlags(data []byte) (*[]Flag, error) {
 	type SourceFlag struct {
-		Key          string `json:"key"`
-		Type         string `json:"type"`
-		Description  string `json:"description"`
-		DefaultValue any    `json:"defaultValue"`
+		Key          string          `json:"key"`
+		Type         string          `json:"type"`
+		Description  string          `json:"description"`
+		DefaultValue json.RawMessage `json:"defaultValue"`
 	}
 
 	// First try to unmarshal as an object with a "flags" property
 	var sourceWithWrapper struct {
-		Flags []SourceFlag `json:"flags"`
+		Flags []json.RawMessage `json:"flags"`
 	}
 
-	var sourceFlagsArray []SourceFlag
+	var sourceFlagsArray []json.RawMessage
 
 	if err := json.Unmarshal(data, &sourceWithWrapper); err == nil && sourceWithWrapper.Flags != nil {
 		// Successfully unmarshaled as object with flags property (even if empty)
@@ -169,19 +394,119 @@ func LoadFromSourceFlags(data []byte) (*[]Flag, error) {
 	}
 
 	var flags []Flag
-	for _, sf := range sourceFlagsArray {
+	for _, raw := range sourceFlagsArray {
+		var sf SourceFlag
+		var fields map[string]any
+		if err := json.Unmarshal(raw, &sf); err != nil {
+			return nil, fmt.Errorf("failed to parse flags: %w", err)
+		}
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			return nil, fmt.Errorf("failed to parse flags: %w", err)
+		}
+
 		flagType, err := ParseFlagType(sf.Type)
 		if err != nil {
 			return nil, err
 		}
 
+		defaultValue, err := DecodeDefaultValue(flagType, sf.DefaultValue)
+		if err != nil {
+			return nil, fmt.Errorf("invalid defaultValue for flag %s: %w", sf.Key, err)
+		}
+
 		flags = append(flags, Flag{
 			Key:          sf.Key,
 			Type:         flagType,
 			Description:  sf.Description,
-			DefaultValue: sf.DefaultValue,
+			DefaultValue: defaultValue,
+			Metadata:     ProviderMetadata(fields),
 		})
 	}
 
 	return &flags, nil
 }
+
+// ProviderMetadata returns the fields of a flag from a remote source that are not part of the
+// flag itself (key, type, description, and default value), or nil if there are none
+func ProviderMetadata(fields map[string]any) map[string]any {
+	var metadata map[string]any
+	for name, value := range fields {
+		switch name {
+		case "key", "type", "description", "defaultValue":
+			continue
+		}
+		if metadata == nil {
+			metadata = make(map[string]any)
+		}
+		metadata[name] = value
+	}
+	return metadata
+}
+
+// DecodeDefaultValue decodes the JSON encoded default value of a flag of the given type.
+// The default values of integer flags are decoded as int64, so that they survive a round trip
+// without losing precision or turning into floats. All other numbers are decoded as float64.
+// Empty data decodes to nil.
+func DecodeDefaultValue(flagType FlagType, data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return normalizeDefaultValue(flagType, value)
+}
+
+// normalizeDefaultValue converts the json.Number values of a default value decoded with UseNumber
+// to int64 for the default value of an integer flag, and to float64 everywhere else
+func normalizeDefaultValue(flagType FlagType, value any) (any, error) {
+	if number, ok := value.(json.Number); ok && flagType == IntType {
+		return parseInteger(number)
+	}
+	return numbersToFloat(value), nil
+}
+
+// parseInteger parses a JSON number as an int64. Decimal and scientific notation (e.g., 10.0 or 1e3)
+// are accepted if the number is a whole number in the int64 range.
+func parseInteger(number json.Number) (int64, error) {
+	if i, err := number.Int64(); err == nil {
+		return i, nil
+	}
+
+	f, _, err := big.ParseFloat(number.String(), 10, 256, big.ToNearestEven)
+	if err != nil {
+		return 0, err
+	}
+	if !f.IsInt() {
+		return 0, fmt.Errorf("%s is not an integer", number)
+	}
+
+	i, accuracy := f.Int64()
+	if accuracy != big.Exact {
+		return 0, fmt.Errorf("%s is out of the int64 range", number)
+	}
+	return i, nil
+}
+
+// numbersToFloat converts all json.Number values, including those nested in objects and arrays, to float64
+func numbersToFloat(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for key, elem := range v {
+			v[key] = numbersToFloat(elem)
+		}
+	case []any:
+		for i, elem := range v {
+			v[i] = numbersToFloat(elem)
+		}
+	}
+	return value
+}
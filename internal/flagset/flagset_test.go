@@ -0,0 +1,233 @@
+package flagset
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalize(t *testing.T) {
+	fs := &Flagset{
+		Flags: []Flag{
+			{
+				Key:         "translated",
+				Description: "Default",
+				Descriptions: map[string]string{
+					"fr":    "Français",
+					"fr-CA": "Français canadien",
+				},
+			},
+			{
+				Key:         "untranslated",
+				Description: "Default",
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		locale   string
+		expected string
+	}{
+		{name: "no locale", locale: "", expected: "Default"},
+		{name: "exact match", locale: "fr-CA", expected: "Français canadien"},
+		{name: "language fallback", locale: "fr-BE", expected: "Français"},
+		{name: "underscore separator", locale: "fr_CH", expected: "Français"},
+		{name: "missing locale", locale: "de", expected: "Default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localized := fs.Localize(tt.locale)
+			assert.Equal(t, tt.expected, localized.Flags[0].Description)
+			assert.Equal(t, "Default", localized.Flags[1].Description)
+		})
+	}
+
+	// The original flagset is not modified
+	assert.Equal(t, "Default", fs.Flags[0].Description)
+}
+
+func TestIsActive(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		flag     Flag
+		now      time.Time
+		expected bool
+	}{
+		{name: "no window", flag: Flag{}, now: from, expected: true},
+		{name: "before start", flag: Flag{ActiveFrom: &from}, now: from.Add(-time.Second), expected: false},
+		{name: "at start", flag: Flag{ActiveFrom: &from, ActiveUntil: &until}, now: from, expected: true},
+		{name: "at end", flag: Flag{ActiveFrom: &from, ActiveUntil: &until}, now: until, expected: false},
+		{name: "open start", flag: Flag{ActiveUntil: &until}, now: from, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.flag.IsActive(tt.now))
+		})
+	}
+}
+
+func TestDecodeDefaultValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		flagType      FlagType
+		data          string
+		expected      any
+		expectedError string
+	}{
+		{name: "integer", flagType: IntType, data: `1`, expected: int64(1)},
+		{name: "max int64", flagType: IntType, data: `9223372036854775807`, expected: int64(math.MaxInt64)},
+		{name: "min int64", flagType: IntType, data: `-9223372036854775808`, expected: int64(math.MinInt64)},
+		{name: "beyond float64 precision", flagType: IntType, data: `9007199254740993`, expected: int64(9007199254740993)},
+		{name: "scientific notation", flagType: IntType, data: `1e3`, expected: int64(1000)},
+		{name: "whole decimal", flagType: IntType, data: `10.0`, expected: int64(10)},
+		{name: "large scientific notation", flagType: IntType, data: `9.223372036854775807E18`, expected: int64(math.MaxInt64)},
+		{name: "fraction", flagType: IntType, data: `1.5`, expectedError: "1.5 is not an integer"},
+		{name: "out of range", flagType: IntType, data: `9223372036854775808`, expectedError: "out of the int64 range"},
+		{name: "out of range scientific notation", flagType: IntType, data: `1e19`, expectedError: "out of the int64 range"},
+		{name: "float", flagType: FloatType, data: `1`, expected: float64(1)},
+		{name: "float scientific notation", flagType: FloatType, data: `2.5e-3`, expected: 0.0025},
+		{name: "nested numbers are floats", flagType: ObjectType, data: `{"a":[1,{"b":2}]}`, expected: map[string]any{"a": []any{float64(1), map[string]any{"b": float64(2)}}}},
+		{name: "null", flagType: IntType, data: `null`, expected: nil},
+		{name: "empty", flagType: IntType, data: ``, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := DecodeDefaultValue(tt.flagType, []byte(tt.data))
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestIntegerDefaultValueRoundTrip(t *testing.T) {
+	data := []byte(`{
+		"flags": {
+			"big-int": {"flagType": "integer", "defaultValue": 9007199254740993},
+			"sci-int": {"flagType": "integer", "defaultValue": 2E3},
+			"ratio": {"flagType": "float", "defaultValue": 0.5}
+		}
+	}`)
+
+	var fs Flagset
+	require.NoError(t, json.Unmarshal(data, &fs))
+	assert.Equal(t, int64(9007199254740993), fs.Flags[0].DefaultValue)
+	assert.Equal(t, 0.5, fs.Flags[1].DefaultValue)
+	assert.Equal(t, int64(2000), fs.Flags[2].DefaultValue)
+
+	out, err := json.Marshal(&fs)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `"defaultValue":9007199254740993`)
+	assert.Contains(t, string(out), `"defaultValue":2000`)
+
+	var invalid Flagset
+	err = json.Unmarshal([]byte(`{"flags": {"bad": {"flagType": "integer", "defaultValue": 1.5}}}`), &invalid)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid defaultValue for flag bad")
+}
+
+func TestForCodegen(t *testing.T) {
+	data := []byte(`{
+		"flags": {
+			"client-flag": {"flagType": "boolean", "defaultValue": true, "tags": ["checkout"]},
+			"secret-flag": {"flagType": "boolean", "defaultValue": true, "codegen": false},
+			"server-flag": {"flagType": "boolean", "defaultValue": true, "tags": ["server-only"]}
+		}
+	}`)
+
+	var fs Flagset
+	require.NoError(t, json.Unmarshal(data, &fs))
+	assert.True(t, fs.Flags[1].SkipCodegen)
+
+	keys := func(fs *Flagset) []string {
+		var keys []string
+		for _, flag := range fs.Flags {
+			keys = append(keys, flag.Key)
+		}
+		return keys
+	}
+	assert.Equal(t, []string{"client-flag", "server-flag"}, keys(fs.ForCodegen(nil)))
+	assert.Equal(t, []string{"client-flag"}, keys(fs.ForCodegen([]string{"server-only"})))
+
+	// codegen and tags survive a round trip
+	out, err := json.Marshal(&fs)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `"codegen":false`)
+	assert.Contains(t, string(out), `"tags":["server-only"]`)
+}
+
+func TestClientVisible(t *testing.T) {
+	data := []byte(`{
+		"flags": {
+			"both-flag": {"flagType": "boolean", "defaultValue": true, "visibility": "both"},
+			"client-flag": {"flagType": "boolean", "defaultValue": true, "visibility": "client"},
+			"server-flag": {"flagType": "boolean", "defaultValue": true, "visibility": "server"},
+			"unclassified-flag": {"flagType": "boolean", "defaultValue": true}
+		}
+	}`)
+
+	var fs Flagset
+	require.NoError(t, json.Unmarshal(data, &fs))
+
+	var keys []string
+	for _, flag := range fs.ClientVisible().Flags {
+		keys = append(keys, flag.Key)
+	}
+	assert.Equal(t, []string{"both-flag", "client-flag", "unclassified-flag"}, keys)
+
+	out, err := json.Marshal(&fs)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), `"visibility":"server"`)
+}
+
+func TestUnmarshalJSON_ScheduleTimezone(t *testing.T) {
+	t.Run("dates start in the timezone of the manifest", func(t *testing.T) {
+		var fs Flagset
+		err := json.Unmarshal([]byte(`{
+			"timezone": "Europe/Berlin",
+			"flags": {
+				"sale": {"flagType": "boolean", "defaultValue": false, "activeFrom": "2026-12-01", "activeUntil": "2026-12-24T18:00:00-05:00"}
+			}
+		}`), &fs)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Europe/Berlin", fs.Timezone)
+		require.Len(t, fs.Flags, 1)
+		assert.Equal(t, time.Date(2026, 11, 30, 23, 0, 0, 0, time.UTC), fs.Flags[0].ActiveFrom.UTC())
+		assert.Equal(t, time.Date(2026, 12, 24, 23, 0, 0, 0, time.UTC), fs.Flags[0].ActiveUntil.UTC())
+	})
+
+	t.Run("dates start in the default timezone", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+		SetDefaultTimezone(loc)
+		t.Cleanup(func() { SetDefaultTimezone(time.UTC) })
+
+		var fs Flagset
+		err = json.Unmarshal([]byte(`{"flags": {"sale": {"flagType": "boolean", "defaultValue": false, "activeUntil": "2026-12-24"}}}`), &fs)
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 12, 24, 5, 0, 0, 0, time.UTC), fs.Flags[0].ActiveUntil.UTC())
+	})
+
+	t.Run("fails for an unknown timezone", func(t *testing.T) {
+		var fs Flagset
+		err := json.Unmarshal([]byte(`{"timezone": "Mars/Olympus", "flags": {}}`), &fs)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid timezone "Mars/Olympus"`)
+	})
+}
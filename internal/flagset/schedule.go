@@ -0,0 +1,63 @@
+package flagset
+
+import (
+	"fmt"
+	"time"
+	// Timezones are loaded by name on systems without a timezone database, such as the alpine image
+	_ "time/tzdata"
+)
+
+// defaultLocation is the timezone of dates without a time in manifests that don't set a timezone
+var defaultLocation = time.UTC
+
+// SetDefaultTimezone sets the timezone of activeFrom and activeUntil dates without a time
+// (e.g., 2026-12-31) in manifests that don't set a timezone. Defaults to UTC.
+func SetDefaultTimezone(loc *time.Location) {
+	defaultLocation = loc
+}
+
+// Location returns the timezone of the named IANA timezone (e.g., Europe/Berlin), or the default
+// timezone if the name is empty
+func Location(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return defaultLocation, nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+	return loc, nil
+}
+
+// ParseScheduleTime parses an activeFrom or activeUntil value: an RFC 3339 timestamp, or a date
+// (e.g., 2026-12-31) that stands for the start of the day in the given timezone
+func ParseScheduleTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation(time.DateOnly, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected an RFC 3339 timestamp or a date (e.g., 2026-12-31)", value)
+	}
+	return t, nil
+}
+
+// parseSchedule parses an optional activeFrom or activeUntil value of the manifest
+func parseSchedule(value string, loc *time.Location) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := ParseScheduleTime(value, loc)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// formatSchedule formats an optional activeFrom or activeUntil time for the manifest
+func formatSchedule(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -15,7 +15,10 @@ type AngularGenerator struct {
 }
 
 // Params holds Angular-specific generation parameters.
-type Params struct{}
+type Params struct {
+	// NgModule also generates an NgModule exporting the directives, for module-based applications
+	NgModule bool
+}
 
 //go:embed angular.tmpl
 var angularTmpl string
@@ -93,17 +96,23 @@ func (g *AngularGenerator) Generate(params *generators.Params[Params]) error {
 	}
 
 	newParams := &generators.Params[any]{
-		OutputPath:   params.OutputPath,
-		TemplatePath: params.TemplatePath,
-		Custom:       Params{},
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom: Params{
+			NgModule: params.Custom.NgModule,
+		},
 	}
 
 	return g.GenerateFile(funcs, angularTmpl, newParams, "openfeature.generated.ts")
 }
 
-// NewGenerator creates a generator for Angular.
+// NewGenerator creates a generator for Angular. The generated code runs on the client, so server-only
+// flags are left out.
 func NewGenerator(fs *flagset.Flagset) *AngularGenerator {
 	return &AngularGenerator{
-		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{}),
+		CommonGenerator: *generators.NewGenerator(fs.ClientVisible(), map[flagset.FlagType]bool{}),
 	}
 }
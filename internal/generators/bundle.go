@@ -0,0 +1,83 @@
+package generators
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// BundleReport is an estimate of the size generated JavaScript or TypeScript code adds to a bundle
+type BundleReport struct {
+	// Bytes is the size of the generated file
+	Bytes int
+	// GzipBytes is the size of the generated file compressed with gzip, as commonly served
+	GzipBytes int
+	// Flags is the estimated size of each flag, in the order of the flagset
+	Flags []BundleFlag
+}
+
+// BundleFlag is the estimated bundle size of a single flag
+type BundleFlag struct {
+	Key  string
+	Type flagset.FlagType
+	// DefaultBytes is the size of the JSON encoded default value embedded in the generated code
+	DefaultBytes int
+	// CodeBytes is the size of the generated lines that reference the flag, excluding comments,
+	// which bundlers strip
+	CodeBytes int
+}
+
+// EstimateBundleSize estimates the bundle size of the generated output and of each flag in it
+func EstimateBundleSize(fs *flagset.Flagset, output []byte) BundleReport {
+	report := BundleReport{Bytes: len(output), GzipBytes: gzipSize(output)}
+
+	var code []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if !isCommentLine(line) {
+			code = append(code, line)
+		}
+	}
+
+	for _, flag := range fs.Flags {
+		defaultValue, _ := json.Marshal(flag.DefaultValue)
+		estimate := BundleFlag{Key: flag.Key, Type: flag.Type, DefaultBytes: len(defaultValue)}
+
+		// The generated code refers to a flag by its quoted key or by identifiers derived from it
+		references := []string{
+			strconv.Quote(flag.Key),
+			strcase.ToCamel(flag.Key),
+			strcase.ToLowerCamel(flag.Key),
+			strcase.ToScreamingSnake(flag.Key),
+		}
+		for _, line := range code {
+			for _, reference := range references {
+				if strings.Contains(line, reference) {
+					estimate.CodeBytes += len(line) + 1
+					break
+				}
+			}
+		}
+		report.Flags = append(report.Flags, estimate)
+	}
+	return report
+}
+
+// isCommentLine reports whether the line is part of a comment
+func isCommentLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "/*") || strings.HasPrefix(trimmed, "*")
+}
+
+// gzipSize returns the size of the data compressed with gzip
+func gzipSize(data []byte) int {
+	var buf bytes.Buffer
+	w, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	_, _ = w.Write(data)
+	_ = w.Close()
+	return buf.Len()
+}
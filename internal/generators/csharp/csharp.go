@@ -19,8 +19,18 @@ type CsharpGenerator struct {
 type Params struct {
 	// Add C# specific parameters here if needed
 	Namespace string
+	// DIExtensions adds the IServiceCollection extension methods that register the generated client
+	DIExtensions bool
+	// Nullable enables nullable reference types and annotates the optional parameters
+	Nullable bool
+	// XMLDocs adds XML documentation comments, from the descriptions of the flags
+	XMLDocs bool
 }
 
+// xmlDocEscaper escapes the characters with a special meaning in XML documentation comments,
+// and joins lines, as a new line would end the comment
+var xmlDocEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r\n", " ", "\n", " ")
+
 //go:embed csharp.tmpl
 var csharpTmpl string
 
@@ -121,20 +131,45 @@ func formatNestedValue(value any) string {
 	}
 }
 
+// xmlDoc formats a value for an XML documentation comment
+func xmlDoc(value any) string {
+	return xmlDocEscaper.Replace(fmt.Sprint(value))
+}
+
+// stripDocComments removes the XML documentation comments from the generated code
+func stripDocComments(data []byte) ([]byte, error) {
+	var b strings.Builder
+	for _, line := range strings.SplitAfter(string(data), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "///") {
+			continue
+		}
+		b.WriteString(line)
+	}
+	return []byte(b.String()), nil
+}
+
 func (g *CsharpGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"OpenFeatureType":    openFeatureType,
 		"FormatDefaultValue": formatDefaultValue,
 		"ToCSharpDict":       toCSharpDict,
+		"XMLDoc":             xmlDoc,
 	}
 
 	newParams := &generators.Params[any]{
-		OutputPath:   params.OutputPath,
-		TemplatePath: params.TemplatePath,
-		Custom:       params.Custom,
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            params.Custom,
 	}
 
-	return g.GenerateFile(funcs, csharpTmpl, newParams, "OpenFeature.g.cs")
+	generator := *g
+	if !params.Custom.XMLDocs {
+		generator.Formatter = stripDocComments
+	}
+	return generator.GenerateFile(funcs, csharpTmpl, newParams, "OpenFeature.g.cs")
 }
 
 // NewGenerator creates a generator for C#.
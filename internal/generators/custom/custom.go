@@ -0,0 +1,89 @@
+package custom
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+	"github.com/spf13/afero"
+)
+
+type CustomGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+	// TemplateDir is the directory of the templates to render
+	TemplateDir string
+}
+
+func toJSONString(value any) string {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "null"
+	}
+	return string(bytes)
+}
+
+// Generate renders every file of the template directory against the flagset, writing each one to
+// the same relative path in the output directory. A .tmpl extension is removed from the file name.
+func (g *CustomGenerator) Generate(params *generators.Params[Params]) error {
+	var templates []string
+	err := afero.Walk(filesystem.FileSystem(), params.Custom.TemplateDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			templates = append(templates, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error reading template directory %s: %w", params.Custom.TemplateDir, err)
+	}
+	if len(templates) == 0 {
+		return fmt.Errorf("no templates found in %s", params.Custom.TemplateDir)
+	}
+
+	funcs := template.FuncMap{
+		"ToJSONString": toJSONString,
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath:  params.OutputPath,
+		Provenance:  params.Provenance,
+		Deprecation: params.Deprecation,
+		Custom:      params.Custom,
+	}
+
+	for _, path := range templates {
+		content, err := filesystem.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading template %s: %w", path, err)
+		}
+
+		rel, err := filepath.Rel(params.Custom.TemplateDir, path)
+		if err != nil {
+			return err
+		}
+
+		if err := g.GenerateFile(funcs, string(content), newParams, strings.TrimSuffix(rel, ".tmpl")); err != nil {
+			return fmt.Errorf("error rendering template %s: %w", rel, err)
+		}
+	}
+
+	return nil
+}
+
+// NewGenerator creates a generator for user-supplied templates.
+func NewGenerator(fs *flagset.Flagset) *CustomGenerator {
+	return &CustomGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{}),
+	}
+}
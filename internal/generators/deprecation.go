@@ -0,0 +1,33 @@
+package generators
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// Deprecation selects the flags whose accessors are marked deprecated in generated code
+type Deprecation struct {
+	// AsOf is the time Window is counted from. When zero, the accessors of every flag with an
+	// activeUntil time are marked deprecated.
+	AsOf time.Time
+	// Window is how long before their activeUntil time, as of AsOf, flags are marked deprecated.
+	// With a window of 0, only the flags expired as of AsOf are marked deprecated.
+	Window time.Duration
+}
+
+// notice returns why the accessors of the flag are deprecated, or an empty string if the flag
+// doesn't expire within the deprecation window. Generated code marks deprecated accessors with the
+// deprecation markers of its language, so IDEs and linters flag the code that still uses them.
+// The notice doesn't depend on the current time, so the same manifest always generates the same code.
+func (d Deprecation) notice(flag flagset.Flag) string {
+	if flag.ActiveUntil == nil {
+		return ""
+	}
+	until := *flag.ActiveUntil
+	if !d.AsOf.IsZero() && until.Sub(d.AsOf) > d.Window {
+		return ""
+	}
+	return fmt.Sprintf("The flag expires on %s. Remove the code that uses it.", until.Format(time.DateOnly))
+}
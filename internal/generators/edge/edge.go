@@ -0,0 +1,69 @@
+package edge
+
+import (
+	_ "embed"
+	"encoding/json"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type EdgeGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct{}
+
+//go:embed edge.tmpl
+var edgeTmpl string
+
+func openFeatureType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		fallthrough
+	case flagset.FloatType:
+		return "number"
+	case flagset.BoolType:
+		return "boolean"
+	case flagset.StringType:
+		return "string"
+	case flagset.ObjectType:
+		return "JsonValue"
+	default:
+		return ""
+	}
+}
+
+func toJSONString(value any) string {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "{}"
+	}
+	return string(bytes)
+}
+
+func (g *EdgeGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType": openFeatureType,
+		"ToJSONString":    toJSONString,
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            Params{},
+	}
+
+	return g.GenerateFile(funcs, edgeTmpl, newParams, "openfeature.edge.ts")
+}
+
+// NewGenerator creates a generator for edge runtimes.
+func NewGenerator(fs *flagset.Flagset) *EdgeGenerator {
+	return &EdgeGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{}),
+	}
+}
@@ -0,0 +1,159 @@
+package env
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+// Languages the environment variable provider can be generated in
+const (
+	LanguageGo     = "go"
+	LanguageNodeJS = "nodejs"
+)
+
+// GetValidLanguages returns the languages the environment variable provider can be generated in
+func GetValidLanguages() []string {
+	return []string{LanguageGo, LanguageNodeJS}
+}
+
+type EnvGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+	// Language is the language of the generated provider
+	Language string
+	// GoPackage is the package of the generated Go code
+	GoPackage string
+	// Prefix is the prefix of the environment variables, followed by the flag key in SCREAMING_SNAKE_CASE
+	Prefix string
+}
+
+//go:embed env_go.tmpl
+var goTmpl string
+
+//go:embed env_ts.tmpl
+var tsTmpl string
+
+// variable returns the environment variable holding the value of the flag with the given key
+func variable(prefix string, key string) string {
+	return prefix + strcase.ToScreamingSnake(key)
+}
+
+// goLiteral returns the default value of a flag as a Go literal of the type the Go SDK evaluates it to
+func goLiteral(flagType flagset.FlagType, value any) string {
+	switch flagType {
+	case flagset.IntType:
+		return fmt.Sprintf("int64(%v)", value)
+	case flagset.FloatType:
+		return fmt.Sprintf("float64(%v)", value)
+	default:
+		return goNestedLiteral(value)
+	}
+}
+
+// goNestedLiteral returns a JSON value as a Go literal
+func goNestedLiteral(value any) string {
+	switch val := value.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return fmt.Sprintf("%q", val)
+	case map[string]any:
+		var builder strings.Builder
+		builder.WriteString("map[string]any{")
+		for index, key := range slices.Sorted(maps.Keys(val)) {
+			if index > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(fmt.Sprintf("%q: %s", key, goNestedLiteral(val[key])))
+		}
+		builder.WriteString("}")
+		return builder.String()
+	case []any:
+		elems := make([]string, 0, len(val))
+		for _, elem := range val {
+			elems = append(elems, goNestedLiteral(elem))
+		}
+		return "[]any{" + strings.Join(elems, ", ") + "}"
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+func toJSONString(value any) string {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "null"
+	}
+	return string(bytes)
+}
+
+// checkVariables returns an error if the keys of two flags map to the same environment variable
+func checkVariables(fs *flagset.Flagset, prefix string) error {
+	keys := make(map[string]string, len(fs.Flags))
+	for _, flag := range fs.Flags {
+		name := variable(prefix, flag.Key)
+		if other, ok := keys[name]; ok {
+			return fmt.Errorf("flags %q and %q are both read from the environment variable %s", other, flag.Key, name)
+		}
+		keys[name] = flag.Key
+	}
+	return nil
+}
+
+func (g *EnvGenerator) Generate(params *generators.Params[Params]) error {
+	if err := checkVariables(g.Flagset, params.Custom.Prefix); err != nil {
+		return err
+	}
+
+	funcs := template.FuncMap{
+		"GoLiteral":    goLiteral,
+		"ToJSONString": toJSONString,
+		"Variable": func(key string) string {
+			return variable(params.Custom.Prefix, key)
+		},
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            params.Custom,
+	}
+
+	switch params.Custom.Language {
+	case LanguageGo:
+		g.Formatter = func(data []byte) ([]byte, error) {
+			data, err := format.Source(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to format go code: %w", err)
+			}
+			return data, nil
+		}
+		return g.GenerateFile(funcs, goTmpl, newParams, params.Custom.GoPackage+"_env.go")
+	case LanguageNodeJS:
+		return g.GenerateFile(funcs, tsTmpl, newParams, "openfeature.env.ts")
+	default:
+		return fmt.Errorf("invalid language: %q. Valid languages are: %s", params.Custom.Language, strings.Join(GetValidLanguages(), ", "))
+	}
+}
+
+// NewGenerator creates a generator for environment variable providers.
+func NewGenerator(fs *flagset.Flagset) *EnvGenerator {
+	return &EnvGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{}),
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"golang.org/x/text/cases"
 )
 
-func defaultFuncs() template.FuncMap {
+func defaultFuncs(params *Params[any]) template.FuncMap {
 	// Update the contributing doc when adding a new function
 	return template.FuncMap{
 		// Remapping ToCamel to ToPascal to match the expected behavior
@@ -32,6 +32,13 @@ func defaultFuncs() template.FuncMap {
 			}
 			return input
 		},
+		"CLIVersion": func() string {
+			return params.Provenance.CLIVersion
+		},
+		"ManifestHash": func() string {
+			return params.Provenance.ManifestHash
+		},
+		"DeprecationNotice": params.Deprecation.notice,
 	}
 }
 
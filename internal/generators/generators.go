@@ -29,7 +29,14 @@ type CommonGenerator struct {
 type Params[T any] struct {
 	OutputPath   string
 	TemplatePath string
-	Custom       T
+	// TemplateOverrides maps the names of templates defined by the generator template to the
+	// path or http(s) URL of the template replacing them
+	TemplateOverrides map[string]string
+	// Provenance is written in the headers of the generated files
+	Provenance Provenance
+	// Deprecation selects the flags whose accessors are marked deprecated
+	Deprecation Deprecation
+	Custom      T
 }
 
 type TemplateData struct {
@@ -45,13 +52,13 @@ func NewGenerator(flagset *flagset.Flagset, UnsupportedFlagTypes map[flagset.Fla
 }
 
 func (g *CommonGenerator) GenerateFile(customFunc template.FuncMap, tmpl string, params *Params[any], name string) error {
-	funcs := defaultFuncs()
+	funcs := defaultFuncs(params)
 	maps.Copy(funcs, customFunc)
 
-	// If a custom template path is provided, read from file instead of using embedded template
+	// If a custom template path is provided, read from file or URL instead of using embedded template
 	if params.TemplatePath != "" {
 		logger.Default.Debug(fmt.Sprintf("Using custom template: %s", params.TemplatePath))
-		content, err := filesystem.ReadFile(params.TemplatePath)
+		content, err := readTemplate(params.TemplatePath)
 		if err != nil {
 			return fmt.Errorf("error reading custom template %s: %w", params.TemplatePath, err)
 		}
@@ -64,6 +71,9 @@ func (g *CommonGenerator) GenerateFile(customFunc template.FuncMap, tmpl string,
 	if err != nil {
 		return fmt.Errorf("error initializing template: %v", err)
 	}
+	if err := overrideTemplates(generatorTemplate, params.TemplateOverrides); err != nil {
+		return err
+	}
 
 	var buf bytes.Buffer
 	data := TemplateData{
@@ -22,11 +22,23 @@ type GolangGenerator struct {
 type Params struct {
 	GoPackage  string
 	CLIVersion string
+	// OTelHooks adds the OpenTelemetry tracing and metrics hooks and the logging hook to the generated client
+	OTelHooks bool
+	// WithTests adds a test of the generated flags, run against the snapshot provider of the package
+	WithTests bool
+	// ConstantsOnly generates only the typed flag key constants and the flag types, without a client
+	ConstantsOnly bool
 }
 
 //go:embed golang.tmpl
 var golangTmpl string
 
+//go:embed golang_test.tmpl
+var golangTestTmpl string
+
+//go:embed golang_constants.tmpl
+var golangConstantsTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -128,25 +140,69 @@ func formatNestedValue(value any) string {
 	}
 }
 
+// goLiteral returns the default value of a flag as a Go literal of the type its generated accessor returns
+func goLiteral(flagType flagset.FlagType, value any) string {
+	switch flagType {
+	case flagset.IntType:
+		return fmt.Sprintf("int64(%v)", value)
+	case flagset.FloatType:
+		return fmt.Sprintf("float64(%v)", value)
+	case flagset.ObjectType:
+		return toMapLiteral(value)
+	default:
+		return formatNestedValue(value)
+	}
+}
+
 func (g *GolangGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"SupportImports":  supportImports,
 		"OpenFeatureType": openFeatureType,
 		"TypeString":      typeString,
 		"ToMapLiteral":    toMapLiteral,
+		"GoLiteral":       goLiteral,
 	}
 
 	newParams := &generators.Params[any]{
-		OutputPath:   params.OutputPath,
-		TemplatePath: params.TemplatePath,
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
 		Custom: Params{
-			GoPackage:  params.Custom.GoPackage,
-			CLIVersion: params.Custom.CLIVersion,
+			GoPackage:     params.Custom.GoPackage,
+			CLIVersion:    params.Custom.CLIVersion,
+			OTelHooks:     params.Custom.OTelHooks,
+			WithTests:     params.Custom.WithTests,
+			ConstantsOnly: params.Custom.ConstantsOnly,
 		},
 	}
 
+	tmpl := golangTmpl
+	if params.Custom.ConstantsOnly {
+		tmpl = golangConstantsTmpl
+	}
+
 	filename := params.Custom.GoPackage + "_gen.go"
-	return g.GenerateFile(funcs, golangTmpl, newParams, filename)
+	if err := g.GenerateFile(funcs, tmpl, newParams, filename); err != nil {
+		return err
+	}
+	if !params.Custom.WithTests {
+		return nil
+	}
+
+	// The custom template and its overrides only apply to the generated code, not to its test
+	testParams := &generators.Params[any]{
+		OutputPath:  params.OutputPath,
+		Provenance:  params.Provenance,
+		Deprecation: params.Deprecation,
+		Custom:      newParams.Custom,
+	}
+	// The test refers to the flags of the package, which goimports would try to resolve as packages,
+	// and its imports are complete, so it is only formatted
+	testGenerator := *g
+	testGenerator.Formatter = format.Source
+	return testGenerator.GenerateFile(funcs, golangTestTmpl, testParams, params.Custom.GoPackage+"_gen_test.go")
 }
 
 // NewGenerator creates a generator for Go.
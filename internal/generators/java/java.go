@@ -20,11 +20,20 @@ type JavaGenerator struct {
 type Params struct {
 	// Add Java parameters here if needed
 	JavaPackage string
+	// SpringBoot adds a Spring configuration wiring the generated client as a bean, and a record
+	// with typed accessors of the flags (requires Java 17)
+	SpringBoot bool
 }
 
 //go:embed java.tmpl
 var javaTmpl string
 
+//go:embed java_spring_configuration.tmpl
+var javaSpringConfigurationTmpl string
+
+//go:embed java_flags_record.tmpl
+var javaFlagsRecordTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -137,12 +146,32 @@ func (g *JavaGenerator) Generate(params *generators.Params[Params]) error {
 	}
 
 	newParams := &generators.Params[any]{
-		OutputPath:   params.OutputPath,
-		TemplatePath: params.TemplatePath,
-		Custom:       params.Custom,
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            params.Custom,
 	}
 
-	return g.GenerateFile(funcs, javaTmpl, newParams, "OpenFeature.java")
+	if err := g.GenerateFile(funcs, javaTmpl, newParams, "OpenFeature.java"); err != nil {
+		return err
+	}
+	if !params.Custom.SpringBoot {
+		return nil
+	}
+
+	// The custom template and its overrides only apply to the generated client, not to the Spring integration
+	springParams := &generators.Params[any]{
+		OutputPath:  params.OutputPath,
+		Provenance:  params.Provenance,
+		Deprecation: params.Deprecation,
+		Custom:      params.Custom,
+	}
+	if err := g.GenerateFile(funcs, javaSpringConfigurationTmpl, springParams, "OpenFeatureConfiguration.java"); err != nil {
+		return err
+	}
+	return g.GenerateFile(funcs, javaFlagsRecordTmpl, springParams, "Flags.java")
 }
 
 // NewGenerator creates a generator for Java.
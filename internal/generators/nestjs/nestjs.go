@@ -3,6 +3,8 @@ package nestjs
 import (
 	_ "embed"
 	"encoding/json"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/open-feature/cli/internal/flagset"
@@ -13,11 +15,19 @@ type NestJsGenerator struct {
 	generators.CommonGenerator
 }
 
-type Params struct{}
+// Params holds NestJS-specific generation parameters.
+type Params struct {
+	// FlagsModule also generates a FlagsModule with an injectable FlagsService and parameter
+	// decorators that take the key of a flag of the manifest, such as @BooleanFlag('my-flag')
+	FlagsModule bool
+}
 
 //go:embed nestjs.tmpl
 var nestJsTmpl string
 
+//go:embed nestjs_flags_module.tmpl
+var nestJsFlagsModuleTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -43,19 +53,54 @@ func toJSONString(value any) string {
 	return string(bytes)
 }
 
+// keyUnion returns the TypeScript union of the quoted keys of the flags of the given type, or
+// never if there are none
+func keyUnion(flags []flagset.Flag, flagType string) string {
+	var keys []string
+	for _, flag := range flags {
+		if openFeatureType(flag.Type) == flagType {
+			keys = append(keys, strconv.Quote(flag.Key))
+		}
+	}
+	if len(keys) == 0 {
+		return "never"
+	}
+	return strings.Join(keys, " | ")
+}
+
 func (g *NestJsGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"OpenFeatureType": openFeatureType,
 		"ToJSONString":    toJSONString,
+		"KeyUnion":        keyUnion,
 	}
 
 	newParams := &generators.Params[any]{
-		OutputPath:   params.OutputPath,
-		TemplatePath: params.TemplatePath,
-		Custom:       Params{},
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom: Params{
+			FlagsModule: params.Custom.FlagsModule,
+		},
 	}
 
-	return g.GenerateFile(funcs, nestJsTmpl, newParams, "openfeature-decorators.ts")
+	if err := g.GenerateFile(funcs, nestJsTmpl, newParams, "openfeature-decorators.ts"); err != nil {
+		return err
+	}
+	if !params.Custom.FlagsModule {
+		return nil
+	}
+
+	// The custom template and its overrides only apply to the decorators, not to the module
+	moduleParams := &generators.Params[any]{
+		OutputPath:  params.OutputPath,
+		Provenance:  params.Provenance,
+		Deprecation: params.Deprecation,
+		Custom:      newParams.Custom,
+	}
+	return g.GenerateFile(funcs, nestJsFlagsModuleTmpl, moduleParams, "openfeature-flags.module.ts")
 }
 
 // NewGenerator creates a generator for NestJS.
@@ -3,7 +3,9 @@ package nodejs
 import (
 	_ "embed"
 	"encoding/json"
+	"slices"
 	"text/template"
+	"time"
 
 	"github.com/open-feature/cli/internal/flagset"
 	"github.com/open-feature/cli/internal/generators"
@@ -13,11 +15,45 @@ type NodejsGenerator struct {
 	generators.CommonGenerator
 }
 
-type Params struct{}
+// Runtimes the generated client can target
+const (
+	RuntimeNode = "node"
+	RuntimeDeno = "deno"
+	RuntimeBun  = "bun"
+)
+
+type Params struct {
+	// Runtime is the JavaScript runtime the client is generated for. Defaults to RuntimeNode.
+	Runtime string
+	// WithTests adds a node:test suite of the generated client, run against the snapshot provider
+	WithTests bool
+}
+
+// IsValidRuntime checks if the given runtime is supported by the generator
+func IsValidRuntime(runtime string) bool {
+	switch runtime {
+	case RuntimeNode, RuntimeDeno, RuntimeBun:
+		return true
+	default:
+		return false
+	}
+}
+
+// sdkModule returns the import specifier of the OpenFeature server SDK for the runtime.
+// Deno resolves npm packages with the npm: specifier; Bun, like Node.js, resolves bare specifiers.
+func sdkModule(runtime string) string {
+	if runtime == RuntimeDeno {
+		return "npm:@openfeature/server-sdk@^1"
+	}
+	return "@openfeature/server-sdk"
+}
 
 //go:embed nodejs.tmpl
 var nodejsTmpl string
 
+//go:embed nodejs_test.tmpl
+var nodejsTestTmpl string
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -43,19 +79,51 @@ func toJSONString(value any) string {
 	return string(bytes)
 }
 
+// milliseconds converts a duration of the manifest (e.g., 30s) to milliseconds. Empty durations are 0.
+func milliseconds(duration string) int64 {
+	d, _ := time.ParseDuration(duration)
+	return d.Milliseconds()
+}
+
 func (g *NodejsGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"OpenFeatureType": openFeatureType,
 		"ToJSONString":    toJSONString,
+		"SDKModule": func() string {
+			return sdkModule(params.Custom.Runtime)
+		},
+		"HasCacheHints": func() bool {
+			return slices.ContainsFunc(g.Flagset.Flags, func(flag flagset.Flag) bool {
+				return flag.Cache != nil
+			})
+		},
+		"Milliseconds": milliseconds,
 	}
 
 	newParams := &generators.Params[any]{
-		OutputPath:   params.OutputPath,
-		TemplatePath: params.TemplatePath,
-		Custom:       Params{},
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            Params{Runtime: params.Custom.Runtime, WithTests: params.Custom.WithTests},
 	}
 
-	return g.GenerateFile(funcs, nodejsTmpl, newParams, "openfeature.ts")
+	if err := g.GenerateFile(funcs, nodejsTmpl, newParams, "openfeature.ts"); err != nil {
+		return err
+	}
+	if !params.Custom.WithTests {
+		return nil
+	}
+
+	// The custom template and its overrides only apply to the generated client, not to its tests
+	testParams := &generators.Params[any]{
+		OutputPath:  params.OutputPath,
+		Provenance:  params.Provenance,
+		Deprecation: params.Deprecation,
+		Custom:      newParams.Custom,
+	}
+	return g.GenerateFile(funcs, nodejsTestTmpl, testParams, "openfeature.test.ts")
 }
 
 // NewGenerator creates a generator for NodeJS.
@@ -0,0 +1,49 @@
+package generators
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/logger"
+)
+
+// overrideTemplates replaces the named templates ({{ define }} or {{ block }}) of the generator
+// template with the templates read from the given paths, keyed by template name, so part of a
+// built-in template can be customized without copying the rest of it
+func overrideTemplates(tmpl *template.Template, overrides map[string]string) error {
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		if name == tmpl.Name() || tmpl.Lookup(name) == nil {
+			return fmt.Errorf("template %q is not defined by the generator template. Templates that can be overridden: %s",
+				name, strings.Join(overridableTemplates(tmpl), ", "))
+		}
+
+		path := overrides[name]
+		logger.Default.Debug(fmt.Sprintf("Overriding template %s with: %s", name, path))
+		content, err := readTemplate(path)
+		if err != nil {
+			return fmt.Errorf("error reading template override %s: %w", path, err)
+		}
+		if _, err := tmpl.New(name).Parse(string(content)); err != nil {
+			return fmt.Errorf("error parsing template override %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// overridableTemplates returns the sorted names of the templates defined by the generator template
+func overridableTemplates(tmpl *template.Template) []string {
+	names := []string{}
+	for _, t := range tmpl.Templates() {
+		if t.Name() != tmpl.Name() {
+			names = append(names, t.Name())
+		}
+	}
+	if len(names) == 0 {
+		return []string{"none"}
+	}
+	slices.Sort(names)
+	return names
+}
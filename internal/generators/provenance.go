@@ -0,0 +1,11 @@
+package generators
+
+// Provenance identifies what generated code was generated from, so that the headers of generated
+// files change whenever the code would, and generated code can be checked for drift in CI.
+// Both fields are left out of the headers when empty.
+type Provenance struct {
+	// CLIVersion is the version of the CLI that generated the code
+	CLIVersion string
+	// ManifestHash is the hex-encoded SHA-256 of the manifest the code was generated from
+	ManifestHash string
+}
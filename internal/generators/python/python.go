@@ -8,7 +8,9 @@ import (
 	"slices"
 	"strings"
 	"text/template"
+	"unicode"
 
+	"github.com/iancoleman/strcase"
 	"github.com/open-feature/cli/internal/flagset"
 	"github.com/open-feature/cli/internal/generators"
 )
@@ -17,11 +19,205 @@ type PythonGenerator struct {
 	generators.CommonGenerator
 }
 
-type Params struct{}
+// Kinds of models generated for object flags
+const (
+	ModelsDataclass = "dataclass"
+	ModelsPydantic  = "pydantic"
+)
+
+type Params struct {
+	// Models is the kind of classes generated for the values of object flags, inferred from their
+	// default values: ModelsDataclass or ModelsPydantic. Object flags evaluate to dicts if empty.
+	Models string
+	// Stubs adds a .pyi type stub of the generated client, typing the values of object flags as
+	// TypedDicts if no models are generated
+	Stubs bool
+}
+
+// IsValidModels checks if the given kind of models is supported by the generator
+func IsValidModels(models string) bool {
+	switch models {
+	case "", ModelsDataclass, ModelsPydantic:
+		return true
+	default:
+		return false
+	}
+}
 
 //go:embed python.tmpl
 var pythonTmpl string
 
+//go:embed python_stubs.tmpl
+var pythonStubsTmpl string
+
+// pythonModel is a class of the value of an object flag, or of an object nested in it
+type pythonModel struct {
+	Name string
+	// FlagKey is the key of the flag of the model, empty for nested objects
+	FlagKey string
+	Fields  []pythonField
+}
+
+// pythonField is a field of a model
+type pythonField struct {
+	// Name is the Python identifier of the field
+	Name string
+	// Key is the key of the field in the flag value
+	Key  string
+	Type string
+	// Model is the model of the field, or of its items if List is set. Empty for other values.
+	Model string
+	List  bool
+}
+
+// HasIdentifierKeys checks if the keys of all fields are Python identifiers, so that the model
+// can be declared as a TypedDict with the class syntax
+func (m pythonModel) HasIdentifierKeys() bool {
+	for _, field := range m.Fields {
+		if field.Key != pythonIdentifier(field.Key) {
+			return false
+		}
+	}
+	return true
+}
+
+// pythonKeywords are the keywords that can't be the name of a field
+var pythonKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true, "async": true,
+	"await": true, "break": true, "class": true, "continue": true, "def": true, "del": true, "elif": true,
+	"else": true, "except": true, "finally": true, "for": true, "from": true, "global": true, "if": true,
+	"import": true, "in": true, "is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// pythonIdentifier converts a key of an object to a valid Python identifier
+func pythonIdentifier(key string) string {
+	identifier := strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, key)
+	if identifier == "" || unicode.IsDigit(rune(identifier[0])) {
+		identifier = "_" + identifier
+	}
+	if pythonKeywords[identifier] {
+		identifier += "_"
+	}
+	return identifier
+}
+
+// modelBuilder infers the models of the object flags from their default values
+type modelBuilder struct {
+	models []pythonModel
+	names  map[string]bool
+	// flagModels maps the key of each object flag to the name of its model
+	flagModels map[string]string
+}
+
+func newModels(flags []flagset.Flag) *modelBuilder {
+	b := &modelBuilder{names: map[string]bool{}, flagModels: map[string]string{}}
+	for _, flag := range flags {
+		if flag.Type != flagset.ObjectType {
+			continue
+		}
+		value, _ := flag.DefaultValue.(map[string]any)
+		b.flagModels[flag.Key] = b.model(strcase.ToCamel(flag.Key), flag.Key, value)
+	}
+	return b
+}
+
+// model adds the model of an object, after the models of the objects nested in it so that every
+// model is declared before it is used, and returns its name
+func (b *modelBuilder) model(name string, flagKey string, value map[string]any) string {
+	name = b.uniqueName(name)
+	model := pythonModel{Name: name, FlagKey: flagKey}
+	fieldNames := map[string]bool{}
+	for _, key := range slices.Sorted(maps.Keys(value)) {
+		field := pythonField{Name: strcase.ToSnake(key), Key: key}
+		field.Name = pythonIdentifier(field.Name)
+		for fieldNames[field.Name] {
+			field.Name += "_"
+		}
+		fieldNames[field.Name] = true
+
+		switch v := value[key].(type) {
+		case map[string]any:
+			field.Model = b.model(name+strcase.ToCamel(key), "", v)
+			field.Type = field.Model
+		case []any:
+			field.List = true
+			itemType := "Any"
+			if item, ok := sameTypeItem(v); ok {
+				if object, isObject := item.(map[string]any); isObject {
+					field.Model = b.model(name+strcase.ToCamel(key)+"Item", "", object)
+					itemType = field.Model
+				} else {
+					itemType = pythonValueType(item)
+				}
+			}
+			field.Type = "list[" + itemType + "]"
+		default:
+			field.Type = pythonValueType(v)
+		}
+		model.Fields = append(model.Fields, field)
+	}
+	b.models = append(b.models, model)
+	return name
+}
+
+// uniqueName returns the name, with a numeric suffix if a model of that name already exists
+func (b *modelBuilder) uniqueName(name string) string {
+	unique := name
+	for i := 2; b.names[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	b.names[unique] = true
+	return unique
+}
+
+// sameTypeItem returns the first item of a list if all of its items have the same type
+func sameTypeItem(items []any) (any, bool) {
+	if len(items) == 0 {
+		return nil, false
+	}
+	itemType := pythonValueType(items[0])
+	for _, item := range items[1:] {
+		if pythonValueType(item) != itemType {
+			return nil, false
+		}
+	}
+	return items[0], true
+}
+
+// pythonValueType returns the Python type of a value of an object that is not a nested object
+func pythonValueType(value any) string {
+	switch v := value.(type) {
+	case string:
+		return "str"
+	case bool:
+		return "bool"
+	case int, int64:
+		return "int"
+	case float64:
+		if v == float64(int64(v)) {
+			return "int"
+		}
+		return "float"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "int"
+		}
+		return "float"
+	case map[string]any:
+		return "dict[str, Any]"
+	case []any:
+		return "list[Any]"
+	default:
+		return "Optional[Any]"
+	}
+}
+
 func openFeatureType(t flagset.FlagType) string {
 	switch t {
 	case flagset.IntType:
@@ -137,6 +333,7 @@ func formatNestedValue(value any) string {
 }
 
 func (g *PythonGenerator) Generate(params *generators.Params[Params]) error {
+	models := newModels(g.Flagset.Flags)
 	funcs := template.FuncMap{
 		"OpenFeatureType":         openFeatureType,
 		"TypedGetMethodSync":      typedGetMethodSync,
@@ -145,15 +342,38 @@ func (g *PythonGenerator) Generate(params *generators.Params[Params]) error {
 		"TypedDetailsMethodAsync": typedDetailsMethodAsync,
 		"PythonBoolLiteral":       pythonBoolLiteral,
 		"ToPythonDict":            toPythonDict,
+		"Models": func() []pythonModel {
+			return models.models
+		},
+		"FlagModel": func(flagKey string) string {
+			return models.flagModels[flagKey]
+		},
 	}
 
 	newParams := &generators.Params[any]{
-		OutputPath:   params.OutputPath,
-		TemplatePath: params.TemplatePath,
-		Custom:       Params{},
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            params.Custom,
 	}
 
-	return g.GenerateFile(funcs, pythonTmpl, newParams, "openfeature.py")
+	if err := g.GenerateFile(funcs, pythonTmpl, newParams, "openfeature.py"); err != nil {
+		return err
+	}
+	if !params.Custom.Stubs {
+		return nil
+	}
+
+	// The custom template and its overrides only apply to the generated client, not to its stub
+	stubParams := &generators.Params[any]{
+		OutputPath:  params.OutputPath,
+		Provenance:  params.Provenance,
+		Deprecation: params.Deprecation,
+		Custom:      params.Custom,
+	}
+	return g.GenerateFile(funcs, pythonStubsTmpl, stubParams, "openfeature.pyi")
 }
 
 // NewGenerator creates a generator for Python.
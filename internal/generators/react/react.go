@@ -13,7 +13,14 @@ type ReactGenerator struct {
 	generators.CommonGenerator
 }
 
-type Params struct{}
+// Params holds React-specific generation parameters.
+type Params struct {
+	// Suspense makes the use<Flag> hooks suspend until the flags are ready
+	Suspense bool
+	// ProviderWrapper also generates a typed OpenFeatureProvider wrapper whose defaultValues
+	// override the flag defaults of the hooks, e.g., with values evaluated during server-side rendering
+	ProviderWrapper bool
+}
 
 //go:embed react.tmpl
 var reactTmpl string
@@ -47,20 +54,31 @@ func (g *ReactGenerator) Generate(params *generators.Params[Params]) error {
 	funcs := template.FuncMap{
 		"OpenFeatureType": openFeatureType,
 		"ToJSONString":    toJSONString,
+		// The options are functions rather than params so that the named templates, which are
+		// executed with a flag, can use them too
+		"Suspense":        func() bool { return params.Custom.Suspense },
+		"ProviderWrapper": func() bool { return params.Custom.ProviderWrapper },
 	}
 
 	newParams := &generators.Params[any]{
-		OutputPath:   params.OutputPath,
-		TemplatePath: params.TemplatePath,
-		Custom:       Params{},
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom: Params{
+			Suspense:        params.Custom.Suspense,
+			ProviderWrapper: params.Custom.ProviderWrapper,
+		},
 	}
 
 	return g.GenerateFile(funcs, reactTmpl, newParams, "openfeature.ts")
 }
 
-// NewGenerator creates a generator for React.
+// NewGenerator creates a generator for React. The generated code runs on the client, so server-only
+// flags are left out.
 func NewGenerator(fs *flagset.Flagset) *ReactGenerator {
 	return &ReactGenerator{
-		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{}),
+		CommonGenerator: *generators.NewGenerator(fs.ClientVisible(), map[flagset.FlagType]bool{}),
 	}
 }
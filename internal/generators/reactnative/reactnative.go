@@ -0,0 +1,70 @@
+package reactnative
+
+import (
+	_ "embed"
+	"encoding/json"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type ReactNativeGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct{}
+
+//go:embed reactnative.tmpl
+var reactNativeTmpl string
+
+func openFeatureType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		fallthrough
+	case flagset.FloatType:
+		return "number"
+	case flagset.BoolType:
+		return "boolean"
+	case flagset.StringType:
+		return "string"
+	case flagset.ObjectType:
+		return "object"
+	default:
+		return ""
+	}
+}
+
+func toJSONString(value any) string {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "{}"
+	}
+	return string(bytes)
+}
+
+func (g *ReactNativeGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType": openFeatureType,
+		"ToJSONString":    toJSONString,
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            Params{},
+	}
+
+	return g.GenerateFile(funcs, reactNativeTmpl, newParams, "openfeature.ts")
+}
+
+// NewGenerator creates a generator for React Native. The generated code runs on the client, so server-only
+// flags are left out.
+func NewGenerator(fs *flagset.Flagset) *ReactNativeGenerator {
+	return &ReactNativeGenerator{
+		CommonGenerator: *generators.NewGenerator(fs.ClientVisible(), map[flagset.FlagType]bool{}),
+	}
+}
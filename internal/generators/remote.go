@@ -0,0 +1,120 @@
+package generators
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/open-feature/cli/internal/api/retry"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/logger"
+)
+
+// checksumFragment is the URL fragment used to pin the checksum of a remote template
+const checksumFragment = "sha256="
+
+// IsRemoteTemplate checks if the template path refers to a remote template
+func IsRemoteTemplate(templatePath string) bool {
+	return strings.HasPrefix(templatePath, "https://") ||
+		strings.HasPrefix(templatePath, "http://") ||
+		strings.HasPrefix(templatePath, "oci://")
+}
+
+// readTemplate reads a custom template from the local filesystem or from a remote URL
+func readTemplate(templatePath string) ([]byte, error) {
+	if !IsRemoteTemplate(templatePath) {
+		return filesystem.ReadFile(templatePath)
+	}
+	return readRemoteTemplate(templatePath)
+}
+
+// readRemoteTemplate reads a template from a http(s) URL.
+// The URL may pin the expected SHA-256 checksum of the template in its fragment
+// (e.g., https://example.com/go.tmpl#sha256=<hex>). Pinned templates are served from the
+// cache when the cached copy matches the checksum. Unpinned templates are always downloaded
+// and only served from the cache when the download fails.
+func readRemoteTemplate(templateURL string) ([]byte, error) {
+	if strings.HasPrefix(templateURL, "oci://") {
+		return nil, fmt.Errorf("OCI template references are not supported, use an https URL instead: %s", templateURL)
+	}
+
+	url, fragment, _ := strings.Cut(templateURL, "#")
+	checksum := ""
+	if fragment != "" {
+		if !strings.HasPrefix(fragment, checksumFragment) {
+			return nil, fmt.Errorf("invalid template checksum %q, expected #%s<hex>", fragment, checksumFragment)
+		}
+		checksum = strings.ToLower(strings.TrimPrefix(fragment, checksumFragment))
+	}
+
+	cachePath, err := templateCachePath(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if checksum != "" {
+		if cached, err := filesystem.ReadFile(cachePath); err == nil && sha256Hex(cached) == checksum {
+			logger.Default.Debug(fmt.Sprintf("Using cached template for %s", url))
+			return cached, nil
+		}
+	}
+
+	content, err := downloadTemplate(url)
+	if err != nil {
+		if checksum == "" {
+			if cached, cacheErr := filesystem.ReadFile(cachePath); cacheErr == nil {
+				logger.Default.Warning(fmt.Sprintf("Failed to download template, using cached copy: %v", err))
+				return cached, nil
+			}
+		}
+		return nil, err
+	}
+
+	if checksum != "" {
+		if actual := sha256Hex(content); actual != checksum {
+			return nil, fmt.Errorf("checksum mismatch for template %s: expected sha256 %s, got %s", url, checksum, actual)
+		}
+	}
+
+	if err := filesystem.WriteFile(cachePath, content); err != nil {
+		logger.Default.Debug(fmt.Sprintf("Failed to cache template %s: %v", url, err))
+	}
+
+	return content, nil
+}
+
+// downloadTemplate fetches the template at the given URL
+func downloadTemplate(url string) ([]byte, error) {
+	logger.Default.Debug(fmt.Sprintf("Downloading template from %s", url))
+
+	resp, err := retry.Get(context.Background(), retry.NewHTTPClient(), url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error downloading template %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading template %s: unexpected status %s", url, resp.Status)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading template %s: %w", url, err)
+	}
+
+	return content, nil
+}
+
+// templateCachePath returns the path the template at the given URL is cached at
+func templateCachePath(url string) (string, error) {
+	return filesystem.CachePath("templates", sha256Hex([]byte(url))+".tmpl")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,147 @@
+package snapshot
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+// Languages the snapshot provider can be generated in
+const (
+	LanguageGo     = "go"
+	LanguageNodeJS = "nodejs"
+	LanguageWeb    = "web"
+)
+
+// GetValidLanguages returns the languages the snapshot provider can be generated in
+func GetValidLanguages() []string {
+	return []string{LanguageGo, LanguageNodeJS, LanguageWeb}
+}
+
+type SnapshotGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+	// Language is the language of the generated provider
+	Language string
+	// GoPackage is the package of the generated Go code
+	GoPackage string
+}
+
+//go:embed snapshot_go.tmpl
+var goTmpl string
+
+//go:embed snapshot_ts.tmpl
+var tsTmpl string
+
+// sdkModule returns the OpenFeature SDK whose in-memory provider is seeded, for the TypeScript languages
+func sdkModule(language string) string {
+	if language == LanguageWeb {
+		return "@openfeature/web-sdk"
+	}
+	return "@openfeature/server-sdk"
+}
+
+// goLiteral returns the default value of a flag as a Go literal of the type the Go SDK evaluates it to
+func goLiteral(flagType flagset.FlagType, value any) string {
+	switch flagType {
+	case flagset.IntType:
+		return fmt.Sprintf("int64(%v)", value)
+	case flagset.FloatType:
+		return fmt.Sprintf("float64(%v)", value)
+	default:
+		return goNestedLiteral(value)
+	}
+}
+
+// goNestedLiteral returns a JSON value as a Go literal
+func goNestedLiteral(value any) string {
+	switch val := value.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return fmt.Sprintf("%q", val)
+	case map[string]any:
+		var builder strings.Builder
+		builder.WriteString("map[string]any{")
+		for index, key := range slices.Sorted(maps.Keys(val)) {
+			if index > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(fmt.Sprintf("%q: %s", key, goNestedLiteral(val[key])))
+		}
+		builder.WriteString("}")
+		return builder.String()
+	case []any:
+		elems := make([]string, 0, len(val))
+		for _, elem := range val {
+			elems = append(elems, goNestedLiteral(elem))
+		}
+		return "[]any{" + strings.Join(elems, ", ") + "}"
+	default:
+		return fmt.Sprintf("%v", val)
+	}
+}
+
+func toJSONString(value any) string {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "null"
+	}
+	return string(bytes)
+}
+
+func (g *SnapshotGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"GoLiteral":    goLiteral,
+		"ToJSONString": toJSONString,
+		"SDKModule": func() string {
+			return sdkModule(params.Custom.Language)
+		},
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            params.Custom,
+	}
+
+	switch params.Custom.Language {
+	case LanguageGo:
+		g.Formatter = func(data []byte) ([]byte, error) {
+			data, err := format.Source(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to format go code: %w", err)
+			}
+			return data, nil
+		}
+		return g.GenerateFile(funcs, goTmpl, newParams, params.Custom.GoPackage+"_snapshot.go")
+	case LanguageWeb:
+		// The web SDK runs in the browser
+		g.Flagset = g.Flagset.ClientVisible()
+		return g.GenerateFile(funcs, tsTmpl, newParams, "openfeature.snapshot.ts")
+	case LanguageNodeJS:
+		return g.GenerateFile(funcs, tsTmpl, newParams, "openfeature.snapshot.ts")
+	default:
+		return fmt.Errorf("invalid language: %q. Valid languages are: %s", params.Custom.Language, strings.Join(GetValidLanguages(), ", "))
+	}
+}
+
+// NewGenerator creates a generator for snapshot providers.
+func NewGenerator(fs *flagset.Flagset) *SnapshotGenerator {
+	return &SnapshotGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{}),
+	}
+}
@@ -0,0 +1,115 @@
+package spreadsheet
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+// Formats of the flag inventory
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+type SpreadsheetGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct {
+	// Format is the format of the inventory: FormatCSV or FormatXLSX. The template renders CSV
+	// rows in both cases, which are converted to a worksheet for FormatXLSX.
+	Format string
+}
+
+//go:embed spreadsheet.tmpl
+var spreadsheetTmpl string
+
+// csvField quotes a field of a CSV row if it contains a separator, a quote or a line break (RFC 4180)
+func csvField(value string) string {
+	if !strings.ContainsAny(value, ",\"\r\n") {
+		return value
+	}
+	return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+}
+
+// formatValue formats a default value or a metadata value for a cell: strings as they are,
+// other values as JSON, and missing values as an empty cell
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
+
+// formatTime formats an optional activeFrom or activeUntil time for a cell
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// metadataKeys returns the keys of the metadata of all flags, each of which gets a column
+func metadataKeys(flags []flagset.Flag) []string {
+	keys := map[string]bool{}
+	for _, flag := range flags {
+		for key := range flag.Metadata {
+			keys[key] = true
+		}
+	}
+	return slices.Sorted(maps.Keys(keys))
+}
+
+func (g *SpreadsheetGenerator) Generate(params *generators.Params[Params]) error {
+	keys := metadataKeys(g.Flagset.Flags)
+	funcs := template.FuncMap{
+		"CSV":   csvField,
+		"Value": formatValue,
+		"Time":  formatTime,
+		"Join": func(values []string) string {
+			return strings.Join(values, ", ")
+		},
+		"MetadataKeys": func() []string {
+			return keys
+		},
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            params.Custom,
+	}
+
+	if params.Custom.Format == FormatXLSX {
+		g.Formatter = csvToXLSX
+		return g.GenerateFile(funcs, spreadsheetTmpl, newParams, "flags.xlsx")
+	}
+	return g.GenerateFile(funcs, spreadsheetTmpl, newParams, "flags.csv")
+}
+
+// NewGenerator creates a generator of the flag inventory as a spreadsheet.
+func NewGenerator(fs *flagset.Flagset) *SpreadsheetGenerator {
+	return &SpreadsheetGenerator{
+		CommonGenerator: *generators.NewGenerator(fs, map[flagset.FlagType]bool{}),
+	}
+}
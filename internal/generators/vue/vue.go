@@ -0,0 +1,87 @@
+package vue
+
+import (
+	_ "embed"
+	"encoding/json"
+	"text/template"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/generators"
+)
+
+type VueGenerator struct {
+	generators.CommonGenerator
+}
+
+type Params struct{}
+
+//go:embed vue.tmpl
+var vueTmpl string
+
+func openFeatureType(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType:
+		fallthrough
+	case flagset.FloatType:
+		return "number"
+	case flagset.BoolType:
+		return "boolean"
+	case flagset.StringType:
+		return "string"
+	case flagset.ObjectType:
+		return "object"
+	default:
+		return ""
+	}
+}
+
+// detailsMethod returns the web SDK client method that evaluates a flag of the given type
+func detailsMethod(t flagset.FlagType) string {
+	switch t {
+	case flagset.IntType, flagset.FloatType:
+		return "getNumberDetails"
+	case flagset.BoolType:
+		return "getBooleanDetails"
+	case flagset.StringType:
+		return "getStringDetails"
+	case flagset.ObjectType:
+		return "getObjectDetails"
+	default:
+		return ""
+	}
+}
+
+func toJSONString(value any) string {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return "{}"
+	}
+	return string(bytes)
+}
+
+func (g *VueGenerator) Generate(params *generators.Params[Params]) error {
+	funcs := template.FuncMap{
+		"OpenFeatureType": openFeatureType,
+		"DetailsMethod":   detailsMethod,
+		"ToJSONString":    toJSONString,
+	}
+
+	newParams := &generators.Params[any]{
+		OutputPath:        params.OutputPath,
+		Provenance:        params.Provenance,
+		Deprecation:       params.Deprecation,
+		TemplatePath:      params.TemplatePath,
+		TemplateOverrides: params.TemplateOverrides,
+		Custom:            Params{},
+	}
+
+	return g.GenerateFile(funcs, vueTmpl, newParams, "openfeature.ts")
+}
+
+// NewGenerator creates a generator for Vue. The generated code runs on the client, so server-only
+// flags are left out.
+func NewGenerator(fs *flagset.Flagset) *VueGenerator {
+	return &VueGenerator{
+		CommonGenerator: *generators.NewGenerator(fs.ClientVisible(), map[flagset.FlagType]bool{}),
+	}
+}
@@ -1,6 +1,8 @@
 package logger
 
 import (
+	"io"
+	"os"
 	"path/filepath"
 
 	"github.com/pterm/pterm"
@@ -115,3 +117,18 @@ func (l *DefaultLogger) GenerationComplete(generatorType string) {
 
 // Default is a singleton instance of DefaultLogger
 var Default Logger = New()
+
+// output is the writer all messages are written to
+var output io.Writer = os.Stdout
+
+// RedirectOutput writes all messages to w instead of stdout, for commands that use stdout for a protocol.
+// It returns the writer the messages were written to before, to restore it.
+func RedirectOutput(w io.Writer) io.Writer {
+	previous := output
+	output = w
+	pterm.SetDefaultOutput(w)
+	for _, printer := range []*pterm.PrefixPrinter{&pterm.Info, &pterm.Success, &pterm.Warning, &pterm.Error, &pterm.Debug} {
+		printer.Writer = w
+	}
+	return previous
+}
@@ -0,0 +1,106 @@
+package lsp
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// evaluationPattern matches flag evaluations with a string literal key across the OpenFeature SDKs,
+// for example getBooleanValue("key", ...), get_boolean_value("key", ...), GetBooleanValueAsync("key", ...),
+// client.BooleanValue(ctx, "key", ...) and useFlag("key", ...).
+// The second submatch is the flag key.
+var evaluationPattern = regexp.MustCompile(`(?i)(?:\bget_?(?:boolean|string|number|integer|int|float|double|object)_?(?:value|details)(?:_?async)?|\buse(?:boolean|string|number|object)?flag(?:value|details)?|\.(?:boolean|string|int|float|object)(?:value|valuedetails))\s*\(\s*(?:[A-Za-z_][\w.]*\s*,\s*)?(["'` + "`" + `])([^"'` + "`" + `\\\n]*)["'` + "`" + `]`)
+
+// keyReference is a flag key passed as a string literal to a flag evaluation
+type keyReference struct {
+	key   string
+	line  int
+	start int
+	end   int
+}
+
+// splitLines splits a document into lines, dropping line terminators
+func splitLines(text string) []string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	return lines
+}
+
+// findEvaluations returns the flag keys passed as string literals to flag evaluations in the lines.
+// The start and end of each reference are byte offsets of the key within its line.
+func findEvaluations(lines []string) []keyReference {
+	var refs []keyReference
+	for i, line := range lines {
+		for _, match := range evaluationPattern.FindAllStringSubmatchIndex(line, -1) {
+			start, end := match[4], match[5]
+			if start == end {
+				continue
+			}
+			refs = append(refs, keyReference{key: line[start:end], line: i, start: start, end: end})
+		}
+	}
+	return refs
+}
+
+// stringLiteralAt returns the byte range of the content of the string literal containing
+// the byte offset col. A literal that is not closed on the line extends to the end of the line,
+// so that keys can be completed while they are being typed.
+func stringLiteralAt(line string, col int) (start, end int, ok bool) {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote == 0 && (c == '"' || c == '\'' || c == '`'):
+			quote = c
+			start = i + 1
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			if col >= start && col <= i {
+				return start, i, true
+			}
+			quote = 0
+		}
+	}
+
+	if quote != 0 && col >= start {
+		return start, len(line), true
+	}
+	return 0, 0, false
+}
+
+// toUTF16 converts a byte offset within the line to the UTF-16 offset used by LSP positions
+func toUTF16(line string, offset int) int {
+	if offset > len(line) {
+		offset = len(line)
+	}
+
+	units := 0
+	for _, r := range line[:offset] {
+		units += utf16.RuneLen(r)
+	}
+	return units
+}
+
+// fromUTF16 converts a UTF-16 offset used by LSP positions to a byte offset within the line
+func fromUTF16(line string, units int) int {
+	offset := 0
+	for units > 0 && offset < len(line) {
+		r, size := utf8.DecodeRuneInString(line[offset:])
+		units -= utf16.RuneLen(r)
+		offset += size
+	}
+	return offset
+}
+
+// lineRange returns the LSP range of the bytes from start to end of the given line
+func lineRange(lines []string, line, start, end int) textRange {
+	return textRange{
+		Start: position{Line: line, Character: toUTF16(lines[line], start)},
+		End:   position{Line: line, Character: toUTF16(lines[line], end)},
+	}
+}
@@ -0,0 +1,348 @@
+// Package lsp implements a minimal language server that provides flag key completion,
+// hover information and diagnostics in source code, powered by the flag manifest.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/manifest"
+)
+
+// Server is a language server that answers requests from an editor over a single connection
+type Server struct {
+	manifestPath string
+	now          func() time.Time
+
+	out   io.Writer
+	flags map[string]flagset.Flag
+	// sources are the absolute paths of the files the flags are read from
+	sources     map[string]bool
+	documents   map[string]string
+	initialized bool
+	shutdown    bool
+}
+
+// NewServer creates a server that serves the flags of the manifest at manifestPath.
+// The manifest is loaded when the editor initializes the server and reloaded whenever one of its
+// files, such as an included file or a default value file, is saved.
+func NewServer(manifestPath string) *Server {
+	return &Server{
+		manifestPath: manifestPath,
+		now:          time.Now,
+		documents:    make(map[string]string),
+	}
+}
+
+// Serve reads requests from in and writes responses to out until the editor sends the exit
+// notification or in is closed.
+func (s *Server) Serve(in io.Reader, out io.Writer) error {
+	s.out = out
+	reader := bufio.NewReader(in)
+
+	for {
+		body, err := readMessage(reader)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error reading message: %w", err)
+		}
+
+		var req request
+		if err := json.Unmarshal(body, &req); err != nil {
+			return fmt.Errorf("error unmarshaling message: %w", err)
+		}
+
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("exit received before shutdown")
+			}
+			return nil
+		}
+
+		if err := s.handle(req); err != nil {
+			return err
+		}
+	}
+}
+
+// handle dispatches a request or notification and writes the response, if any
+func (s *Server) handle(req request) error {
+	if !s.initialized && req.Method != "initialize" {
+		if req.ID == nil {
+			return nil
+		}
+		return s.replyError(req.ID, codeServerNotInitialized, "server not initialized")
+	}
+
+	switch req.Method {
+	case "initialize":
+		s.initialized = true
+		s.loadManifest()
+		return s.reply(req.ID, map[string]any{
+			"capabilities": map[string]any{
+				"textDocumentSync": map[string]any{
+					"openClose": true,
+					"change":    textDocumentSyncFull,
+					"save":      true,
+				},
+				"completionProvider": map[string]any{
+					"triggerCharacters": []string{`"`, `'`, "`"},
+				},
+				"hoverProvider": true,
+			},
+			"serverInfo": map[string]any{"name": "openfeature"},
+		})
+	case "shutdown":
+		s.shutdown = true
+		return s.reply(req.ID, nil)
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil
+		}
+		s.documents[params.TextDocument.URI] = params.TextDocument.Text
+		return s.publishDiagnostics(params.TextDocument.URI)
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := json.Unmarshal(req.Params, &params); err != nil || len(params.ContentChanges) == 0 {
+			return nil
+		}
+		s.documents[params.TextDocument.URI] = params.ContentChanges[len(params.ContentChanges)-1].Text
+		return s.publishDiagnostics(params.TextDocument.URI)
+	case "textDocument/didSave":
+		var params textDocumentParams
+		if err := json.Unmarshal(req.Params, &params); err != nil || !s.isSource(params.TextDocument.URI) {
+			return nil
+		}
+		s.loadManifest()
+		for uri := range s.documents {
+			if err := s.publishDiagnostics(uri); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "textDocument/didClose":
+		var params textDocumentParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil
+		}
+		delete(s.documents, params.TextDocument.URI)
+		return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []diagnostic{},
+		})
+	case "textDocument/completion":
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return s.replyError(req.ID, codeInvalidParams, err.Error())
+		}
+		return s.reply(req.ID, s.completion(params))
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return s.replyError(req.ID, codeInvalidParams, err.Error())
+		}
+		return s.reply(req.ID, s.hover(params))
+	}
+
+	if req.ID == nil {
+		// Notifications that are not supported are ignored
+		return nil
+	}
+	return s.replyError(req.ID, codeMethodNotFound, fmt.Sprintf("method not supported: %s", req.Method))
+}
+
+// loadManifest (re)loads the flags from the manifest.
+// A manifest that fails to load is reported to the editor and disables diagnostics
+// until it is fixed, so that every key isn't reported as unknown.
+func (s *Server) loadManifest() {
+	s.sources = make(map[string]bool)
+	for _, path := range manifest.SourceFiles(s.manifestPath) {
+		if abs, err := filepath.Abs(path); err == nil {
+			s.sources[abs] = true
+		}
+	}
+
+	fs, err := manifest.LoadFlagSet(s.manifestPath)
+	if err != nil {
+		s.flags = nil
+		_ = s.notify("window/showMessage", map[string]any{
+			"type":    messageTypeWarning,
+			"message": fmt.Sprintf("OpenFeature: failed to load manifest %q: %v", s.manifestPath, err),
+		})
+		return
+	}
+
+	s.flags = make(map[string]flagset.Flag, len(fs.Flags))
+	for _, flag := range fs.Flags {
+		s.flags[flag.Key] = flag
+	}
+}
+
+// isSource reports whether the document URI refers to one of the files the flags are read from
+func (s *Server) isSource(uri string) bool {
+	path, err := uriToPath(uri)
+	if err != nil {
+		return false
+	}
+	return s.sources[filepath.Clean(path)]
+}
+
+// completion returns all flag keys when the position is inside a string literal
+func (s *Server) completion(params textDocumentPositionParams) []completionItem {
+	items := []completionItem{}
+
+	lines := splitLines(s.documents[params.TextDocument.URI])
+	if params.Position.Line < 0 || params.Position.Line >= len(lines) {
+		return items
+	}
+	line := lines[params.Position.Line]
+	col := fromUTF16(line, params.Position.Character)
+
+	start, end, ok := stringLiteralAt(line, col)
+	if !ok {
+		return items
+	}
+	replace := lineRange(lines, params.Position.Line, start, end)
+
+	keys := make([]string, 0, len(s.flags))
+	for key := range s.flags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		flag := s.flags[key]
+		items = append(items, completionItem{
+			Label:         key,
+			Kind:          completionItemKindConstant,
+			Detail:        flag.Type.String(),
+			Documentation: markupContent{Kind: "markdown", Value: s.describe(flag)},
+			TextEdit:      textEdit{Range: replace, NewText: key},
+		})
+	}
+	return items
+}
+
+// hover returns the details of the flag whose key is the string literal at the position, if any
+func (s *Server) hover(params textDocumentPositionParams) *hover {
+	lines := splitLines(s.documents[params.TextDocument.URI])
+	if params.Position.Line < 0 || params.Position.Line >= len(lines) {
+		return nil
+	}
+	line := lines[params.Position.Line]
+	col := fromUTF16(line, params.Position.Character)
+
+	start, end, ok := stringLiteralAt(line, col)
+	if !ok {
+		return nil
+	}
+	flag, ok := s.flags[line[start:end]]
+	if !ok {
+		return nil
+	}
+
+	return &hover{
+		Contents: markupContent{Kind: "markdown", Value: s.describe(flag)},
+		Range:    lineRange(lines, params.Position.Line, start, end),
+	}
+}
+
+// describe renders the details of a flag as markdown
+func (s *Server) describe(flag flagset.Flag) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s** (%s)\n\n", flag.Key, flag.Type)
+	if flag.Description != "" {
+		fmt.Fprintf(&b, "%s\n\n", flag.Description)
+	}
+
+	defaultValue, err := json.Marshal(flag.DefaultValue)
+	if err == nil {
+		fmt.Fprintf(&b, "Default: `%s`\n", defaultValue)
+	}
+	if flag.ActiveFrom != nil {
+		fmt.Fprintf(&b, "\nActive from: %s\n", flag.ActiveFrom.Format(time.RFC3339))
+	}
+	if flag.ActiveUntil != nil {
+		expired := ""
+		if !s.now().Before(*flag.ActiveUntil) {
+			expired = " (expired)"
+		}
+		fmt.Fprintf(&b, "\nActive until: %s%s\n", flag.ActiveUntil.Format(time.RFC3339), expired)
+	}
+	return b.String()
+}
+
+// diagnostics reports flag evaluations in the document that use unknown or expired flag keys
+func (s *Server) diagnostics(uri string) []diagnostic {
+	diagnostics := []diagnostic{}
+	if s.flags == nil || s.isSource(uri) {
+		return diagnostics
+	}
+
+	lines := splitLines(s.documents[uri])
+	now := s.now()
+	for _, ref := range findEvaluations(lines) {
+		var message string
+		flag, ok := s.flags[ref.key]
+		switch {
+		case !ok:
+			message = fmt.Sprintf("unknown flag key %q: the flag is not defined in %s", ref.key, s.manifestPath)
+		case flag.ActiveUntil != nil && !now.Before(*flag.ActiveUntil):
+			message = fmt.Sprintf("flag %q expired at %s", ref.key, flag.ActiveUntil.Format(time.RFC3339))
+		default:
+			continue
+		}
+
+		diagnostics = append(diagnostics, diagnostic{
+			Range:    lineRange(lines, ref.line, ref.start, ref.end),
+			Severity: diagnosticSeverityWarning,
+			Source:   "openfeature",
+			Message:  message,
+		})
+	}
+	return diagnostics
+}
+
+// publishDiagnostics sends the diagnostics of an open document to the editor
+func (s *Server) publishDiagnostics(uri string) error {
+	return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: s.diagnostics(uri),
+	})
+}
+
+func (s *Server) reply(id *json.RawMessage, result any) error {
+	return writeMessage(s.out, response{JSONRPC: "2.0", ID: id, Result: result})
+}
+
+func (s *Server) replyError(id *json.RawMessage, code int, message string) error {
+	return writeMessage(s.out, errorResponse{JSONRPC: "2.0", ID: id, Error: responseError{Code: code, Message: message}})
+}
+
+func (s *Server) notify(method string, params any) error {
+	return writeMessage(s.out, notification{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+// uriToPath converts a file URI to a file path
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported URI scheme: %s", u.Scheme)
+	}
+	return filepath.FromSlash(u.Path), nil
+}
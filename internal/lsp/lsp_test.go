@@ -0,0 +1,275 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `{
+	"flags": {
+		"new-checkout": {
+			"flagType": "boolean",
+			"defaultValue": false,
+			"description": "Enables the new checkout flow"
+		},
+		"holiday-banner": {
+			"flagType": "string",
+			"defaultValue": "off",
+			"activeUntil": "2025-01-01T00:00:00Z"
+		}
+	}
+}`
+
+const testDocument = `const enabled = await client.getBooleanValue("new-checkout", false);
+const banner = await client.getStringValue("holiday-banner", "off");
+const missing = await client.getBooleanValue("old-checkout", false);
+const label = "new-"`
+
+const testURI = "file:///project/src/checkout.ts"
+
+// session runs the server on the given messages, preceded by initialize and
+// followed by shutdown and exit, and returns the messages written by the server
+func session(t *testing.T, messages ...map[string]any) []map[string]any {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(testManifest), 0o644))
+
+	var in bytes.Buffer
+	all := append([]map[string]any{{"id": 0, "method": "initialize", "params": map[string]any{}}}, messages...)
+	all = append(all, map[string]any{"id": 999, "method": "shutdown"}, map[string]any{"method": "exit"})
+	for _, msg := range all {
+		msg["jsonrpc"] = "2.0"
+		require.NoError(t, writeMessage(&in, msg))
+	}
+
+	server := NewServer("flags.json")
+	server.now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	var out bytes.Buffer
+	require.NoError(t, server.Serve(&in, &out))
+
+	var replies []map[string]any
+	reader := bufio.NewReader(&out)
+	for {
+		body, err := readMessage(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+
+		var reply map[string]any
+		require.NoError(t, json.Unmarshal(body, &reply))
+		replies = append(replies, reply)
+	}
+	return replies
+}
+
+// find returns the reply to the request with the given id
+func find(t *testing.T, replies []map[string]any, id float64) map[string]any {
+	t.Helper()
+	for _, reply := range replies {
+		if reply["id"] == id {
+			return reply
+		}
+	}
+	t.Fatalf("no reply to request %v", id)
+	return nil
+}
+
+func didOpen() map[string]any {
+	return map[string]any{
+		"method": "textDocument/didOpen",
+		"params": map[string]any{
+			"textDocument": map[string]any{"uri": testURI, "languageId": "typescript", "version": 1, "text": testDocument},
+		},
+	}
+}
+
+func positionRequest(id int, method string, line, character int) map[string]any {
+	return map[string]any{
+		"id":     id,
+		"method": method,
+		"params": map[string]any{
+			"textDocument": map[string]any{"uri": testURI},
+			"position":     map[string]any{"line": line, "character": character},
+		},
+	}
+}
+
+func TestDiagnostics(t *testing.T) {
+	replies := session(t, didOpen())
+
+	var diagnostics []any
+	for _, reply := range replies {
+		if reply["method"] == "textDocument/publishDiagnostics" {
+			params := reply["params"].(map[string]any)
+			assert.Equal(t, testURI, params["uri"])
+			diagnostics = params["diagnostics"].([]any)
+		}
+	}
+
+	require.Len(t, diagnostics, 2)
+
+	expired := diagnostics[0].(map[string]any)
+	assert.Equal(t, `flag "holiday-banner" expired at 2025-01-01T00:00:00Z`, expired["message"])
+	assert.Equal(t, map[string]any{
+		"start": map[string]any{"line": float64(1), "character": float64(44)},
+		"end":   map[string]any{"line": float64(1), "character": float64(58)},
+	}, expired["range"])
+
+	unknown := diagnostics[1].(map[string]any)
+	assert.Contains(t, unknown["message"], `unknown flag key "old-checkout"`)
+	assert.Equal(t, float64(2), unknown["range"].(map[string]any)["start"].(map[string]any)["line"])
+}
+
+func TestReloadOnSave(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.json", []byte(`{"include": ["flags/checkout.json"], "flags": {}}`), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "flags/checkout.json", []byte(`{
+		"flags": {"new-checkout": {"flagType": "object", "defaultValueFile": "layout.json"}}
+	}`), 0o644))
+	require.NoError(t, afero.WriteFile(fs, "flags/layout.json", []byte(`{"columns": 2}`), 0o644))
+
+	server := NewServer("flags.json")
+	server.out = io.Discard
+	server.initialized = true
+	server.loadManifest()
+	require.Contains(t, server.flags, "new-checkout")
+
+	save := func(path string) {
+		t.Helper()
+		abs, err := filepath.Abs(path)
+		require.NoError(t, err)
+		params, err := json.Marshal(map[string]any{"textDocument": map[string]any{"uri": "file://" + filepath.ToSlash(abs)}})
+		require.NoError(t, err)
+		require.NoError(t, server.handle(request{Method: "textDocument/didSave", Params: params}))
+	}
+
+	// Saving an included file reloads the flags
+	require.NoError(t, afero.WriteFile(fs, "flags/checkout.json", []byte(`{
+		"flags": {"old-checkout": {"flagType": "object", "defaultValueFile": "layout.json"}}
+	}`), 0o644))
+	save("flags/checkout.json")
+	assert.Contains(t, server.flags, "old-checkout")
+
+	// So does saving a default value file
+	require.NoError(t, afero.WriteFile(fs, "flags/layout.json", []byte(`{"columns": 3}`), 0o644))
+	save("flags/layout.json")
+	assert.Equal(t, map[string]any{"columns": float64(3)}, server.flags["old-checkout"].DefaultValue)
+
+	// Other files don't
+	require.NoError(t, afero.WriteFile(fs, "flags/checkout.json", []byte(`{"flags": {}}`), 0o644))
+	save("src/checkout.ts")
+	assert.Contains(t, server.flags, "old-checkout")
+}
+
+func TestCompletion(t *testing.T) {
+	replies := session(t,
+		didOpen(),
+		positionRequest(1, "textDocument/completion", 3, 18),
+		positionRequest(2, "textDocument/completion", 0, 10),
+	)
+
+	items := find(t, replies, 1)["result"].([]any)
+	require.Len(t, items, 2)
+
+	item := items[1].(map[string]any)
+	assert.Equal(t, "new-checkout", item["label"])
+	assert.Equal(t, "boolean", item["detail"])
+	assert.Equal(t, map[string]any{
+		"range": map[string]any{
+			"start": map[string]any{"line": float64(3), "character": float64(15)},
+			"end":   map[string]any{"line": float64(3), "character": float64(19)},
+		},
+		"newText": "new-checkout",
+	}, item["textEdit"])
+
+	assert.Empty(t, find(t, replies, 2)["result"], "keys should only be completed in string literals")
+}
+
+func TestHover(t *testing.T) {
+	replies := session(t,
+		didOpen(),
+		positionRequest(1, "textDocument/hover", 0, 50),
+		positionRequest(2, "textDocument/hover", 1, 50),
+		positionRequest(3, "textDocument/hover", 2, 50),
+	)
+
+	contents := find(t, replies, 1)["result"].(map[string]any)["contents"].(map[string]any)
+	assert.Equal(t, "markdown", contents["kind"])
+	assert.Equal(t, "**new-checkout** (boolean)\n\nEnables the new checkout flow\n\nDefault: `false`\n", contents["value"])
+
+	contents = find(t, replies, 2)["result"].(map[string]any)["contents"].(map[string]any)
+	assert.Contains(t, contents["value"], "Active until: 2025-01-01T00:00:00Z (expired)")
+
+	assert.Nil(t, find(t, replies, 3)["result"], "unknown keys should not have hover information")
+}
+
+func TestUnsupportedMethod(t *testing.T) {
+	replies := session(t, map[string]any{"id": 1, "method": "textDocument/definition", "params": map[string]any{}})
+
+	reply := find(t, replies, 1)
+	assert.NotContains(t, reply, "result")
+	assert.Equal(t, float64(codeMethodNotFound), reply["error"].(map[string]any)["code"])
+}
+
+func TestStringLiteralAt(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		col       int
+		wantStart int
+		wantEnd   int
+		wantOK    bool
+	}{
+		{name: "inside double quotes", line: `get("my-flag")`, col: 6, wantStart: 5, wantEnd: 12, wantOK: true},
+		{name: "at closing quote", line: `get('my-flag')`, col: 12, wantStart: 5, wantEnd: 12, wantOK: true},
+		{name: "unclosed literal", line: "get(`my-", col: 8, wantStart: 5, wantEnd: 8, wantOK: true},
+		{name: "escaped quote", line: `get("a\"b")`, col: 9, wantStart: 5, wantEnd: 9, wantOK: true},
+		{name: "outside literal", line: `get("my-flag")`, col: 2, wantOK: false},
+		{name: "between literals", line: `f("a", "b")`, col: 5, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := stringLiteralAt(tt.line, tt.col)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.wantStart, start)
+				assert.Equal(t, tt.wantEnd, end)
+			}
+		})
+	}
+}
+
+func TestFindEvaluations(t *testing.T) {
+	lines := []string{
+		`enabled := client.BooleanValue(ctx, "go-flag", false, openfeature.EvaluationContext{})`,
+		`client.getBooleanValue("java-flag", false);`,
+		`client.get_string_details('python-flag', "")`,
+		`await client.GetIntegerValueAsync("dotnet-flag", 1);`,
+		`const { value } = useFlag("react-flag", false);`,
+		`name := flag.String("not-a-flag", "", "usage")`,
+	}
+
+	var keys []string
+	for _, ref := range findEvaluations(lines) {
+		keys = append(keys, ref.key)
+	}
+
+	assert.Equal(t, []string{"go-flag", "java-flag", "python-flag", "dotnet-flag", "react-flag"}, keys)
+}
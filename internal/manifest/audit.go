@@ -0,0 +1,70 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/open-feature/cli/internal/filesystem"
+)
+
+// How a safeguarded change was confirmed
+const (
+	AuditConfirmedByPhrase   = "phrase"
+	AuditConfirmedByApprover = "approver"
+	// AuditConfirmedByOverrideFreeze is a change made during a freeze window with --override-freeze
+	AuditConfirmedByOverrideFreeze = "override-freeze"
+)
+
+// AuditEntry records a change to remote flags that needed an extra confirmation
+type AuditEntry struct {
+	Time        time.Time `json:"time"`
+	Operation   string    `json:"operation"`
+	ProviderURL string    `json:"providerUrl"`
+	// Target is the named target of .openfeature.yaml pushed to. Empty without a target.
+	Target string `json:"target,omitempty"`
+	// User is the local user who made the change
+	User    string            `json:"user,omitempty"`
+	Changes []AuditFlagChange `json:"changes"`
+	// ConfirmedBy is how the change was confirmed: with the confirmation phrase or by a second approver
+	ConfirmedBy string `json:"confirmedBy"`
+	// ApprovedBy references the second approver, such as their name or the URL of their review
+	ApprovedBy string `json:"approvedBy,omitempty"`
+	// Reason is why a freeze window was overridden
+	Reason string `json:"reason,omitempty"`
+	// Error is why the change failed. Empty if it succeeded.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditFlagChange is the change of the default value of a flag
+type AuditFlagChange struct {
+	Key  string `json:"key"`
+	Risk string `json:"risk"`
+	From any    `json:"from"`
+	To   any    `json:"to"`
+}
+
+// AppendAuditLog appends the entry to the audit log at the given path, as a line of JSON.
+// The log and its directory are created if they don't exist.
+func AppendAuditLog(path string, entry AuditEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error marshaling audit entry: %w", err)
+	}
+
+	fs := filesystem.FileSystem()
+	if err := fs.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return fmt.Errorf("error creating audit log directory: %w", err)
+	}
+	f, err := fs.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("error opening audit log %s: %w", path, err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("error writing audit log %s: %w", path, err)
+	}
+	return nil
+}
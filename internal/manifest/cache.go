@@ -0,0 +1,98 @@
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+)
+
+// CacheOptions holds options for caching flags loaded from a remote source
+type CacheOptions struct {
+	// TTL is how long cached flags are reused. Caching is disabled if TTL is not positive.
+	TTL time.Duration
+	// Disabled bypasses the cache, both for reading and writing
+	Disabled bool
+}
+
+// cacheEntry is the on-disk representation of cached flags
+type cacheEntry struct {
+	FetchedAt time.Time        `json:"fetchedAt"`
+	Flags     *flagset.Flagset `json:"manifest"`
+}
+
+// LoadWithCache returns the flags cached for the given source and auth token if they are
+// younger than the TTL. Otherwise, the flags are loaded with load and written to the cache.
+// The auth token is part of the cache key, so credentials with different access never share entries.
+func LoadWithCache(source string, authToken string, opts CacheOptions, load func() (*flagset.Flagset, error)) (*flagset.Flagset, error) {
+	if opts.Disabled || opts.TTL <= 0 {
+		return load()
+	}
+
+	path, err := cachePath(source, authToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry, ok := readCacheEntry(path); ok {
+		if age := time.Since(entry.FetchedAt); age < opts.TTL {
+			logger.Default.Debug(fmt.Sprintf("Using flags cached %s ago for %s", age.Round(time.Second), source))
+			return entry.Flags, nil
+		}
+	}
+
+	flags, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(cacheEntry{FetchedAt: time.Now(), Flags: flags})
+	if err == nil {
+		err = filesystem.WriteFile(path, data)
+	}
+	if err != nil {
+		logger.Default.Debug(fmt.Sprintf("Failed to cache flags for %s: %v", source, err))
+	}
+
+	return flags, nil
+}
+
+// CachedFlags returns the flags last cached for the given source and auth token, regardless of
+// their age, along with the time they were fetched. Returns false if nothing is cached.
+func CachedFlags(source string, authToken string) (*flagset.Flagset, time.Time, bool) {
+	path, err := cachePath(source, authToken)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	entry, ok := readCacheEntry(path)
+	if !ok {
+		return nil, time.Time{}, false
+	}
+	return entry.Flags, entry.FetchedAt, true
+}
+
+// cachePath returns the path of the cache file for the given source and auth token
+func cachePath(source string, authToken string) (string, error) {
+	sum := sha256.Sum256([]byte(source + "\x00" + authToken))
+	return filesystem.CachePath("pull", hex.EncodeToString(sum[:])+".json")
+}
+
+// readCacheEntry reads the cache file at path, returning false if it is missing or invalid
+func readCacheEntry(path string) (cacheEntry, bool) {
+	data, err := filesystem.ReadFile(path)
+	if err != nil {
+		return cacheEntry{}, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Flags == nil {
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
@@ -0,0 +1,193 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// TypeMismatchStrategy is how a pulled default value that conflicts with the declared type is handled
+type TypeMismatchStrategy string
+
+const (
+	// TypeMismatchFail aborts the pull
+	TypeMismatchFail TypeMismatchStrategy = "fail"
+	// TypeMismatchCoerce converts the pulled value to the declared type, if it can be converted without loss
+	TypeMismatchCoerce TypeMismatchStrategy = "coerce"
+	// TypeMismatchKeepLocal keeps the type and default value of the local manifest
+	TypeMismatchKeepLocal TypeMismatchStrategy = "keep-local"
+)
+
+// IsValidTypeMismatchStrategy checks if the given strategy is a valid type mismatch strategy
+func IsValidTypeMismatchStrategy(strategy string) bool {
+	switch TypeMismatchStrategy(strategy) {
+	case TypeMismatchFail, TypeMismatchCoerce, TypeMismatchKeepLocal:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetValidTypeMismatchStrategies returns a list of all valid type mismatch strategies
+func GetValidTypeMismatchStrategies() []string {
+	return []string{
+		string(TypeMismatchFail),
+		string(TypeMismatchCoerce),
+		string(TypeMismatchKeepLocal),
+	}
+}
+
+// TypeMismatch is a pulled flag whose default value conflicts with its declared type
+type TypeMismatch struct {
+	// Key is the key of the flag
+	Key string
+	// DeclaredType is the type of the flag in the local manifest, or the pulled type if the flag is new
+	DeclaredType flagset.FlagType
+	// Value is the pulled default value
+	Value any
+	// Resolution describes how the mismatch was resolved, empty if it was not
+	Resolution string
+}
+
+// String describes the mismatch for display
+func (m TypeMismatch) String() string {
+	value, _ := json.Marshal(m.Value)
+	message := fmt.Sprintf("flag %q: pulled default value %s conflicts with declared type %s", m.Key, value, m.DeclaredType)
+	if m.Resolution != "" {
+		message += "; " + m.Resolution
+	}
+	return message
+}
+
+// ResolveTypeMismatches finds the pulled flags whose default value conflicts with the type declared
+// in the local manifest (or the pulled type for new flags) and resolves them in place with the strategy.
+// The local flagset may be nil. Returns the mismatches found; with TypeMismatchFail, or if a
+// mismatch cannot be resolved with the strategy, an error is returned as well.
+func ResolveTypeMismatches(local *flagset.Flagset, pulled *flagset.Flagset, strategy TypeMismatchStrategy) ([]TypeMismatch, error) {
+	localFlags := make(map[string]flagset.Flag)
+	if local != nil {
+		for _, flag := range local.Flags {
+			localFlags[flag.Key] = flag
+		}
+	}
+
+	var mismatches []TypeMismatch
+	var unresolved []string
+	for i := range pulled.Flags {
+		flag := &pulled.Flags[i]
+		localFlag, isLocal := localFlags[flag.Key]
+
+		declared := flag.Type
+		if isLocal {
+			declared = localFlag.Type
+		}
+		if flag.DefaultValue == nil || conformsToType(flag.DefaultValue, declared) {
+			continue
+		}
+
+		mismatch := TypeMismatch{Key: flag.Key, DeclaredType: declared, Value: flag.DefaultValue}
+		switch strategy {
+		case TypeMismatchCoerce:
+			if value, ok := coerceToType(flag.DefaultValue, declared); ok {
+				flag.Type = declared
+				flag.DefaultValue = value
+				coerced, _ := json.Marshal(value)
+				mismatch.Resolution = fmt.Sprintf("coerced to %s", coerced)
+			} else {
+				unresolved = append(unresolved, fmt.Sprintf("%s (cannot be coerced to %s)", flag.Key, declared))
+			}
+		case TypeMismatchKeepLocal:
+			if isLocal {
+				flag.Type = localFlag.Type
+				flag.DefaultValue = localFlag.DefaultValue
+				mismatch.Resolution = "kept the local default value"
+			} else {
+				unresolved = append(unresolved, fmt.Sprintf("%s (not in the local manifest)", flag.Key))
+			}
+		default:
+			unresolved = append(unresolved, flag.Key)
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+
+	if len(unresolved) > 0 {
+		return mismatches, fmt.Errorf("pulled default values conflict with the declared flag types: %s",
+			strings.Join(unresolved, ", "))
+	}
+	return mismatches, nil
+}
+
+// conformsToType reports whether a default value decoded from JSON is valid for the flag type
+func conformsToType(value any, flagType flagset.FlagType) bool {
+	switch flagType {
+	case flagset.BoolType:
+		_, ok := value.(bool)
+		return ok
+	case flagset.StringType:
+		_, ok := value.(string)
+		return ok
+	case flagset.IntType:
+		switch v := value.(type) {
+		case int, int64:
+			return true
+		case float64:
+			return v == math.Trunc(v)
+		}
+		return false
+	case flagset.FloatType:
+		switch value.(type) {
+		case int, int64, float64:
+			return true
+		}
+		return false
+	case flagset.ObjectType:
+		_, ok := value.(map[string]any)
+		return ok
+	default:
+		return true
+	}
+}
+
+// coerceToType converts a default value to the flag type if it can be converted without loss,
+// for example the string "true" to a boolean or the number 1 to the string "1"
+func coerceToType(value any, flagType flagset.FlagType) (any, bool) {
+	switch flagType {
+	case flagset.BoolType:
+		if s, ok := value.(string); ok {
+			b, err := strconv.ParseBool(strings.TrimSpace(s))
+			return b, err == nil
+		}
+	case flagset.IntType:
+		if s, ok := value.(string); ok {
+			i, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+			return i, err == nil
+		}
+	case flagset.FloatType:
+		if s, ok := value.(string); ok {
+			f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			return f, err == nil && !math.IsInf(f, 0) && !math.IsNaN(f)
+		}
+	case flagset.StringType:
+		switch v := value.(type) {
+		case bool:
+			return strconv.FormatBool(v), true
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), true
+		case int:
+			return strconv.Itoa(v), true
+		case int64:
+			return strconv.FormatInt(v, 10), true
+		}
+	case flagset.ObjectType:
+		if s, ok := value.(string); ok {
+			var object map[string]any
+			err := json.Unmarshal([]byte(s), &object)
+			return object, err == nil && object != nil
+		}
+	}
+	return nil, false
+}
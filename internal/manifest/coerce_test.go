@@ -0,0 +1,106 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTypeMismatches(t *testing.T) {
+	local := &flagset.Flagset{
+		Flags: []flagset.Flag{
+			{Key: "bool-flag", Type: flagset.BoolType, DefaultValue: false},
+			{Key: "int-flag", Type: flagset.IntType, DefaultValue: float64(10)},
+			{Key: "string-flag", Type: flagset.StringType, DefaultValue: "a"},
+		},
+	}
+	pulled := func() *flagset.Flagset {
+		return &flagset.Flagset{
+			Flags: []flagset.Flag{
+				{Key: "bool-flag", Type: flagset.StringType, DefaultValue: "true"},
+				{Key: "int-flag", Type: flagset.IntType, DefaultValue: "20"},
+				{Key: "string-flag", Type: flagset.StringType, DefaultValue: "b"},
+				{Key: "new-flag", Type: flagset.FloatType, DefaultValue: float64(1.5)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		local         *flagset.Flagset
+		strategy      TypeMismatchStrategy
+		expectedError string
+		expected      map[string]any
+	}{
+		{
+			name:          "fail reports every mismatch",
+			local:         local,
+			strategy:      TypeMismatchFail,
+			expectedError: "bool-flag, int-flag",
+		},
+		{
+			name:     "coerce converts values to the declared type",
+			local:    local,
+			strategy: TypeMismatchCoerce,
+			expected: map[string]any{"bool-flag": true, "int-flag": int64(20), "string-flag": "b", "new-flag": 1.5},
+		},
+		{
+			name:     "keep-local keeps the local default values",
+			local:    local,
+			strategy: TypeMismatchKeepLocal,
+			expected: map[string]any{"bool-flag": false, "int-flag": float64(10), "string-flag": "b", "new-flag": 1.5},
+		},
+		{
+			name:          "keep-local fails for flags that are not local",
+			strategy:      TypeMismatchKeepLocal,
+			expectedError: "int-flag (not in the local manifest)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pulled()
+			mismatches, err := ResolveTypeMismatches(tt.local, flags, tt.strategy)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, mismatches, 2)
+
+			for _, flag := range flags.Flags {
+				assert.Equal(t, tt.expected[flag.Key], flag.DefaultValue, "unexpected default value for %s", flag.Key)
+			}
+			assert.Equal(t, flagset.BoolType, flags.Flags[0].Type)
+		})
+	}
+}
+
+func TestCoerceToType(t *testing.T) {
+	tests := []struct {
+		value    any
+		flagType flagset.FlagType
+		expected any
+		ok       bool
+	}{
+		{value: "false", flagType: flagset.BoolType, expected: false, ok: true},
+		{value: "yes", flagType: flagset.BoolType, ok: false},
+		{value: "1.5", flagType: flagset.IntType, ok: false},
+		{value: "1e3", flagType: flagset.FloatType, expected: 1000.0, ok: true},
+		{value: float64(3), flagType: flagset.StringType, expected: "3", ok: true},
+		{value: true, flagType: flagset.StringType, expected: "true", ok: true},
+		{value: `{"a":1}`, flagType: flagset.ObjectType, expected: map[string]any{"a": float64(1)}, ok: true},
+		{value: "[1]", flagType: flagset.ObjectType, ok: false},
+	}
+
+	for _, tt := range tests {
+		value, ok := coerceToType(tt.value, tt.flagType)
+		assert.Equal(t, tt.ok, ok, "coercing %v to %s", tt.value, tt.flagType)
+		if tt.ok {
+			assert.Equal(t, tt.expected, value, "coercing %v to %s", tt.value, tt.flagType)
+		}
+	}
+}
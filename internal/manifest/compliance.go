@@ -0,0 +1,82 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// findComplianceIssues checks that flags tagged as affecting personal data or data
+// residency carry the metadata required by their tags.
+func findComplianceIssues(data []byte) []ValidationError {
+	var manifest struct {
+		Flags map[string]struct {
+			Compliance *flagset.Compliance `json:"compliance"`
+		} `json:"flags"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil
+	}
+
+	keys := make([]string, 0, len(manifest.Flags))
+	for key := range manifest.Flags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var issues []ValidationError
+	for _, key := range keys {
+		compliance := manifest.Flags[key].Compliance
+		if compliance == nil {
+			continue
+		}
+
+		path := fmt.Sprintf("flags.%s", key)
+		if compliance.PII && compliance.DPIA == "" {
+			issues = append(issues, ValidationError{
+				Type:    "compliance",
+				Path:    path,
+				Message: fmt.Sprintf("flag '%s' affects PII and requires a 'compliance.dpia' link", key),
+			})
+		}
+		if compliance.DataResidency && len(compliance.Regions) == 0 {
+			issues = append(issues, ValidationError{
+				Type:    "compliance",
+				Path:    path,
+				Message: fmt.Sprintf("flag '%s' is subject to data residency and requires a 'compliance.regions' list", key),
+			})
+		}
+	}
+
+	return issues
+}
+
+// CheckTargetRegion returns an error if any flag may not be pushed to a target in the given region.
+// If region is empty, flags restricted to specific regions cannot be pushed at all, because
+// it is unknown where the target is located.
+func CheckTargetRegion(fs *flagset.Flagset, region string) error {
+	var restricted, disallowed []string
+	for _, flag := range fs.Flags {
+		if flag.Compliance == nil || len(flag.Compliance.Regions) == 0 {
+			continue
+		}
+		restricted = append(restricted, flag.Key)
+		if !flag.Compliance.AllowsRegion(region) {
+			disallowed = append(disallowed, flag.Key)
+		}
+	}
+
+	if region == "" && len(restricted) > 0 {
+		return fmt.Errorf("flags restricted to specific regions cannot be pushed to a target with an unknown region: %s. Set the target region with --target-region",
+			strings.Join(restricted, ", "))
+	}
+	if len(disallowed) > 0 {
+		return fmt.Errorf("flags may not be pushed to a target in region %q: %s",
+			region, strings.Join(disallowed, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// FieldEdit is a change to a field of a flag entry in the manifest
+type FieldEdit struct {
+	// Name is the name of the field in the flag entry, e.g. defaultValue
+	Name string
+	// Value is the new value of the field. A nil value removes the field.
+	Value any
+}
+
+// entryMember is a member of a JSON object, located by its offsets in the manifest data
+type entryMember struct {
+	name       string
+	keyStart   int
+	valueStart int
+	valueEnd   int
+}
+
+// flagEntry is the entry of a flag in the manifest data
+type flagEntry struct {
+	// keyStart and keyEnd are the offsets of the quoted key of the flag
+	keyStart int
+	keyEnd   int
+	// start is the offset of the opening brace of the entry
+	start   int
+	members []entryMember
+}
+
+// EditFlag changes fields of the entry of a flag in the manifest data. Only the edited values change:
+// the formatting of the manifest and the order of its flags and fields are kept, and new fields are
+// added at the end of the entry. The edited manifest is validated before it is returned.
+func EditFlag(data []byte, key string, edits []FieldEdit) ([]byte, error) {
+	for _, edit := range edits {
+		entry, err := findFlagEntry(data, key)
+		if err != nil {
+			return nil, err
+		}
+		data, err = entry.edit(data, edit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return validateEdited(data)
+}
+
+// AddFlag adds the entry of a flag at the end of the flags object of the manifest data. The rest of
+// the manifest is kept as it is, and the entry is indented like the entries before it. The edited
+// manifest is validated before it is returned.
+func AddFlag(data []byte, flag flagset.Flag) ([]byte, error) {
+	flags, err := findFlags(data)
+	if err != nil {
+		return nil, err
+	}
+	if slices.ContainsFunc(flags.members, func(member entryMember) bool { return member.name == flag.Key }) {
+		return nil, fmt.Errorf("flag '%s' already exists in manifest", flag.Key)
+	}
+	data, err = flags.edit(data, FieldEdit{Name: flag.Key, Value: Entry(flag)})
+	if err != nil {
+		return nil, err
+	}
+	return validateEdited(data)
+}
+
+// RemoveFlag removes the entry of the flag with the key from the manifest data, keeping the rest of
+// the manifest as it is
+func RemoveFlag(data []byte, key string) ([]byte, error) {
+	flags, err := findFlags(data)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(flags.members, func(member entryMember) bool { return member.name == key }) {
+		return nil, fmt.Errorf("flag '%s' not found in manifest", key)
+	}
+	data, err = flags.edit(data, FieldEdit{Name: key})
+	if err != nil {
+		return nil, err
+	}
+	return validateEdited(data)
+}
+
+// validateEdited returns the edited manifest data if it is valid
+func validateEdited(data []byte) ([]byte, error) {
+	validationErrors, err := Validate(data)
+	if err != nil {
+		return nil, err
+	} else if len(validationErrors) > 0 {
+		return nil, fmt.Errorf("edited manifest is invalid: %s", FormatValidationError(validationErrors))
+	}
+	return data, nil
+}
+
+// edit applies a field edit to the entry and returns the edited data
+func (e flagEntry) edit(data []byte, edit FieldEdit) ([]byte, error) {
+	index := -1
+	for i, member := range e.members {
+		if member.name == edit.Name {
+			index = i
+		}
+	}
+
+	var result bytes.Buffer
+	if edit.Value == nil {
+		if index < 0 {
+			return data, nil
+		}
+		// Remove the member with the separator before it, or after it for the first member
+		member := e.members[index]
+		switch {
+		case index > 0:
+			result.Write(data[:e.members[index-1].valueEnd])
+			result.Write(data[member.valueEnd:])
+		case len(e.members) > 1:
+			result.Write(data[:member.keyStart])
+			result.Write(data[e.members[1].keyStart:])
+		default:
+			result.Write(data[:member.keyStart])
+			result.Write(data[member.valueEnd:])
+		}
+		return result.Bytes(), nil
+	}
+
+	if index >= 0 {
+		member := e.members[index]
+		value, err := marshalFieldValue(edit.Value, lineIndent(data, member.keyStart))
+		if err != nil {
+			return nil, err
+		}
+		result.Write(data[:member.valueStart])
+		result.Write(value)
+		result.Write(data[member.valueEnd:])
+		return result.Bytes(), nil
+	}
+
+	// Add the member after the last one, on its own line if the members are on their own lines
+	name, _ := json.Marshal(edit.Name)
+	if len(e.members) == 0 {
+		// The member of an empty object goes on its own line in manifests written on several lines
+		indent := lineIndent(data, e.start)
+		prefix, suffix := "", ""
+		if bytes.Contains(data, []byte("\n")) {
+			prefix, suffix = "\n"+indent+"  ", "\n"+indent
+			indent += "  "
+		}
+		value, err := marshalFieldValue(edit.Value, indent)
+		if err != nil {
+			return nil, err
+		}
+		end := e.start + 1 + bytes.IndexByte(data[e.start+1:], '}')
+		result.Write(data[:e.start+1])
+		fmt.Fprintf(&result, "%s%s: %s%s", prefix, name, value, suffix)
+		result.Write(data[end:])
+		return result.Bytes(), nil
+	}
+	last := e.members[len(e.members)-1]
+	indent := lineIndent(data, last.keyStart)
+	value, err := marshalFieldValue(edit.Value, indent)
+	if err != nil {
+		return nil, err
+	}
+	separator := ", "
+	if bytes.Contains(data[e.start:last.keyStart], []byte("\n")) {
+		separator = ",\n" + indent
+	}
+	result.Write(data[:last.valueEnd])
+	fmt.Fprintf(&result, "%s%s: %s", separator, name, value)
+	result.Write(data[last.valueEnd:])
+	return result.Bytes(), nil
+}
+
+// marshalFieldValue formats a field value, indenting objects and arrays from the indentation of
+// their line
+func marshalFieldValue(value any, indent string) ([]byte, error) {
+	data, err := json.MarshalIndent(value, indent, "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling field value: %w", err)
+	}
+	return data, nil
+}
+
+// lineIndent returns the whitespace at the start of the line holding the offset
+func lineIndent(data []byte, offset int) string {
+	start := bytes.LastIndexByte(data[:offset], '\n') + 1
+	end := start
+	for end < offset && (data[end] == ' ' || data[end] == '\t') {
+		end++
+	}
+	return string(data[start:end])
+}
+
+// findFlagEntry locates the entry of the flag with the key in the manifest data
+func findFlagEntry(data []byte, key string) (flagEntry, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := seekFlags(dec); err != nil {
+		return flagEntry{}, err
+	}
+	if err := expectDelim(dec, '{'); err != nil {
+		return flagEntry{}, err
+	}
+	for dec.More() {
+		offset := int(dec.InputOffset())
+		flagKey, err := dec.Token()
+		if err != nil {
+			return flagEntry{}, fmt.Errorf("error parsing manifest: %w", err)
+		}
+		if flagKey != key {
+			if err := dec.Decode(&json.RawMessage{}); err != nil {
+				return flagEntry{}, fmt.Errorf("error parsing manifest: %w", err)
+			}
+			continue
+		}
+		keyStart := offset + bytes.IndexByte(data[offset:], '"')
+		keyEnd := int(dec.InputOffset())
+		entry, err := parseFlagEntry(dec, data)
+		entry.keyStart, entry.keyEnd = keyStart, keyEnd
+		return entry, err
+	}
+	return flagEntry{}, fmt.Errorf("flag '%s' not found in manifest", key)
+}
+
+// findFlags locates the flags object of the manifest data, whose members are the entries of the flags
+func findFlags(data []byte) (flagEntry, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := seekFlags(dec); err != nil {
+		return flagEntry{}, err
+	}
+	return parseFlagEntry(dec, data)
+}
+
+// seekFlags reads the manifest data up to the flags object, leaving the decoder at it
+func seekFlags(dec *json.Decoder) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		name, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("error parsing manifest: %w", err)
+		}
+		if name == "flags" {
+			return nil
+		}
+		if err := dec.Decode(&json.RawMessage{}); err != nil {
+			return fmt.Errorf("error parsing manifest: %w", err)
+		}
+	}
+	return errors.New("error parsing manifest: the manifest has no flags object")
+}
+
+// parseFlagEntry reads the members of the flag entry the decoder is at
+func parseFlagEntry(dec *json.Decoder, data []byte) (flagEntry, error) {
+	if err := expectDelim(dec, '{'); err != nil {
+		return flagEntry{}, err
+	}
+	entry := flagEntry{start: int(dec.InputOffset()) - 1}
+	for dec.More() {
+		offset := int(dec.InputOffset())
+		name, err := dec.Token()
+		if err != nil {
+			return flagEntry{}, fmt.Errorf("error parsing manifest: %w", err)
+		}
+		member := entryMember{name: name.(string)}
+		member.keyStart = offset + bytes.IndexByte(data[offset:], '"')
+		member.valueStart = int(dec.InputOffset())
+		for member.valueStart < len(data) && bytes.IndexByte([]byte(" \t\r\n:"), data[member.valueStart]) >= 0 {
+			member.valueStart++
+		}
+		if err := dec.Decode(&json.RawMessage{}); err != nil {
+			return flagEntry{}, fmt.Errorf("error parsing manifest: %w", err)
+		}
+		member.valueEnd = int(dec.InputOffset())
+		entry.members = append(entry.members, member)
+	}
+	return entry, nil
+}
+
+// expectDelim reads the next token of the decoder, which must be the delimiter
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("error parsing manifest: %w", err)
+	}
+	if token != delim {
+		return errors.New("error parsing manifest: unexpected structure")
+	}
+	return nil
+}
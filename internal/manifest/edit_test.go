@@ -0,0 +1,150 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditFlag(t *testing.T) {
+	const data = `{
+    "flags": {
+        "zeta": {"flagType": "integer", "defaultValue": 1},
+        "new-checkout": {
+            "flagType": "boolean",
+            "description": "New checkout",
+            "defaultValue": false
+        }
+    },
+    "$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v0/flag-manifest.json"
+}
+`
+
+	t.Run("replaces values in place", func(t *testing.T) {
+		edited, err := EditFlag([]byte(data), "new-checkout", []FieldEdit{
+			{Name: "defaultValue", Value: true},
+			{Name: "description", Value: "New checkout flow"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `{
+    "flags": {
+        "zeta": {"flagType": "integer", "defaultValue": 1},
+        "new-checkout": {
+            "flagType": "boolean",
+            "description": "New checkout flow",
+            "defaultValue": true
+        }
+    },
+    "$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v0/flag-manifest.json"
+}
+`, string(edited))
+	})
+
+	t.Run("adds fields at the end of the entry", func(t *testing.T) {
+		edited, err := EditFlag([]byte(data), "new-checkout", []FieldEdit{{Name: "activeUntil", Value: "2026-12-31T00:00:00Z"}})
+		require.NoError(t, err)
+		assert.Contains(t, string(edited), `
+            "defaultValue": false,
+            "activeUntil": "2026-12-31T00:00:00Z"
+        }`)
+
+		edited, err = EditFlag([]byte(data), "zeta", []FieldEdit{{Name: "description", Value: "Zeta"}})
+		require.NoError(t, err)
+		assert.Contains(t, string(edited), `"zeta": {"flagType": "integer", "defaultValue": 1, "description": "Zeta"},`)
+	})
+
+	t.Run("removes fields", func(t *testing.T) {
+		edited, err := EditFlag([]byte(data), "new-checkout", []FieldEdit{{Name: "description"}})
+		require.NoError(t, err)
+		assert.Contains(t, string(edited), `
+            "flagType": "boolean",
+            "defaultValue": false
+        }`)
+
+		edited, err = EditFlag([]byte(data), "zeta", []FieldEdit{{Name: "flagType"}, {Name: "flagType", Value: "integer"}})
+		require.NoError(t, err)
+		assert.Contains(t, string(edited), `"zeta": {"defaultValue": 1, "flagType": "integer"},`)
+	})
+
+	t.Run("indents object values", func(t *testing.T) {
+		const objectData = `{
+  "flags": {
+    "theme": {
+      "flagType": "object",
+      "defaultValue": {}
+    }
+  }
+}`
+		edited, err := EditFlag([]byte(objectData), "theme", []FieldEdit{{Name: "defaultValue", Value: map[string]any{"color": "blue"}}})
+		require.NoError(t, err)
+		assert.Equal(t, `{
+  "flags": {
+    "theme": {
+      "flagType": "object",
+      "defaultValue": {
+        "color": "blue"
+      }
+    }
+  }
+}`, string(edited))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := EditFlag([]byte(data), "missing", []FieldEdit{{Name: "description", Value: "x"}})
+		assert.EqualError(t, err, "flag 'missing' not found in manifest")
+
+		_, err = EditFlag([]byte(data), "zeta", []FieldEdit{{Name: "defaultValue", Value: "one"}})
+		assert.ErrorContains(t, err, "edited manifest is invalid")
+	})
+}
+
+func TestAddAndRemoveFlag(t *testing.T) {
+	const data = `{
+  "flags": {
+    "zeta": {"flagType": "integer", "defaultValue": 1}
+  }
+}
+`
+	flag := flagset.Flag{Key: "new-checkout", Type: flagset.BoolType, DefaultValue: false, Description: "New checkout"}
+
+	added, err := AddFlag([]byte(data), flag)
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "flags": {
+    "zeta": {"flagType": "integer", "defaultValue": 1},
+    "new-checkout": {
+      "defaultValue": false,
+      "description": "New checkout",
+      "flagType": "boolean"
+    }
+  }
+}
+`, string(added))
+
+	_, err = AddFlag(added, flag)
+	assert.EqualError(t, err, "flag 'new-checkout' already exists in manifest")
+
+	removed, err := RemoveFlag(added, "new-checkout")
+	require.NoError(t, err)
+	assert.Equal(t, data, string(removed))
+
+	_, err = RemoveFlag(removed, "missing")
+	assert.EqualError(t, err, "flag 'missing' not found in manifest")
+
+	t.Run("to empty flags", func(t *testing.T) {
+		added, err := AddFlag([]byte("{\n  \"flags\": {}\n}\n"), flag)
+		require.NoError(t, err)
+		assert.Equal(t, `{
+  "flags": {
+    "new-checkout": {
+      "defaultValue": false,
+      "description": "New checkout",
+      "flagType": "boolean"
+    }
+  }
+}
+`, string(added))
+	})
+}
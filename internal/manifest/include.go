@@ -0,0 +1,393 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+)
+
+// manifestHeader holds the fields of a manifest file that say how it relates to the other files of
+// the manifest
+type manifestHeader struct {
+	Timezone string                     `json:"timezone"`
+	Include  []string                   `json:"include"`
+	Flags    map[string]json.RawMessage `json:"flags"`
+}
+
+// FileValidationError is an issue found in one of the files of a manifest
+type FileValidationError struct {
+	File string `json:"file"`
+	ValidationError
+}
+
+// readHeader reads the header of the manifest file at the path. A file that can't be parsed has an
+// empty header, so that its syntax errors are reported when it is loaded or validated.
+func readHeader(path string) (manifestHeader, error) {
+	var header manifestHeader
+	data, err := ReadData(path)
+	if err != nil {
+		if _, readErr := filesystem.ReadFile(path); readErr != nil {
+			return header, fmt.Errorf("error reading contents from file %q", path)
+		}
+		return header, nil
+	}
+	_ = json.Unmarshal(data, &header)
+	return header, nil
+}
+
+// Files returns the path of the manifest followed by the paths of the files it includes, in the order
+// of its include patterns. The patterns are globs relative to the directory of the file that has them,
+// such as flags/*.json, and the included files can include more files. Every file is listed once.
+func Files(manifestPath string) ([]string, error) {
+	var files []string
+	seen := make(map[string]bool)
+
+	var add func(path string) error
+	add = func(path string) error {
+		if seen[filepath.Clean(path)] {
+			return nil
+		}
+		seen[filepath.Clean(path)] = true
+		files = append(files, path)
+
+		header, err := readHeader(path)
+		if err != nil {
+			return err
+		}
+		for _, pattern := range header.Include {
+			if !filepath.IsAbs(pattern) {
+				pattern = filepath.Join(filepath.Dir(path), pattern)
+			}
+			matches, err := afero.Glob(filesystem.FileSystem(), pattern)
+			if err != nil {
+				return fmt.Errorf("%s: invalid include pattern %q: %w", path, pattern, err)
+			}
+			if len(matches) == 0 && !strings.ContainsAny(pattern, `*?[\`) {
+				return fmt.Errorf("%s: included file %s does not exist", path, pattern)
+			}
+			for _, match := range matches {
+				if err := add(match); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := add(manifestPath); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// SourceFiles returns the files the flags of the manifest are read from: the files of the manifest,
+// including the files it includes, and the default value files they reference. Only the manifest is
+// returned if it can't be read, so that changes to a broken manifest are still noticed.
+func SourceFiles(manifestPath string) []string {
+	files, err := Files(manifestPath)
+	if err != nil {
+		return []string{manifestPath}
+	}
+
+	paths := slices.Clone(files)
+	for _, file := range files {
+		data, err := ReadData(file)
+		if err != nil {
+			continue
+		}
+		var m struct {
+			Flags map[string]struct {
+				DefaultValueFile string `json:"defaultValueFile"`
+			} `json:"flags"`
+		}
+		if err := json.Unmarshal(data, &m); err != nil {
+			continue
+		}
+
+		for _, flag := range m.Flags {
+			if flag.DefaultValueFile == "" {
+				continue
+			}
+			path := flag.DefaultValueFile
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(filepath.Dir(file), path)
+			}
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// FileOf returns the path of the file of the manifest that defines the flag with the key: the
+// manifest itself, or one of the files it includes
+func FileOf(manifestPath string, key string) (string, error) {
+	files, err := Files(manifestPath)
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		header, err := readHeader(file)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := header.Flags[key]; ok {
+			return file, nil
+		}
+	}
+	return "", fmt.Errorf("flag '%s' not found in manifest", key)
+}
+
+// loadFiles loads the flags of the manifest and of the files it includes into one flagset, which has
+// the timezone of the manifest. Each file is loaded in its own timezone, and its default value files
+// are relative to its own directory. A flag defined in more than one file is an error.
+func loadFiles(manifestPath string) (*flagset.Flagset, error) {
+	files, err := Files(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var merged *flagset.Flagset
+	definedIn := make(map[string]string)
+	for _, file := range files {
+		fs, err := loadFile(file)
+		if err != nil {
+			if file != manifestPath {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+			return nil, err
+		}
+		for _, flag := range fs.Flags {
+			if other, ok := definedIn[flag.Key]; ok {
+				return nil, fmt.Errorf("flag '%s' is defined in both %s and %s", flag.Key, other, file)
+			}
+			definedIn[flag.Key] = file
+		}
+		if merged == nil {
+			merged = fs
+		} else {
+			merged.Flags = append(merged.Flags, fs.Flags...)
+		}
+	}
+	return merged, nil
+}
+
+// ValidateFiles validates the manifest and the files it includes like ValidateDetailed, and reports
+// the flags that are defined in more than one of the files
+func ValidateFiles(manifestPath string) ([]FileValidationError, error) {
+	files, err := Files(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []FileValidationError
+	definedIn := make(map[string]string)
+	for _, file := range files {
+		raw, err := filesystem.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading manifest %s: %w", file, err)
+		}
+		fileIssues, err := ValidateDetailed(file, raw)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range fileIssues {
+			issues = append(issues, FileValidationError{File: file, ValidationError: issue})
+		}
+
+		data, err := ToJSON(file, raw)
+		if err != nil {
+			continue
+		}
+		var header manifestHeader
+		if json.Unmarshal(data, &header) != nil {
+			continue
+		}
+		positions := issuePositions(file, raw, data)
+		keys := slices.SortedFunc(maps.Keys(header.Flags), func(a, b string) int {
+			return positions["flags."+a][0] - positions["flags."+b][0]
+		})
+		for _, key := range keys {
+			other, ok := definedIn[key]
+			if !ok {
+				definedIn[key] = file
+				continue
+			}
+			pos := positions["flags."+key]
+			issues = append(issues, FileValidationError{File: file, ValidationError: ValidationError{
+				Type:    "duplicate_key",
+				Path:    "flags." + key,
+				Message: fmt.Sprintf("flag '%s' is also defined in %s", key, other),
+				Line:    max(pos[0], 1),
+				Column:  max(pos[1], 1),
+			}})
+		}
+	}
+	return issues, nil
+}
+
+// writeFiles writes the flags of the flagset back to the files of the manifest at the path: the flags
+// defined in an included file to that file, and the other flags to the manifest. Only the files whose
+// flags changed are written, by editing their entries in place like EditFlag, AddFlag and RemoveFlag,
+// so the formatting, comments and order of the rest of each file are kept. A manifest that doesn't
+// exist yet is created.
+func writeFiles(path string, fs flagset.Flagset) error {
+	if exists, err := afero.Exists(filesystem.FileSystem(), path); err != nil || !exists {
+		flags := make(map[string]any, len(fs.Flags))
+		for _, flag := range fs.Flags {
+			flags[flag.Key] = Entry(flag)
+		}
+		m := createInitManifest(flags)
+		m.Timezone = fs.Timezone
+		return writeManifest(path, m)
+	}
+
+	files, err := Files(path)
+	if err != nil {
+		return err
+	}
+
+	definedIn := make(map[string]string)
+	for _, file := range files[1:] {
+		header, err := readHeader(file)
+		if err != nil {
+			return err
+		}
+		for key := range header.Flags {
+			definedIn[key] = file
+		}
+	}
+
+	flags := make(map[string][]flagset.Flag, len(files))
+	for _, flag := range fs.Flags {
+		file, ok := definedIn[flag.Key]
+		if !ok {
+			file = path
+		}
+		flags[file] = append(flags[file], flag)
+	}
+
+	for _, file := range files {
+		timezone := fs.Timezone
+		if file != path {
+			timezone = ""
+		}
+		if err := writeFile(file, flags[file], timezone, file == path); err != nil {
+			if file != path {
+				return fmt.Errorf("%s: %w", file, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFile edits the manifest file at the path so that it defines exactly the flags, and writes it
+// if that changed it. The timezone of the file is only set when setTimezone is true.
+func writeFile(path string, flags []flagset.Flag, timezone string, setTimezone bool) error {
+	data, err := ReadData(path)
+	if err != nil {
+		return err
+	}
+	var header manifestHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return fmt.Errorf("error unmarshaling JSON: %w", err)
+	}
+	edited := data
+
+	// The existing flags are read in the new timezone, so the dates that mean another time in it
+	// are rewritten
+	if setTimezone && header.Timezone != timezone {
+		var value any
+		if timezone != "" {
+			value = timezone
+		}
+		if edited, err = editRoot(edited, FieldEdit{Name: "timezone", Value: value}); err != nil {
+			return err
+		}
+	}
+	existing, err := ParseFlagSet(edited)
+	if err != nil {
+		return err
+	}
+
+	existingFlags := make(map[string]flagset.Flag, len(existing.Flags))
+	for _, flag := range existing.Flags {
+		existingFlags[flag.Key] = flag
+	}
+	for _, flag := range flags {
+		old, ok := existingFlags[flag.Key]
+		delete(existingFlags, flag.Key)
+		if !ok {
+			if edited, err = AddFlag(edited, flag); err != nil {
+				return err
+			}
+			continue
+		}
+		edits, err := entryEdits(old, flag)
+		if err != nil {
+			return err
+		}
+		if len(edits) == 0 {
+			continue
+		}
+		if edited, err = EditFlag(edited, flag.Key, edits); err != nil {
+			return err
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(existingFlags)) {
+		if edited, err = RemoveFlag(edited, key); err != nil {
+			return err
+		}
+	}
+
+	if bytes.Equal(edited, data) {
+		return nil
+	}
+	return WriteData(path, edited)
+}
+
+// editRoot applies a field edit to the top-level object of the manifest data
+func editRoot(data []byte, edit FieldEdit) ([]byte, error) {
+	root, err := parseFlagEntry(json.NewDecoder(bytes.NewReader(data)), data)
+	if err != nil {
+		return nil, err
+	}
+	return root.edit(data, edit)
+}
+
+// entryEdits returns the field edits changing the manifest entry of the old flag into the entry of
+// the flag. Fields with the same value in both entries are left alone.
+func entryEdits(old flagset.Flag, flag flagset.Flag) ([]FieldEdit, error) {
+	var oldEntry, newEntry map[string]any
+	for entry, f := range map[*map[string]any]flagset.Flag{&oldEntry: old, &newEntry: flag} {
+		data, err := json.Marshal(Entry(f))
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling flag %s: %w", f.Key, err)
+		}
+		if err := json.Unmarshal(data, entry); err != nil {
+			return nil, fmt.Errorf("error unmarshaling flag %s: %w", f.Key, err)
+		}
+	}
+
+	var edits []FieldEdit
+	for _, name := range slices.Sorted(maps.Keys(newEntry)) {
+		if value, ok := oldEntry[name]; !ok || !reflect.DeepEqual(value, newEntry[name]) {
+			edits = append(edits, FieldEdit{Name: name, Value: newEntry[name]})
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(oldEntry)) {
+		if _, ok := newEntry[name]; !ok {
+			edits = append(edits, FieldEdit{Name: name})
+		}
+	}
+	return edits, nil
+}
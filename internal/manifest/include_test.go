@@ -0,0 +1,158 @@
+package manifest
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupIncludes(t *testing.T) afero.Fs {
+	t.Helper()
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	files := map[string]string{
+		"flags.json": `{
+  "include": ["flags/*.json", "shared/common.yaml"],
+  "flags": {
+    "new-checkout": {"flagType": "boolean", "defaultValue": false}
+  }
+}
+`,
+		"flags/payments.json": `{"flags": {"payment-retries": {"flagType": "integer", "defaultValue": 3}}}`,
+		"flags/search.json":   `{"flags": {"search-v2": {"flagType": "boolean", "defaultValue": true}}}`,
+		"shared/common.yaml": `flags:
+  theme:
+    flagType: string
+    defaultValue: light
+`,
+	}
+	for path, content := range files {
+		require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0o644))
+	}
+	return fs
+}
+
+func flagKeys(fs *flagset.Flagset) []string {
+	var keys []string
+	for _, flag := range fs.Flags {
+		keys = append(keys, flag.Key)
+	}
+	return keys
+}
+
+func TestFiles(t *testing.T) {
+	fs := setupIncludes(t)
+
+	files, err := Files("flags.json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"flags.json", "flags/payments.json", "flags/search.json", "shared/common.yaml"}, files)
+
+	t.Run("files are listed once", func(t *testing.T) {
+		require.NoError(t, afero.WriteFile(fs, "flags/search.json", []byte(`{"include": ["../flags.json"], "flags": {}}`), 0o644))
+		files, err := Files("flags.json")
+		require.NoError(t, err)
+		assert.Len(t, files, 4)
+	})
+
+	t.Run("missing included file", func(t *testing.T) {
+		require.NoError(t, afero.WriteFile(fs, "other.json", []byte(`{"include": ["missing.json"], "flags": {}}`), 0o644))
+		_, err := Files("other.json")
+		assert.EqualError(t, err, "other.json: included file missing.json does not exist")
+	})
+}
+
+func TestLoadFlagSetWithIncludes(t *testing.T) {
+	fs := setupIncludes(t)
+
+	flags, err := LoadFlagSet("flags.json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"new-checkout", "payment-retries", "search-v2", "theme"}, flagKeys(flags))
+
+	file, err := FileOf("flags.json", "search-v2")
+	require.NoError(t, err)
+	assert.Equal(t, "flags/search.json", file)
+	_, err = FileOf("flags.json", "missing")
+	assert.EqualError(t, err, "flag 'missing' not found in manifest")
+
+	t.Run("duplicate keys across files", func(t *testing.T) {
+		require.NoError(t, afero.WriteFile(fs, "flags/search.json", []byte(`{"flags": {"theme": {"flagType": "string", "defaultValue": "dark"}}}`), 0o644))
+		_, err := LoadFlagSet("flags.json")
+		assert.EqualError(t, err, "flag 'theme' is defined in both flags/search.json and shared/common.yaml")
+
+		issues, err := ValidateFiles("flags.json")
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		assert.Equal(t, FileValidationError{File: "shared/common.yaml", ValidationError: ValidationError{
+			Type:    "duplicate_key",
+			Path:    "flags.theme",
+			Message: "flag 'theme' is also defined in flags/search.json",
+			Line:    3,
+			Column:  5,
+		}}, issues[0])
+	})
+}
+
+func TestWriteWithIncludes(t *testing.T) {
+	fs := setupIncludes(t)
+	require.NoError(t, afero.WriteFile(fs, "shared/common.yaml", []byte(`flags:
+  # Theme of the storefront
+  theme:
+    flagType: string
+    defaultValue: light
+`), 0o644))
+
+	flags, err := LoadFlagSet("flags.json")
+	require.NoError(t, err)
+	flags.Flags = append(flags.Flags[1:], flagset.Flag{Key: "summer-sale", Type: flagset.BoolType, DefaultValue: false})
+	flags.Flags[2].DefaultValue = "dark"
+	require.NoError(t, Write("flags.json", *flags))
+
+	content, err := afero.ReadFile(fs, "flags.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+  "include": ["flags/*.json", "shared/common.yaml"],
+  "flags": {"summer-sale": {"flagType": "boolean", "defaultValue": false, "description": ""}}
+}`, string(content))
+
+	// Only the changed fields of the flags are written, keeping the comments of the file
+	content, err = afero.ReadFile(fs, "shared/common.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, `flags:
+  # Theme of the storefront
+  theme:
+    flagType: string
+    defaultValue: dark
+`, string(content))
+
+	// Files whose flags didn't change are not written
+	content, err = afero.ReadFile(fs, "flags/payments.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"flags": {"payment-retries": {"flagType": "integer", "defaultValue": 3}}}`, string(content))
+
+	flags, err = LoadFlagSet("flags.json")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"summer-sale", "payment-retries", "search-v2", "theme"}, flagKeys(flags))
+}
+
+func TestSourceFiles(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "config/flags.json", []byte(`{
+		"flags": {
+			"theme": {"flagType": "object", "defaultValueFile": "defaults/theme.json"},
+			"banner": {"flagType": "string", "defaultValue": "hi"}
+		}
+	}`), 0o644))
+
+	assert.Equal(t, []string{"config/flags.json", filepath.Join("config", "defaults", "theme.json")},
+		SourceFiles("config/flags.json"))
+
+	require.NoError(t, afero.WriteFile(fs, "config/flags.json", []byte(`{"flags": `), 0o644))
+	assert.Equal(t, []string{"config/flags.json"}, SourceFiles("config/flags.json"),
+		"a broken manifest is still watched")
+}
@@ -0,0 +1,124 @@
+package manifest
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// Expiry health of an initiative, from the activation windows of its flags
+const (
+	InitiativeHealthOK       = "ok"
+	InitiativeHealthExpiring = "expiring"
+	InitiativeHealthExpired  = "expired"
+)
+
+// Rollout states of a flag, from the rollout the provider reports in its metadata
+const (
+	RolloutOff     = "off"
+	RolloutPartial = "partial"
+	RolloutFull    = "full"
+	RolloutUnknown = "unknown"
+)
+
+// InitiativeExpiringWindow is how soon before its activeUntil time a flag counts as expiring
+const InitiativeExpiringWindow = 30 * 24 * time.Hour
+
+// rolloutMetadataFields are the provider metadata fields checked, in order, for the rollout of a flag
+var rolloutMetadataFields = []string{"rolloutState", "rolloutPercentage", "rollout"}
+
+// InitiativeStatus is the roll-up of the flags of an initiative
+type InitiativeStatus struct {
+	// Name is the initiative, or empty for the flags that are not assigned to one
+	Name  string   `json:"name"`
+	Flags []string `json:"flags"`
+	// Rollout counts the flags by rollout state
+	Rollout      map[string]int `json:"rollout"`
+	Expired      int            `json:"expired"`
+	ExpiringSoon int            `json:"expiringSoon"`
+	NoExpiry     int            `json:"noExpiry"`
+	Health       string         `json:"health"`
+}
+
+// RollUpInitiatives groups the flags by initiative as of now, sorted by name with the unassigned
+// flags last
+func RollUpInitiatives(flags []flagset.Flag, now time.Time) []InitiativeStatus {
+	byName := map[string]*InitiativeStatus{}
+	for _, flag := range flags {
+		status, ok := byName[flag.Initiative]
+		if !ok {
+			status = &InitiativeStatus{Name: flag.Initiative, Flags: []string{}, Rollout: map[string]int{}}
+			byName[flag.Initiative] = status
+		}
+
+		status.Flags = append(status.Flags, flag.Key)
+		status.Rollout[RolloutState(flag)]++
+		switch {
+		case flag.ActiveUntil == nil:
+			status.NoExpiry++
+		case !now.Before(*flag.ActiveUntil):
+			status.Expired++
+		case flag.ActiveUntil.Sub(now) <= InitiativeExpiringWindow:
+			status.ExpiringSoon++
+		}
+	}
+
+	statuses := make([]InitiativeStatus, 0, len(byName))
+	for _, status := range byName {
+		switch {
+		case status.Expired > 0:
+			status.Health = InitiativeHealthExpired
+		case status.ExpiringSoon > 0:
+			status.Health = InitiativeHealthExpiring
+		default:
+			status.Health = InitiativeHealthOK
+		}
+		sort.Strings(status.Flags)
+		statuses = append(statuses, *status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		if (statuses[i].Name == "") != (statuses[j].Name == "") {
+			return statuses[j].Name == ""
+		}
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// RolloutState returns the rollout state of the flag that the provider reports in its metadata:
+// a state such as "full", or a percentage, which is off at 0, full at 100 and partial in between
+func RolloutState(flag flagset.Flag) string {
+	for _, field := range rolloutMetadataFields {
+		switch value := flag.Metadata[field].(type) {
+		case string:
+			if value != "" {
+				return strings.ToLower(value)
+			}
+		case json.Number:
+			if percentage, err := value.Float64(); err == nil {
+				return rolloutPercentageState(percentage)
+			}
+		case float64:
+			return rolloutPercentageState(value)
+		case int:
+			return rolloutPercentageState(float64(value))
+		}
+	}
+	return RolloutUnknown
+}
+
+// rolloutPercentageState returns the rollout state of a flag rolled out to the percentage of users
+func rolloutPercentageState(percentage float64) string {
+	switch {
+	case percentage <= 0:
+		return RolloutOff
+	case percentage >= 100:
+		return RolloutFull
+	default:
+		return RolloutPartial
+	}
+}
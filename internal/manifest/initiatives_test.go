@@ -0,0 +1,47 @@
+package manifest
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollUpInitiatives(t *testing.T) {
+	now := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	at := func(t time.Time) *time.Time { return &t }
+
+	flags := []flagset.Flag{
+		{Key: "checkout-button", Initiative: "checkout", ActiveUntil: at(now.AddDate(0, 0, 10)), Metadata: map[string]any{"rolloutPercentage": 50.0}},
+		{Key: "checkout-api", Initiative: "checkout", ActiveUntil: at(now.AddDate(0, 2, 0)), Metadata: map[string]any{"rolloutPercentage": 100.0}},
+		{Key: "old-search", Initiative: "search", ActiveUntil: at(now.AddDate(0, 0, -1)), Metadata: map[string]any{"rolloutState": "Full"}},
+		{Key: "dark-mode"},
+		{Key: "a-theme", Initiative: "apps"},
+	}
+
+	assert.Equal(t, []InitiativeStatus{
+		{Name: "apps", Flags: []string{"a-theme"}, Rollout: map[string]int{RolloutUnknown: 1}, NoExpiry: 1, Health: InitiativeHealthOK},
+		{Name: "checkout", Flags: []string{"checkout-api", "checkout-button"}, Rollout: map[string]int{RolloutFull: 1, RolloutPartial: 1}, ExpiringSoon: 1, Health: InitiativeHealthExpiring},
+		{Name: "search", Flags: []string{"old-search"}, Rollout: map[string]int{"full": 1}, Expired: 1, Health: InitiativeHealthExpired},
+		{Name: "", Flags: []string{"dark-mode"}, Rollout: map[string]int{RolloutUnknown: 1}, NoExpiry: 1, Health: InitiativeHealthOK},
+	}, RollUpInitiatives(flags, now))
+}
+
+func TestRolloutState(t *testing.T) {
+	tests := []struct {
+		metadata map[string]any
+		want     string
+	}{
+		{nil, RolloutUnknown},
+		{map[string]any{"rolloutPercentage": 0.0}, RolloutOff},
+		{map[string]any{"rolloutPercentage": 25.0}, RolloutPartial},
+		{map[string]any{"rollout": 100}, RolloutFull},
+		{map[string]any{"rolloutPercentage": json.Number("100")}, RolloutFull},
+		{map[string]any{"rolloutState": "Ramping", "rolloutPercentage": 100.0}, "ramping"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, RolloutState(flagset.Flag{Metadata: tt.metadata}))
+	}
+}
@@ -45,6 +45,10 @@ type ObjectFlag struct {
 	Type string `json:"flagType,omitempty" jsonschema:"enum=object"`
 	// The value returned from an unsuccessful flag evaluation
 	DefaultValue any `json:"defaultValue,omitempty"`
+	// Path of a JSON file holding the default value, relative to the manifest. Used instead of defaultValue.
+	DefaultValueFile string `json:"defaultValueFile,omitempty"`
+	// SHA-256 hash of the default value file, recorded to detect changes to it.
+	DefaultValueSha256 string `json:"defaultValueSha256,omitempty" jsonschema:"pattern=^[a-f0-9]{64}$"`
 }
 
 type BaseFlag struct {
@@ -52,10 +56,70 @@ type BaseFlag struct {
 	Type string `json:"flagType,omitempty" jsonschema:"required"`
 	// A concise description of this feature flag's purpose.
 	Description string `json:"description,omitempty"`
+	// Translations of the description, keyed by locale (e.g., de, fr-CA).
+	Descriptions map[string]string `json:"descriptions,omitempty"`
+	// Metadata about the experiment this feature flag is part of.
+	Experiment *Experiment `json:"experiment,omitempty"`
+	// Marks a boolean flag as a kill switch that can be tripped during an incident.
+	KillSwitch bool `json:"killSwitch,omitempty"`
+	// Data protection metadata, such as whether the flag affects personal data.
+	Compliance *Compliance `json:"compliance,omitempty"`
+	// Start of the window in which the flag is active, as an RFC 3339 timestamp or a date (the start of the day in the timezone of the manifest).
+	ActiveFrom string `json:"activeFrom,omitempty" jsonschema:"pattern=^[0-9]{4}-[0-9]{2}-[0-9]{2}(T[0-9]{2}:[0-9]{2}:[0-9]{2}(\\.[0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2}))?$"`
+	// End of the window in which the flag is active, as an RFC 3339 timestamp or a date (the start of the day in the timezone of the manifest). Must be after activeFrom.
+	ActiveUntil string `json:"activeUntil,omitempty" jsonschema:"pattern=^[0-9]{4}-[0-9]{2}-[0-9]{2}(T[0-9]{2}:[0-9]{2}:[0-9]{2}(\\.[0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2}))?$"`
+	// Provider-side information about the flag captured on pull (e.g., maintainer, tags, creation date).
+	Metadata map[string]any `json:"metadata,omitempty"`
+	// Labels of the flag (e.g., server-only), for example to exclude it from code generation with generate.exclude-tags.
+	Tags []string `json:"tags,omitempty"`
+	// Whether the flag is included in generated code. Set to false to keep it out of all generated code.
+	Codegen *bool `json:"codegen,omitempty"`
+	// Where the flag may be evaluated. Server-only flags are left out of client-side generated code.
+	Visibility string `json:"visibility,omitempty" jsonschema:"enum=client,enum=server,enum=both"`
+	// Hints on how long generated clients may cache evaluations of the flag.
+	Cache *Cache `json:"cache,omitempty"`
+	// The epic or initiative the flag is delivered as part of (e.g., checkout-redesign), for the initiatives status command.
+	Initiative string `json:"initiative,omitempty"`
+	// How risky changing the flag is. Pushes that change the default value of high-risk flags need an extra confirmation.
+	Risk string `json:"risk,omitempty" jsonschema:"enum=low,enum=medium,enum=high"`
+}
+
+// Metadata about an experiment that a feature flag is part of
+type Experiment struct {
+	// The identifier of the experiment in the experimentation platform.
+	ID string `json:"id,omitempty"`
+	// The hypothesis the experiment is testing.
+	Hypothesis string `json:"hypothesis,omitempty"`
+	// The primary metric used to evaluate the experiment.
+	Metric string `json:"metric,omitempty"`
+}
+
+// Data protection metadata about a feature flag
+type Compliance struct {
+	// Whether the flag affects personally identifiable information. Requires a DPIA link.
+	PII bool `json:"pii,omitempty"`
+	// Whether the flag is subject to data residency rules. Requires a list of regions.
+	DataResidency bool `json:"dataResidency,omitempty"`
+	// Link to the data protection impact assessment covering this flag.
+	DPIA string `json:"dpia,omitempty" jsonschema:"format=uri"`
+	// Regions of the targets this flag may be pushed to (e.g., eu, us).
+	Regions []string `json:"regions,omitempty"`
+}
+
+// Hints on how long generated clients may cache evaluations of a feature flag
+type Cache struct {
+	// How long an evaluation is served from the cache, as a duration (e.g., 30s, 5m).
+	TTL string `json:"ttl" jsonschema:"pattern=^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"`
+	// How long an expired evaluation may still be served while it is refreshed in the background, as a duration (e.g., 5m).
+	StaleWhileRevalidate string `json:"staleWhileRevalidate,omitempty" jsonschema:"pattern=^([0-9]+(\\.[0-9]+)?(ms|s|m|h))+$"`
 }
 
 // Feature flag manifest for the OpenFeature CLI
 type Manifest struct {
+	// IANA timezone (e.g., Europe/Berlin) of the activeFrom and activeUntil dates without a time. Defaults to the timezone of the configuration, or UTC.
+	Timezone string `json:"timezone,omitempty"`
+	// Other manifest files whose flags are part of this manifest, as paths or globs (e.g., flags/*.json) relative to the directory of this file. A flag can only be defined in one of the files.
+	Include []string `json:"include,omitempty"`
 	// Collection of feature flag definitions
 	Flags map[string]any `json:"flags" jsonschema:"title=Flags,required"`
 }
@@ -96,7 +160,12 @@ func ToJSONSchema() *jsonschema.Schema {
 				{Ref: "#/$defs/floatFlag"},
 				{Ref: "#/$defs/objectFlag"},
 			},
-			Required: []string{"flagType", "defaultValue"},
+			Required: []string{"flagType"},
+			// Object flags may keep their default value in an external file
+			AnyOf: []*jsonschema.Schema{
+				{Required: []string{"defaultValue"}},
+				{Required: []string{"defaultValueFile"}},
+			},
 		},
 		"booleanFlag": &jsonschema.Schema{
 			Type:       "object",
@@ -118,6 +187,22 @@ func ToJSONSchema() *jsonschema.Schema {
 			Type:       "object",
 			Properties: reflector.Reflect(ObjectFlag{}).Properties,
 		},
+		"Experiment": &jsonschema.Schema{
+			Type:                 "object",
+			Properties:           reflector.Reflect(Experiment{}).Properties,
+			AdditionalProperties: jsonschema.FalseSchema,
+		},
+		"Compliance": &jsonschema.Schema{
+			Type:                 "object",
+			Properties:           reflector.Reflect(Compliance{}).Properties,
+			AdditionalProperties: jsonschema.FalseSchema,
+		},
+		"Cache": &jsonschema.Schema{
+			Type:                 "object",
+			Properties:           reflector.Reflect(Cache{}).Properties,
+			Required:             []string{"ttl"},
+			AdditionalProperties: jsonschema.FalseSchema,
+		},
 	}
 
 	return schema
@@ -8,8 +8,10 @@ import (
 	"io"
 	"net/http"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	"github.com/open-feature/cli/internal/api/retry"
 	"github.com/open-feature/cli/internal/api/sync"
 	"github.com/open-feature/cli/internal/filesystem"
 	"github.com/open-feature/cli/internal/flagset"
@@ -30,14 +32,37 @@ func Create(path string) error {
 	return writeManifest(path, m)
 }
 
-// LoadFlagSet loads, validates, and unmarshals the manifest file at the given path into a flagset
+// LoadFlagSet loads, validates, and unmarshals the manifest file at the given path into a flagset,
+// with the flags of the files it includes
 func LoadFlagSet(manifestPath string) (*flagset.Flagset, error) {
-	fs := filesystem.FileSystem()
-	data, err := afero.ReadFile(fs, manifestPath)
+	return loadFiles(manifestPath)
+}
+
+// loadFile loads, validates, and unmarshals a single manifest file into a flagset
+func loadFile(manifestPath string) (*flagset.Flagset, error) {
+	data, err := filesystem.ReadFile(manifestPath)
 	if err != nil {
 		return nil, fmt.Errorf("error reading contents from file %q", manifestPath)
 	}
+	data, err = ToJSON(manifestPath, data)
+	if err != nil {
+		return nil, err
+	}
 
+	flags, err := ParseFlagSet(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ResolveDefaultValueFiles(flags, filepath.Dir(manifestPath)); err != nil {
+		return nil, err
+	}
+
+	return flags, nil
+}
+
+// ParseFlagSet validates and unmarshals manifest data into a flagset
+func ParseFlagSet(data []byte) (*flagset.Flagset, error) {
 	validationErrors, err := Validate(data)
 	if err != nil {
 		return nil, err
@@ -54,25 +79,72 @@ func LoadFlagSet(manifestPath string) (*flagset.Flagset, error) {
 	return &flagset, nil
 }
 
-// Write writes a flagset to a manifest file at the given path
+// Write writes a flagset to a manifest file at the given path. The flags defined in the files the
+// manifest includes are written to those files.
 func Write(path string, flagset flagset.Flagset) error {
-	flags := make(map[string]any)
-	for _, flag := range flagset.Flags {
-		flags[flag.Key] = map[string]any{
-			"flagType":     flag.Type.String(),
-			"description":  flag.Description,
-			"defaultValue": flag.DefaultValue,
+	return writeFiles(path, flagset)
+}
+
+// Entry converts a flag to its entry in the flags object of a manifest
+func Entry(flag flagset.Flag) map[string]any {
+	entry := map[string]any{
+		"flagType":     flag.Type.String(),
+		"description":  flag.Description,
+		"defaultValue": flag.DefaultValue,
+	}
+	if flag.DefaultValueFile != "" {
+		// Keep large defaults in their own file, recording the hash of its contents
+		delete(entry, "defaultValue")
+		entry["defaultValueFile"] = flag.DefaultValueFile
+		if flag.DefaultValueSha256 != "" {
+			entry["defaultValueSha256"] = flag.DefaultValueSha256
 		}
 	}
-
-	m := createInitManifest(flags)
-	return writeManifest(path, m)
+	if len(flag.Descriptions) > 0 {
+		entry["descriptions"] = flag.Descriptions
+	}
+	if flag.Experiment != nil {
+		entry["experiment"] = flag.Experiment
+	}
+	if flag.KillSwitch {
+		entry["killSwitch"] = true
+	}
+	if flag.Compliance != nil {
+		entry["compliance"] = flag.Compliance
+	}
+	if flag.ActiveFrom != nil {
+		entry["activeFrom"] = flag.ActiveFrom
+	}
+	if flag.ActiveUntil != nil {
+		entry["activeUntil"] = flag.ActiveUntil
+	}
+	if len(flag.Metadata) > 0 {
+		entry["metadata"] = flag.Metadata
+	}
+	if len(flag.Tags) > 0 {
+		entry["tags"] = flag.Tags
+	}
+	if flag.SkipCodegen {
+		entry["codegen"] = false
+	}
+	if flag.Visibility != "" {
+		entry["visibility"] = flag.Visibility
+	}
+	if flag.Cache != nil {
+		entry["cache"] = flag.Cache
+	}
+	if flag.Initiative != "" {
+		entry["initiative"] = flag.Initiative
+	}
+	if flag.Risk != "" {
+		entry["risk"] = flag.Risk
+	}
+	return entry
 }
 
 // LoadFromLocal loads flags from a local file path
 func LoadFromLocal(filePath string) (*flagset.Flagset, error) {
-	fs := filesystem.FileSystem()
-	data, err := afero.ReadFile(fs, filePath)
+	data, err := ReadData(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("error reading local flags file: %w", err)
 	}
@@ -87,31 +159,36 @@ func LoadFromLocal(filePath string) (*flagset.Flagset, error) {
 
 // LoadFromSyncAPI loads flags from a remote URL using the sync API client
 // This should be used when the remote source implements the sync API specification
-func LoadFromSyncAPI(baseURL string, authToken string) (*flagset.Flagset, error) {
+// The request and its retries are canceled when ctx is done.
+func LoadFromSyncAPI(ctx context.Context, baseURL string, authToken string) (*flagset.Flagset, error) {
 	logger.Default.Debug(fmt.Sprintf("Loading flags from sync API at %s", baseURL))
 
 	client, err := sync.NewClient(baseURL, authToken)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create sync client: %w", err)
 	}
+	defer logSyncMetrics(client)
 
-	ctx := context.Background()
 	return client.PullFlags(ctx)
 }
 
-// LoadFromRemote loads flags from a remote URL using direct HTTP requests
-// This is a fallback for sources that don't implement the sync API specification
-func LoadFromRemote(url string, authToken string) (*flagset.Flagset, error) {
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+// logSyncMetrics logs the metrics of the API calls made by the client, to help diagnose slow syncs
+func logSyncMetrics(client *sync.Client) {
+	for _, m := range client.Metrics() {
+		logger.Default.Debug(fmt.Sprintf("Sync API %s", m))
 	}
+}
 
+// LoadFromRemote loads flags from a remote URL using direct HTTP requests
+// This is a fallback for sources that don't implement the sync API specification
+// The request and its retries are canceled when ctx is done.
+func LoadFromRemote(ctx context.Context, url string, authToken string) (*flagset.Flagset, error) {
+	header := http.Header{}
 	if authToken != "" {
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", authToken))
+		header.Set("Authorization", fmt.Sprintf("Bearer %s", authToken))
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := retry.Get(ctx, retry.NewHTTPClient(), url, header)
 	if err != nil {
 		return nil, err
 	}
@@ -158,7 +235,17 @@ func writeManifest(path string, manifest *initManifest) error {
 	if err != nil {
 		return err
 	}
+	return WriteData(path, formattedManifest)
+}
 
+// WriteData replaces the manifest file at the given path with the given JSON contents, converted to
+// YAML or TOML for YAML and TOML manifests (see Render). The file is written to a temporary file first, so the
+// manifest is never left partially written.
+func WriteData(path string, formattedManifest []byte) error {
+	formattedManifest, err := Render(path, formattedManifest)
+	if err != nil {
+		return err
+	}
 	fs := filesystem.FileSystem()
 	dir := filepath.Dir(path)
 
@@ -207,19 +294,40 @@ func loadFlagsFromData(data []byte) (*flagset.Flagset, error) {
 	return &flagset.Flagset{Flags: *loadedFlags}, nil
 }
 
+// PushOptions holds options for pushing flags to a remote
+type PushOptions struct {
+	// DryRun only computes the changes without making any API calls that modify the remote
+	DryRun bool
+	// Prune deletes remote flags that are not present in the local flags
+	Prune bool
+	// ConfirmPrune is called with the flags that would be deleted before any change is made.
+	// The push is aborted if it returns false or an error. If nil, pruning is not confirmed.
+	ConfirmPrune func(flags []flagset.Flag) (bool, error)
+	// PruneKeys restricts pruning to the remote flags with these keys, such as the flags of a
+	// reviewed prune plan. If nil, all remote flags that are not present locally are pruned.
+	PruneKeys map[string]bool
+	// ConfirmHighRisk is called with the changes to the default values of high-risk flags before any
+	// change is made. The push is aborted if it returns an error. If nil, the changes are not confirmed.
+	ConfirmHighRisk func(changes []sync.DefaultChange) error
+	// Progress is called after each flag is created, updated or deleted on the remote. Optional.
+	Progress sync.ProgressFunc
+}
+
 // SaveToRemote saves flags to a remote URL using HTTP/HTTPS
 // This function performs a smart push: it fetches remote flags first,
 // compares them with local flags, and intelligently creates or updates
 // flags as needed. Returns a PushResult with details of what was changed.
-// If dryRun is true, only performs the comparison without making actual API calls.
-func SaveToRemote(url string, flags *flagset.Flagset, authToken string, dryRun bool) (*sync.PushResult, error) {
+// If opts.DryRun is true, only performs the comparison without making actual API calls.
+// If opts.Prune is true, remote flags that are not present locally are deleted.
+// All requests and their retries are canceled when ctx is done.
+func SaveToRemote(ctx context.Context, url string, flags *flagset.Flagset, authToken string, opts PushOptions) (*sync.PushResult, error) {
 	// Use the generated OpenAPI client for type-safe API calls
 	client, err := sync.NewClient(url, authToken)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create push client: %w", err)
 	}
-
-	ctx := context.Background()
+	client.OnProgress(opts.Progress)
+	defer logSyncMetrics(client)
 
 	// Fetch remote flags to compare with local flags using the sync client
 	logger.Default.Debug("Fetching remote flags for comparison")
@@ -229,6 +337,55 @@ func SaveToRemote(url string, flags *flagset.Flagset, authToken string, dryRun b
 	}
 	logger.Default.Debug(fmt.Sprintf("Fetched %d remote flags", len(remoteFlags.Flags)))
 
+	// Confirm the prune before making any changes, so a declined prune leaves the remote untouched
+	var toDelete []flagset.Flag
+	if opts.Prune {
+		toDelete = sync.FlagsToPrune(flags, remoteFlags)
+		if opts.PruneKeys != nil {
+			toDelete = slices.DeleteFunc(toDelete, func(flag flagset.Flag) bool {
+				return !opts.PruneKeys[flag.Key]
+			})
+		}
+		if len(toDelete) > 0 && !opts.DryRun && opts.ConfirmPrune != nil {
+			confirmed, err := opts.ConfirmPrune(toDelete)
+			if err != nil {
+				return nil, err
+			}
+			if !confirmed {
+				return nil, errors.New("push aborted: deletion of remote flags was not confirmed")
+			}
+		}
+	}
+
+	// Confirm the changes to the defaults of high-risk flags before making any changes
+	if !opts.DryRun && opts.ConfirmHighRisk != nil {
+		changes := slices.DeleteFunc(sync.DefaultChanges(flags, remoteFlags), func(change sync.DefaultChange) bool {
+			return change.Flag.Risk != flagset.RiskHigh
+		})
+		if len(changes) > 0 {
+			if err := opts.ConfirmHighRisk(changes); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Smart push: compare and intelligently create or update flags
-	return client.PushFlags(ctx, flags, remoteFlags, dryRun)
+	result, err := client.PushFlags(ctx, flags, remoteFlags, opts.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DryRun || len(toDelete) == 0 {
+		result.Deleted = toDelete
+		result.Metrics = client.Metrics()
+		return result, nil
+	}
+
+	result.Deleted, err = client.DeleteFlags(ctx, toDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Metrics = client.Metrics()
+	return result, nil
 }
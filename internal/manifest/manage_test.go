@@ -3,7 +3,11 @@ package manifest
 import (
 	"testing"
 
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestURLLooksLikeAFile(t *testing.T) {
@@ -81,3 +85,54 @@ func TestURLLooksLikeAFile(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteAndLoadFlagSetRoundTrip(t *testing.T) {
+	filesystem.SetFileSystem(afero.NewMemMapFs())
+
+	original := flagset.Flagset{
+		Flags: []flagset.Flag{
+			{
+				Key:          "newCheckout",
+				Type:         flagset.BoolType,
+				Description:  "Enables the new checkout",
+				Descriptions: map[string]string{"de": "Aktiviert den neuen Checkout"},
+				DefaultValue: false,
+				Experiment: &flagset.Experiment{
+					ID:     "exp-42",
+					Metric: "checkout_conversion",
+				},
+			},
+		},
+	}
+
+	require.NoError(t, Write("flags.json", original))
+
+	loaded, err := LoadFlagSet("flags.json")
+	require.NoError(t, err)
+	assert.Equal(t, original.Flags, loaded.Flags)
+}
+
+func TestWriteKeepsTimezone(t *testing.T) {
+	filesystem.SetFileSystem(afero.NewMemMapFs())
+	require.NoError(t, afero.WriteFile(filesystem.FileSystem(), "flags.json", []byte(`{
+		"timezone": "Asia/Tokyo",
+		"flags": {"sale": {"flagType": "boolean", "defaultValue": false, "activeUntil": "2026-12-24"}}
+	}`), 0o644))
+
+	loaded, err := LoadFlagSet("flags.json")
+	require.NoError(t, err)
+	require.NoError(t, Write("flags.json", *loaded))
+
+	content, err := afero.ReadFile(filesystem.FileSystem(), "flags.json")
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"timezone": "Asia/Tokyo"`)
+	assert.Contains(t, string(content), `"activeUntil": "2026-12-24"`)
+
+	loaded.Timezone = "Europe/Berlin"
+	require.NoError(t, Write("flags.json", *loaded))
+
+	content, err = afero.ReadFile(filesystem.FileSystem(), "flags.json")
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"timezone": "Europe/Berlin"`)
+	assert.Contains(t, string(content), `"activeUntil": "2026-12-24T00:00:00+09:00"`)
+}
@@ -0,0 +1,71 @@
+package manifest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// MetadataField maps a field of a flag in the remote source to a field of the flag metadata in the manifest
+type MetadataField struct {
+	// Name is the name of the field in the metadata of the manifest
+	Name string
+	// Path is the dot-separated path of the field in the remote flag (e.g., owner.email)
+	Path string
+}
+
+// ParseMetadataFields parses field mappings of the form name=path, or path to keep the name of the
+// remote field (e.g., "maintainer=owner.email", "tags")
+func ParseMetadataFields(specs []string) ([]MetadataField, error) {
+	fields := make([]MetadataField, 0, len(specs))
+	for _, spec := range specs {
+		name, path, found := strings.Cut(spec, "=")
+		if !found {
+			path = name
+			name = path[strings.LastIndex(path, ".")+1:]
+		}
+		name, path = strings.TrimSpace(name), strings.TrimSpace(path)
+		if name == "" || path == "" {
+			return nil, fmt.Errorf("invalid metadata field %q, expected name=path or path", spec)
+		}
+		fields = append(fields, MetadataField{Name: name, Path: path})
+	}
+	return fields, nil
+}
+
+// MapMetadata replaces the metadata of each pulled flag with the mapped fields of the remote flag.
+// Fields missing from the remote flag are skipped, and no metadata is kept if no fields are mapped.
+func MapMetadata(fs *flagset.Flagset, fields []MetadataField) {
+	for i := range fs.Flags {
+		flag := &fs.Flags[i]
+		remote := flag.Metadata
+		flag.Metadata = nil
+
+		for _, field := range fields {
+			value, ok := lookupPath(remote, field.Path)
+			if !ok {
+				continue
+			}
+			if flag.Metadata == nil {
+				flag.Metadata = make(map[string]any)
+			}
+			flag.Metadata[field.Name] = value
+		}
+	}
+}
+
+// lookupPath returns the value at the dot-separated path of nested objects
+func lookupPath(fields map[string]any, path string) (any, bool) {
+	var value any = fields
+	for _, part := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[part]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,35 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapMetadata(t *testing.T) {
+	fields, err := ParseMetadataFields([]string{"maintainer=owner.email", "audit.createdAt", "archived"})
+	require.NoError(t, err)
+	assert.Equal(t, []MetadataField{
+		{Name: "maintainer", Path: "owner.email"},
+		{Name: "createdAt", Path: "audit.createdAt"},
+		{Name: "archived", Path: "archived"},
+	}, fields)
+
+	fs := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "a", Metadata: map[string]any{
+			"owner":    map[string]any{"email": "team@example.com"},
+			"audit":    map[string]any{"createdAt": "2024-03-02T09:45:03Z"},
+			"internal": "not mapped",
+		}},
+		{Key: "b", Metadata: map[string]any{"owner": "team"}},
+	}}
+	MapMetadata(fs, fields)
+
+	assert.Equal(t, map[string]any{"maintainer": "team@example.com", "createdAt": "2024-03-02T09:45:03Z"}, fs.Flags[0].Metadata)
+	assert.Nil(t, fs.Flags[1].Metadata)
+
+	_, err = ParseMetadataFields([]string{"maintainer="})
+	assert.ErrorContains(t, err, `invalid metadata field "maintainer="`)
+}
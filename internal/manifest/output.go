@@ -12,12 +12,16 @@ const (
 	OutputFormatJSON OutputFormat = "json"
 	// OutputFormatYAML represents the YAML output format
 	OutputFormatYAML OutputFormat = "yaml"
+	// OutputFormatJSONPatch represents the RFC 6902 JSON Patch output format
+	OutputFormatJSONPatch OutputFormat = "json-patch"
+	// OutputFormatPatch represents the unified diff output format, as produced by git diff
+	OutputFormatPatch OutputFormat = "patch"
 )
 
 // IsValidOutputFormat checks if the given format is a valid output format
 func IsValidOutputFormat(format string) bool {
 	switch OutputFormat(format) {
-	case OutputFormatTree, OutputFormatFlat, OutputFormatJSON, OutputFormatYAML:
+	case OutputFormatTree, OutputFormatFlat, OutputFormatJSON, OutputFormatYAML, OutputFormatJSONPatch, OutputFormatPatch:
 		return true
 	default:
 		return false
@@ -31,5 +35,7 @@ func GetValidOutputFormats() []string {
 		string(OutputFormatFlat),
 		string(OutputFormatJSON),
 		string(OutputFormatYAML),
+		string(OutputFormatJSONPatch),
+		string(OutputFormatPatch),
 	}
 }
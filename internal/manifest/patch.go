@@ -0,0 +1,371 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PatchOperation is an operation of an RFC 6902 JSON Patch
+type PatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+	From  string `json:"from,omitempty"`
+}
+
+// JSONPatch converts the changes between two manifests to the RFC 6902 JSON Patch that turns the
+// old manifest into the new one. Changed flags are patched field by field, so fields that were
+// ignored in the comparison are left as they are.
+func JSONPatch(changes []Change) []PatchOperation {
+	sorted := slices.Clone(changes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Path < sorted[j].Path
+	})
+
+	operations := []PatchOperation{}
+	for _, change := range sorted {
+		path := "/flags/" + escapePointerToken(strings.TrimPrefix(change.Path, "flags."))
+		switch change.Type {
+		case "add":
+			operations = append(operations, PatchOperation{Op: "add", Path: path, Value: change.NewValue})
+		case "remove":
+			operations = append(operations, PatchOperation{Op: "remove", Path: path})
+		case "change":
+			operations = append(operations, diffOperations(path, change.OldValue, change.NewValue)...)
+		}
+	}
+	return operations
+}
+
+// diffOperations returns the operations that turn the old value at the path into the new value,
+// descending into objects so that only the members that differ are patched
+func diffOperations(path string, oldValue any, newValue any) []PatchOperation {
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+	if !oldIsMap || !newIsMap {
+		if reflect.DeepEqual(oldValue, newValue) {
+			return nil
+		}
+		return []PatchOperation{{Op: "replace", Path: path, Value: newValue}}
+	}
+
+	keys := slices.Sorted(maps.Keys(oldMap))
+	for key := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var operations []PatchOperation
+	for _, key := range keys {
+		memberPath := path + "/" + escapePointerToken(key)
+		oldMember, inOld := oldMap[key]
+		newMember, inNew := newMap[key]
+		switch {
+		case !inNew:
+			operations = append(operations, PatchOperation{Op: "remove", Path: memberPath})
+		case !inOld:
+			operations = append(operations, PatchOperation{Op: "add", Path: memberPath, Value: newMember})
+		default:
+			operations = append(operations, diffOperations(memberPath, oldMember, newMember)...)
+		}
+	}
+	return operations
+}
+
+// ApplyJSONPatch applies RFC 6902 JSON Patch operations to a copy of a JSON document decoded
+// into maps and slices, and returns the patched copy
+func ApplyJSONPatch(doc any, operations []PatchOperation) (any, error) {
+	patched, err := copyJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, operation := range operations {
+		tokens, err := parsePointer(operation.Path)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+
+		switch operation.Op {
+		case "add":
+			patched, err = setPointer(patched, tokens, operation.Value, true)
+		case "replace":
+			patched, err = setPointer(patched, tokens, operation.Value, false)
+		case "remove":
+			patched, err = removePointer(patched, tokens)
+		case "move":
+			patched, err = movePointer(patched, operation.From, tokens)
+		case "copy":
+			patched, err = copyPointer(patched, operation.From, tokens)
+		case "test":
+			err = testPointer(patched, tokens, operation.Value)
+		default:
+			err = fmt.Errorf("unsupported op %q", operation.Op)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, operation.Op, operation.Path, err)
+		}
+	}
+	return patched, nil
+}
+
+// movePointer removes the value at the from path of the document and adds it at the path
+func movePointer(doc any, from string, tokens []string) (any, error) {
+	fromTokens, err := parsePointer(from)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) > len(fromTokens) && slices.Equal(tokens[:len(fromTokens)], fromTokens) {
+		return nil, fmt.Errorf("cannot move %q into one of its children", from)
+	}
+
+	value, err := resolvePointer(doc, fromTokens)
+	if err != nil {
+		return nil, err
+	}
+	if doc, err = removePointer(doc, fromTokens); err != nil {
+		return nil, err
+	}
+	return setPointer(doc, tokens, value, true)
+}
+
+// copyPointer adds a copy of the value at the from path of the document at the path
+func copyPointer(doc any, from string, tokens []string) (any, error) {
+	fromTokens, err := parsePointer(from)
+	if err != nil {
+		return nil, err
+	}
+	value, err := resolvePointer(doc, fromTokens)
+	if err != nil {
+		return nil, err
+	}
+	if value, err = copyJSON(value); err != nil {
+		return nil, err
+	}
+	return setPointer(doc, tokens, value, true)
+}
+
+// testPointer checks that the value at the path of the document equals the given value
+func testPointer(doc any, tokens []string, want any) error {
+	value, err := resolvePointer(doc, tokens)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(value, want) {
+		return fmt.Errorf("value is %s, expected %s", formatJSON(value), formatJSON(want))
+	}
+	return nil
+}
+
+// formatJSON formats a value for an error message
+func formatJSON(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// ApplyMergePatch applies an RFC 7386 JSON Merge Patch to a copy of a JSON document decoded
+// into maps and slices, and returns the patched copy
+func ApplyMergePatch(doc any, patch any) (any, error) {
+	patched, err := copyJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+	return mergePatch(patched, patch), nil
+}
+
+// mergePatch merges the patch into the target: members of objects are merged recursively, null
+// members are removed and any other value replaces the target
+func mergePatch(target any, patch any) any {
+	patchMap, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetMap, ok := target.(map[string]any)
+	if !ok {
+		targetMap = map[string]any{}
+	}
+	for key, value := range patchMap {
+		if value == nil {
+			delete(targetMap, key)
+			continue
+		}
+		targetMap[key] = mergePatch(targetMap[key], value)
+	}
+	return targetMap
+}
+
+// ApplyPatch applies a patch to a copy of a JSON document decoded into maps and slices, and
+// returns the patched copy. The patch is an RFC 6902 JSON Patch if it is an array of operations,
+// or an RFC 7386 JSON Merge Patch if it is an object.
+func ApplyPatch(doc any, patch []byte) (any, error) {
+	var decoded any
+	if err := json.Unmarshal(patch, &decoded); err != nil {
+		return nil, fmt.Errorf("error unmarshaling patch: %w", err)
+	}
+
+	switch decoded.(type) {
+	case []any:
+		var operations []PatchOperation
+		if err := json.Unmarshal(patch, &operations); err != nil {
+			return nil, fmt.Errorf("invalid JSON Patch: %w", err)
+		}
+		return ApplyJSONPatch(doc, operations)
+	case map[string]any:
+		return ApplyMergePatch(doc, decoded)
+	default:
+		return nil, fmt.Errorf("invalid patch: expected a JSON Patch array of operations or a JSON Merge Patch object")
+	}
+}
+
+// copyJSON returns a deep copy of a JSON document decoded into maps and slices
+func copyJSON(doc any) (any, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("error copying document: %w", err)
+	}
+	var copied any
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return nil, fmt.Errorf("error copying document: %w", err)
+	}
+	return copied, nil
+}
+
+// escapePointerToken escapes a member name for use in an RFC 6901 JSON Pointer
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into its unescaped reference tokens
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with /", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// setPointer sets the value at the path of the document. With add, a member is created if it
+// doesn't exist and a value is inserted into an array; otherwise the value must exist.
+func setPointer(doc any, tokens []string, value any, add bool) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	parent, err := resolvePointer(doc, tokens[:len(tokens)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := tokens[len(tokens)-1]
+
+	switch container := parent.(type) {
+	case map[string]any:
+		if _, ok := container[last]; !ok && !add {
+			return nil, fmt.Errorf("member %q does not exist", last)
+		}
+		container[last] = value
+		return doc, nil
+	case []any:
+		if add {
+			index := len(container)
+			if last != "-" {
+				if index, err = arrayIndex(last, len(container)+1); err != nil {
+					return nil, err
+				}
+			}
+			return replaceParent(doc, tokens[:len(tokens)-1], slices.Insert(container, index, value))
+		}
+		index, err := arrayIndex(last, len(container))
+		if err != nil {
+			return nil, err
+		}
+		container[index] = value
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("cannot set %q of a value that is not an object or array", last)
+	}
+}
+
+// removePointer removes the value at the path of the document
+func removePointer(doc any, tokens []string) (any, error) {
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("cannot remove the whole document")
+	}
+
+	parent, err := resolvePointer(doc, tokens[:len(tokens)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := tokens[len(tokens)-1]
+
+	switch container := parent.(type) {
+	case map[string]any:
+		if _, ok := container[last]; !ok {
+			return nil, fmt.Errorf("member %q does not exist", last)
+		}
+		delete(container, last)
+		return doc, nil
+	case []any:
+		index, err := arrayIndex(last, len(container))
+		if err != nil {
+			return nil, err
+		}
+		return replaceParent(doc, tokens[:len(tokens)-1], slices.Delete(container, index, index+1))
+	default:
+		return nil, fmt.Errorf("cannot remove %q of a value that is not an object or array", last)
+	}
+}
+
+// replaceParent replaces the array at the path of the document, whose length was changed
+func replaceParent(doc any, tokens []string, array []any) (any, error) {
+	return setPointer(doc, tokens, array, false)
+}
+
+// resolvePointer returns the value at the path of the document
+func resolvePointer(doc any, tokens []string) (any, error) {
+	current := doc
+	for _, token := range tokens {
+		switch container := current.(type) {
+		case map[string]any:
+			value, ok := container[token]
+			if !ok {
+				return nil, fmt.Errorf("member %q does not exist", token)
+			}
+			current = value
+		case []any:
+			index, err := arrayIndex(token, len(container))
+			if err != nil {
+				return nil, err
+			}
+			current = container[index]
+		default:
+			return nil, fmt.Errorf("cannot resolve %q in a value that is not an object or array", token)
+		}
+	}
+	return current, nil
+}
+
+// arrayIndex parses an array index of a JSON pointer, which must be less than size
+func arrayIndex(token string, size int) (int, error) {
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index >= size || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	return index, nil
+}
@@ -0,0 +1,171 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPatch(t *testing.T) {
+	oldManifest := &Manifest{
+		Flags: map[string]any{
+			"a/b": map[string]any{"flagType": "boolean", "defaultValue": true, "description": "Slash in the key"},
+			"changed": map[string]any{
+				"flagType":     "object",
+				"defaultValue": map[string]any{"color": "red", "size": 1.0},
+				"description":  "Old description",
+				"tags":         []any{"web"},
+			},
+			"removed": map[string]any{"flagType": "string", "defaultValue": "x"},
+		},
+	}
+	newManifest := &Manifest{
+		Flags: map[string]any{
+			"a/b": map[string]any{"flagType": "boolean", "defaultValue": false, "description": "Slash in the key"},
+			"changed": map[string]any{
+				"flagType":     "object",
+				"defaultValue": map[string]any{"color": "blue", "shape": "round"},
+				"description":  "New description",
+			},
+			"added": map[string]any{"flagType": "integer", "defaultValue": 3.0},
+		},
+	}
+
+	changes, err := Compare(oldManifest, newManifest, CompareOptions{IgnorePatterns: []string{"description"}})
+	require.NoError(t, err)
+
+	operations := JSONPatch(changes)
+	assert.Equal(t, []PatchOperation{
+		{Op: "replace", Path: "/flags/a~1b/defaultValue", Value: false},
+		{Op: "add", Path: "/flags/added", Value: map[string]any{"flagType": "integer", "defaultValue": 3.0}},
+		{Op: "replace", Path: "/flags/changed/defaultValue/color", Value: "blue"},
+		{Op: "add", Path: "/flags/changed/defaultValue/shape", Value: "round"},
+		{Op: "remove", Path: "/flags/changed/defaultValue/size"},
+		{Op: "remove", Path: "/flags/changed/tags"},
+		{Op: "remove", Path: "/flags/removed"},
+	}, operations)
+
+	t.Run("applying the patch leaves ignored fields as they are", func(t *testing.T) {
+		patched, err := ApplyJSONPatch(map[string]any{"flags": oldManifest.Flags}, operations)
+		require.NoError(t, err)
+
+		flags := patched.(map[string]any)["flags"].(map[string]any)
+		assert.Equal(t, map[string]any{
+			"flagType":     "object",
+			"defaultValue": map[string]any{"color": "blue", "shape": "round"},
+			"description":  "Old description",
+		}, flags["changed"])
+		assert.NotContains(t, flags, "removed")
+		assert.Contains(t, flags, "added")
+
+		// The original document is not modified
+		assert.Contains(t, oldManifest.Flags, "removed")
+	})
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	doc := map[string]any{"tags": []any{"a", "c"}, "name": "x"}
+
+	patched, err := ApplyJSONPatch(doc, []PatchOperation{
+		{Op: "add", Path: "/tags/1", Value: "b"},
+		{Op: "add", Path: "/tags/-", Value: "d"},
+		{Op: "remove", Path: "/tags/0"},
+		{Op: "replace", Path: "/name", Value: "y"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"tags": []any{"b", "c", "d"}, "name": "y"}, patched)
+
+	tests := []struct {
+		name      string
+		operation PatchOperation
+		wantErr   string
+	}{
+		{"replace of a missing member", PatchOperation{Op: "replace", Path: "/missing", Value: 1}, `member "missing" does not exist`},
+		{"remove of a missing member", PatchOperation{Op: "remove", Path: "/missing"}, `member "missing" does not exist`},
+		{"index out of range", PatchOperation{Op: "remove", Path: "/tags/5"}, `invalid array index "5"`},
+		{"path without a leading slash", PatchOperation{Op: "remove", Path: "name"}, "must start with /"},
+		{"unknown op", PatchOperation{Op: "rename", Path: "/name"}, `unsupported op "rename"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ApplyJSONPatch(doc, []PatchOperation{tt.operation})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestApplyJSONPatchMoveCopyTest(t *testing.T) {
+	doc := map[string]any{"flags": map[string]any{"old": map[string]any{"defaultValue": true}}}
+
+	patched, err := ApplyJSONPatch(doc, []PatchOperation{
+		{Op: "test", Path: "/flags/old/defaultValue", Value: true},
+		{Op: "copy", From: "/flags/old", Path: "/flags/copy"},
+		{Op: "move", From: "/flags/old", Path: "/flags/new"},
+		{Op: "replace", Path: "/flags/copy/defaultValue", Value: false},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"flags": map[string]any{
+		"copy": map[string]any{"defaultValue": false},
+		"new":  map[string]any{"defaultValue": true},
+	}}, patched)
+
+	tests := []struct {
+		name      string
+		operation PatchOperation
+		wantErr   string
+	}{
+		{"failed test", PatchOperation{Op: "test", Path: "/flags/old/defaultValue", Value: false}, "value is true, expected false"},
+		{"move into a child", PatchOperation{Op: "move", From: "/flags", Path: "/flags/old/flags"}, `cannot move "/flags" into one of its children`},
+		{"copy of a missing member", PatchOperation{Op: "copy", From: "/flags/missing", Path: "/flags/new"}, `member "missing" does not exist`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ApplyJSONPatch(doc, []PatchOperation{tt.operation})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	doc := map[string]any{
+		"flags": map[string]any{
+			"a": map[string]any{"defaultValue": map[string]any{"color": "red", "size": 1.0}, "tags": []any{"web"}},
+			"b": map[string]any{"defaultValue": "x"},
+		},
+	}
+
+	patched, err := ApplyMergePatch(doc, map[string]any{
+		"flags": map[string]any{
+			"a": map[string]any{"defaultValue": map[string]any{"size": nil, "shape": "round"}, "tags": []any{"mobile"}},
+			"b": nil,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"flags": map[string]any{
+			"a": map[string]any{"defaultValue": map[string]any{"color": "red", "shape": "round"}, "tags": []any{"mobile"}},
+		},
+	}, patched)
+
+	// The original document is not modified
+	assert.Contains(t, doc["flags"], "b")
+}
+
+func TestApplyPatch(t *testing.T) {
+	doc := map[string]any{"name": "x"}
+
+	patched, err := ApplyPatch(doc, []byte(`[{"op": "replace", "path": "/name", "value": "y"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"name": "y"}, patched)
+
+	patched, err = ApplyPatch(doc, []byte(`{"name": "z"}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"name": "z"}, patched)
+
+	_, err = ApplyPatch(doc, []byte(`42`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid patch")
+}
@@ -0,0 +1,58 @@
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+)
+
+// ResolveDefaultValueFiles loads the default value of each object flag that references an external
+// file (defaultValueFile), resolving the path relative to manifestDir. The hash of the file is recorded
+// on the flag; a warning is logged if it differs from the hash pinned in the manifest.
+func ResolveDefaultValueFiles(fs *flagset.Flagset, manifestDir string) error {
+	for i := range fs.Flags {
+		flag := &fs.Flags[i]
+		if flag.DefaultValueFile == "" {
+			continue
+		}
+		if flag.DefaultValue != nil {
+			return fmt.Errorf("flag %s sets both defaultValue and defaultValueFile", flag.Key)
+		}
+		if flag.Type != flagset.ObjectType {
+			return fmt.Errorf("flag %s: defaultValueFile is only supported for object flags", flag.Key)
+		}
+
+		path := flag.DefaultValueFile
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(manifestDir, path)
+		}
+		data, err := filesystem.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("flag %s: error reading default value file %q: %w", flag.Key, flag.DefaultValueFile, err)
+		}
+
+		sum := sha256.Sum256(data)
+		hash := hex.EncodeToString(sum[:])
+		if flag.DefaultValueSha256 != "" && flag.DefaultValueSha256 != hash {
+			logger.Default.Warning(fmt.Sprintf("Default value file %q of flag %s has changed since its hash was recorded",
+				flag.DefaultValueFile, flag.Key))
+		}
+
+		value, err := flagset.DecodeDefaultValue(flagset.ObjectType, data)
+		if err != nil {
+			return fmt.Errorf("flag %s: error parsing default value file %q: %w", flag.Key, flag.DefaultValueFile, err)
+		}
+		if _, ok := value.(map[string]any); !ok {
+			return fmt.Errorf("flag %s: default value file %q must contain a JSON object", flag.Key, flag.DefaultValueFile)
+		}
+
+		flag.DefaultValue = value
+		flag.DefaultValueSha256 = hash
+	}
+	return nil
+}
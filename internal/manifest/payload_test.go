@@ -0,0 +1,73 @@
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultValueFile(t *testing.T) {
+	payload := []byte(`{"currency": "EUR", "tiers": [{"name": "basic", "price": 10}]}`)
+	sum := sha256.Sum256(payload)
+	hash := hex.EncodeToString(sum[:])
+
+	setup := func(t *testing.T, manifest string, payload []byte) {
+		fs := afero.NewMemMapFs()
+		filesystem.SetFileSystem(fs)
+		require.NoError(t, afero.WriteFile(fs, "config/flags.json", []byte(manifest), 0o644))
+		require.NoError(t, afero.WriteFile(fs, "config/payloads/pricing.json", payload, 0o644))
+	}
+
+	t.Run("resolves the default value relative to the manifest", func(t *testing.T) {
+		setup(t, `{"flags": {"pricing": {"flagType": "object", "defaultValueFile": "./payloads/pricing.json"}}}`, payload)
+
+		fs, err := LoadFlagSet("config/flags.json")
+		require.NoError(t, err)
+		require.Len(t, fs.Flags, 1)
+		assert.Equal(t, "EUR", fs.Flags[0].DefaultValue.(map[string]any)["currency"])
+		assert.Equal(t, hash, fs.Flags[0].DefaultValueSha256)
+
+		entry := Entry(fs.Flags[0])
+		assert.NotContains(t, entry, "defaultValue")
+		assert.Equal(t, "./payloads/pricing.json", entry["defaultValueFile"])
+		assert.Equal(t, hash, entry["defaultValueSha256"])
+	})
+
+	t.Run("loads a file whose hash changed", func(t *testing.T) {
+		setup(t, `{"flags": {"pricing": {"flagType": "object", "defaultValueFile": "payloads/pricing.json",
+			"defaultValueSha256": "`+hash+`"}}}`, []byte(`{"currency": "USD"}`))
+
+		fs, err := LoadFlagSet("config/flags.json")
+		require.NoError(t, err)
+		assert.Equal(t, "USD", fs.Flags[0].DefaultValue.(map[string]any)["currency"])
+		assert.NotEqual(t, hash, fs.Flags[0].DefaultValueSha256)
+	})
+
+	t.Run("fails if the file is not a JSON object", func(t *testing.T) {
+		setup(t, `{"flags": {"pricing": {"flagType": "object", "defaultValueFile": "payloads/pricing.json"}}}`, []byte(`[1, 2]`))
+
+		_, err := LoadFlagSet("config/flags.json")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must contain a JSON object")
+	})
+
+	t.Run("fails if both defaultValue and defaultValueFile are set", func(t *testing.T) {
+		setup(t, `{"flags": {"pricing": {"flagType": "object", "defaultValue": {}, "defaultValueFile": "payloads/pricing.json"}}}`, payload)
+
+		_, err := LoadFlagSet("config/flags.json")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "sets both defaultValue and defaultValueFile")
+	})
+
+	t.Run("requires a default value", func(t *testing.T) {
+		issues, err := Validate([]byte(`{"flags": {"pricing": {"flagType": "object"}}}`))
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		assert.Equal(t, "defaultValue is required", issues[0].Message)
+	})
+}
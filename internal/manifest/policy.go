@@ -0,0 +1,255 @@
+package manifest
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/api/retry"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/open-feature/cli/internal/logger"
+)
+
+// policySignatureSuffix is appended to the URL of a policy bundle to get the URL of its signature
+const policySignatureSuffix = ".sig"
+
+// Policy is an organization-wide bundle of flag presets and governance rules,
+// fetched from a central endpoint so that rules can be rolled out without changing every repository
+type Policy struct {
+	// Presets are flag presets available to manifest add, in the same form as the presets block of the config
+	Presets map[string]map[string]any `json:"presets,omitempty"`
+	// Rules are the rules every flag must follow
+	Rules PolicyRules `json:"rules"`
+}
+
+// PolicyRules are the governance rules of a policy
+type PolicyRules struct {
+	// RequireDescription requires every flag to have a description
+	RequireDescription bool `json:"requireDescription,omitempty"`
+	// RequireExpiry requires every flag to have an activeUntil time
+	RequireExpiry bool `json:"requireExpiry,omitempty"`
+	// RequireVisibility requires every flag to be classified as client, server or both
+	RequireVisibility bool `json:"requireVisibility,omitempty"`
+	// KeyPattern is a regular expression every flag key must match
+	KeyPattern string `json:"keyPattern,omitempty"`
+	// AllowedTypes restricts the types flags may have. All types are allowed if empty.
+	AllowedTypes []string `json:"allowedTypes,omitempty"`
+
+	keyPattern *regexp.Regexp
+}
+
+// policyCacheEntry is the on-disk representation of a cached policy bundle.
+// The bundle is kept as fetched so its signature can be checked again when it is read.
+type policyCacheEntry struct {
+	FetchedAt time.Time `json:"fetchedAt"`
+	Bundle    []byte    `json:"bundle"`
+	Signature []byte    `json:"signature,omitempty"`
+}
+
+// ParsePolicy parses and checks a policy bundle
+func ParsePolicy(data []byte) (*Policy, error) {
+	var policy Policy
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("invalid policy bundle: %w", err)
+	}
+
+	if policy.Rules.KeyPattern != "" {
+		pattern, err := regexp.Compile(policy.Rules.KeyPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid policy bundle: invalid keyPattern: %w", err)
+		}
+		policy.Rules.keyPattern = pattern
+	}
+	for _, t := range policy.Rules.AllowedTypes {
+		if _, err := flagset.ParseFlagType(t); err != nil {
+			return nil, fmt.Errorf("invalid policy bundle: unknown flag type %q in allowedTypes", t)
+		}
+	}
+
+	return &policy, nil
+}
+
+// LoadPolicy returns the policy bundle at the given URL. Bundles are cached and reused for the TTL.
+// If publicKey is set, the bundle must be signed with the corresponding Ed25519 private key; the
+// base64 encoded signature is fetched from the bundle URL with a .sig suffix. When the bundle cannot
+// be fetched, a previously cached bundle is used regardless of its age.
+func LoadPolicy(ctx context.Context, url string, publicKey string, ttl time.Duration) (*Policy, error) {
+	var key ed25519.PublicKey
+	if publicKey != "" {
+		decoded, err := base64.StdEncoding.DecodeString(publicKey)
+		if err != nil || len(decoded) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid policy public key: expected a base64 encoded Ed25519 public key")
+		}
+		key = decoded
+	}
+
+	path, err := policyCachePath(url)
+	if err != nil {
+		return nil, err
+	}
+
+	cached, hasCache := readPolicyCacheEntry(path, key)
+	if hasCache {
+		if age := time.Since(cached.FetchedAt); age < ttl {
+			logger.Default.Debug(fmt.Sprintf("Using policy cached %s ago for %s", age.Round(time.Second), url))
+			return ParsePolicy(cached.Bundle)
+		}
+	}
+
+	entry, err := fetchPolicy(ctx, url, key)
+	if err != nil {
+		var verifyErr *policySignatureError
+		if hasCache && !errors.As(err, &verifyErr) {
+			logger.Default.Warning(fmt.Sprintf("Failed to fetch policy, using cached copy from %s: %v",
+				cached.FetchedAt.Format(time.RFC3339), err))
+			return ParsePolicy(cached.Bundle)
+		}
+		return nil, err
+	}
+
+	policy, err := ParsePolicy(entry.Bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(entry)
+	if err == nil {
+		err = filesystem.WriteFile(path, data)
+	}
+	if err != nil {
+		logger.Default.Debug(fmt.Sprintf("Failed to cache policy for %s: %v", url, err))
+	}
+
+	return policy, nil
+}
+
+// Check returns the flags that violate the rules of the policy. A nil policy has no rules.
+func (p *Policy) Check(flags []flagset.Flag) []ValidationError {
+	if p == nil {
+		return nil
+	}
+
+	var issues []ValidationError
+	add := func(key string, message string) {
+		issues = append(issues, ValidationError{
+			Type:    "policy",
+			Path:    fmt.Sprintf("flags.%s", key),
+			Message: message,
+		})
+	}
+
+	rules := p.Rules
+	for _, flag := range flags {
+		if rules.keyPattern != nil && !rules.keyPattern.MatchString(flag.Key) {
+			add(flag.Key, fmt.Sprintf("flag key '%s' does not match the pattern %s required by the policy", flag.Key, rules.KeyPattern))
+		}
+		if len(rules.AllowedTypes) > 0 && !slices.ContainsFunc(rules.AllowedTypes, func(t string) bool {
+			allowed, _ := flagset.ParseFlagType(t)
+			return allowed == flag.Type
+		}) {
+			add(flag.Key, fmt.Sprintf("flag '%s' has type %s, but the policy only allows %s",
+				flag.Key, flag.Type, strings.Join(rules.AllowedTypes, ", ")))
+		}
+		if rules.RequireDescription && strings.TrimSpace(flag.Description) == "" {
+			add(flag.Key, fmt.Sprintf("flag '%s' requires a description by policy", flag.Key))
+		}
+		if rules.RequireExpiry && flag.ActiveUntil == nil {
+			add(flag.Key, fmt.Sprintf("flag '%s' requires an 'activeUntil' time by policy", flag.Key))
+		}
+		if rules.RequireVisibility && flag.Visibility == "" {
+			add(flag.Key, fmt.Sprintf("flag '%s' requires a 'visibility' (client, server or both) by policy", flag.Key))
+		}
+	}
+
+	return issues
+}
+
+// policySignatureError is returned when a fetched policy bundle is not signed with the configured key
+type policySignatureError struct {
+	url string
+}
+
+func (e *policySignatureError) Error() string {
+	return fmt.Sprintf("policy bundle %s is not signed with the configured public key", e.url)
+}
+
+// fetchPolicy downloads the policy bundle at the given URL, and its signature if a key is given
+func fetchPolicy(ctx context.Context, url string, key ed25519.PublicKey) (policyCacheEntry, error) {
+	logger.Default.Debug(fmt.Sprintf("Fetching policy from %s", url))
+
+	entry := policyCacheEntry{FetchedAt: time.Now()}
+	bundle, err := downloadPolicyFile(ctx, url)
+	if err != nil {
+		return entry, err
+	}
+	entry.Bundle = bundle
+
+	if key != nil {
+		encoded, err := downloadPolicyFile(ctx, url+policySignatureSuffix)
+		if err != nil {
+			return entry, err
+		}
+		signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encoded)))
+		if err != nil || !ed25519.Verify(key, bundle, signature) {
+			return entry, &policySignatureError{url: url}
+		}
+		entry.Signature = signature
+	}
+
+	return entry, nil
+}
+
+// downloadPolicyFile fetches the file at the given URL
+func downloadPolicyFile(ctx context.Context, url string) ([]byte, error) {
+	resp, err := retry.Get(ctx, retry.NewHTTPClient(), url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching policy %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error fetching policy %s: unexpected status %s", url, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading policy %s: %w", url, err)
+	}
+	return data, nil
+}
+
+// policyCachePath returns the path the policy bundle at the given URL is cached at
+func policyCachePath(url string) (string, error) {
+	sum := sha256.Sum256([]byte(url))
+	return filesystem.CachePath("policy", hex.EncodeToString(sum[:])+".json")
+}
+
+// readPolicyCacheEntry reads the cached policy bundle at path, returning false if it is missing,
+// invalid, or not signed with the given key
+func readPolicyCacheEntry(path string, key ed25519.PublicKey) (policyCacheEntry, bool) {
+	data, err := filesystem.ReadFile(path)
+	if err != nil {
+		return policyCacheEntry{}, false
+	}
+
+	var entry policyCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Bundle == nil {
+		return policyCacheEntry{}, false
+	}
+	if key != nil && !ed25519.Verify(key, entry.Bundle, entry.Signature) {
+		return policyCacheEntry{}, false
+	}
+	return entry, true
+}
@@ -0,0 +1,112 @@
+package manifest
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const policyURL = "https://platform.example.com/openfeature/policy.json"
+
+func TestPolicyCheck(t *testing.T) {
+	policy, err := ParsePolicy([]byte(`{"rules": {
+		"requireDescription": true,
+		"requireExpiry": true,
+		"requireVisibility": true,
+		"keyPattern": "^[a-z][a-z0-9-]*$",
+		"allowedTypes": ["boolean", "string"]
+	}}`))
+	require.NoError(t, err)
+
+	until := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	issues := policy.Check([]flagset.Flag{
+		{Key: "new-checkout", Type: flagset.BoolType, Description: "New checkout", ActiveUntil: &until, Visibility: flagset.VisibilityClient},
+		{Key: "NewCheckout", Type: flagset.ObjectType},
+	})
+
+	var messages []string
+	for _, issue := range issues {
+		assert.Equal(t, "policy", issue.Type)
+		assert.Equal(t, "flags.NewCheckout", issue.Path)
+		messages = append(messages, issue.Message)
+	}
+	assert.Equal(t, []string{
+		"flag key 'NewCheckout' does not match the pattern ^[a-z][a-z0-9-]*$ required by the policy",
+		"flag 'NewCheckout' has type object, but the policy only allows boolean, string",
+		"flag 'NewCheckout' requires a description by policy",
+		"flag 'NewCheckout' requires an 'activeUntil' time by policy",
+		"flag 'NewCheckout' requires a 'visibility' (client, server or both) by policy",
+	}, messages)
+
+	var none *Policy
+	assert.Empty(t, none.Check([]flagset.Flag{{Key: "NewCheckout"}}))
+
+	_, err = ParsePolicy([]byte(`{"rules": {"keyPattern": "("}}`))
+	assert.ErrorContains(t, err, "invalid keyPattern")
+	_, err = ParsePolicy([]byte(`{"rules": {"allowedTypes": ["date"]}}`))
+	assert.ErrorContains(t, err, `unknown flag type "date"`)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	encodedKey := base64.StdEncoding.EncodeToString(publicKey)
+
+	bundle := `{"rules": {"requireDescription": true}}`
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte(bundle)))
+
+	t.Run("fetches a signed bundle and reuses it from the cache", func(t *testing.T) {
+		filesystem.SetFileSystem(afero.NewMemMapFs())
+		defer gock.Off()
+		gock.New("https://platform.example.com").Get("/openfeature/policy.json").Reply(200).BodyString(bundle)
+		gock.New("https://platform.example.com").Get("/openfeature/policy.json.sig").Reply(200).BodyString(signature)
+
+		policy, err := LoadPolicy(context.Background(), policyURL, encodedKey, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, policy.Rules.RequireDescription)
+		assert.True(t, gock.IsDone())
+
+		// No requests are mocked anymore, so the bundle must come from the cache
+		policy, err = LoadPolicy(context.Background(), policyURL, encodedKey, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, policy.Rules.RequireDescription)
+	})
+
+	t.Run("rejects a bundle with an invalid signature", func(t *testing.T) {
+		filesystem.SetFileSystem(afero.NewMemMapFs())
+		defer gock.Off()
+		gock.New("https://platform.example.com").Get("/openfeature/policy.json").Reply(200).BodyString(`{"rules": {}}`)
+		gock.New("https://platform.example.com").Get("/openfeature/policy.json.sig").Reply(200).BodyString(signature)
+
+		_, err := LoadPolicy(context.Background(), policyURL, encodedKey, time.Hour)
+		assert.ErrorContains(t, err, "is not signed with the configured public key")
+	})
+
+	t.Run("uses an expired cached bundle when the bundle cannot be fetched", func(t *testing.T) {
+		filesystem.SetFileSystem(afero.NewMemMapFs())
+		defer gock.Off()
+		gock.New("https://platform.example.com").Get("/openfeature/policy.json").Reply(200).BodyString(bundle)
+
+		_, err := LoadPolicy(context.Background(), policyURL, "", time.Hour)
+		require.NoError(t, err)
+
+		gock.New("https://platform.example.com").Get("/openfeature/policy.json").Reply(404)
+		policy, err := LoadPolicy(context.Background(), policyURL, "", 0)
+		require.NoError(t, err)
+		assert.True(t, policy.Rules.RequireDescription)
+	})
+
+	t.Run("rejects an invalid public key", func(t *testing.T) {
+		_, err := LoadPolicy(context.Background(), policyURL, "not-a-key", time.Hour)
+		assert.ErrorContains(t, err, "invalid policy public key")
+	})
+}
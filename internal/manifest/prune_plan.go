@@ -0,0 +1,153 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/spf13/afero"
+)
+
+// Age groups of the flags in a prune plan, based on when the provider last updated them
+const (
+	PruneAgeRecent  = "< 30 days"
+	PruneAgeStale   = "30-90 days"
+	PruneAgeOld     = "> 90 days"
+	PruneAgeUnknown = "unknown"
+)
+
+// pruneAgeMetadataFields are the provider metadata fields checked, in order, for the age of a remote flag
+var pruneAgeMetadataFields = []string{"updatedAt", "createdAt"}
+
+// PrunePlan is a reviewable list of the remote flags that are not in the manifest, per target.
+// It is written by flags prune-plan and restricts what push --prune deletes.
+type PrunePlan struct {
+	CreatedAt time.Time         `json:"createdAt"`
+	Manifest  string            `json:"manifest"`
+	Targets   []PrunePlanTarget `json:"targets"`
+}
+
+// PrunePlanTarget is the remote flags of a target that are not in the manifest
+type PrunePlanTarget struct {
+	Name        string          `json:"name"`
+	ProviderURL string          `json:"providerUrl"`
+	Flags       []PrunePlanFlag `json:"flags"`
+}
+
+// PrunePlanFlag is a remote flag planned for deletion
+type PrunePlanFlag struct {
+	Key       string     `json:"key"`
+	Type      string     `json:"type"`
+	Age       string     `json:"age"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// PlanPrune compares the local flags with the remote flags of a target and returns the remote
+// flags that are not in the manifest, sorted by key, along with their age group as of now
+func PlanPrune(local *flagset.Flagset, remote *flagset.Flagset, now time.Time) ([]PrunePlanFlag, error) {
+	changes, err := Compare(toManifest(local), toManifest(remote), CompareOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	remoteFlags := make(map[string]flagset.Flag, len(remote.Flags))
+	for _, flag := range remote.Flags {
+		remoteFlags[flag.Key] = flag
+	}
+
+	flags := []PrunePlanFlag{}
+	for _, change := range changes {
+		if change.Type != "add" {
+			continue
+		}
+		flag := remoteFlags[strings.TrimPrefix(change.Path, "flags.")]
+		planned := PrunePlanFlag{Key: flag.Key, Type: flag.Type.String(), Age: PruneAgeUnknown}
+		if updatedAt, ok := remoteUpdatedAt(flag); ok {
+			planned.UpdatedAt = &updatedAt
+			planned.Age = pruneAge(now.Sub(updatedAt))
+		}
+		flags = append(flags, planned)
+	}
+
+	sort.Slice(flags, func(i, j int) bool {
+		return flags[i].Key < flags[j].Key
+	})
+	return flags, nil
+}
+
+// toManifest converts flags to a manifest, for comparison
+func toManifest(flags *flagset.Flagset) *Manifest {
+	m := &Manifest{Flags: make(map[string]any, len(flags.Flags))}
+	for _, flag := range flags.Flags {
+		m.Flags[flag.Key] = Entry(flag)
+	}
+	return m
+}
+
+// remoteUpdatedAt returns when the provider last updated the flag, if it reports it in the metadata
+func remoteUpdatedAt(flag flagset.Flag) (time.Time, bool) {
+	for _, field := range pruneAgeMetadataFields {
+		if value, ok := flag.Metadata[field].(string); ok {
+			if t, err := time.Parse(time.RFC3339, value); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// pruneAge returns the age group of a flag last updated age ago
+func pruneAge(age time.Duration) string {
+	days := age.Hours() / 24
+	switch {
+	case days < 30:
+		return PruneAgeRecent
+	case days <= 90:
+		return PruneAgeStale
+	default:
+		return PruneAgeOld
+	}
+}
+
+// WritePrunePlan writes the prune plan to the given path as JSON
+func WritePrunePlan(path string, plan PrunePlan) error {
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling prune plan: %w", err)
+	}
+	return filesystem.WriteFile(path, append(data, '\n'))
+}
+
+// LoadPrunePlan reads a prune plan written by WritePrunePlan
+func LoadPrunePlan(path string) (*PrunePlan, error) {
+	data, err := afero.ReadFile(filesystem.FileSystem(), path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading prune plan: %w", err)
+	}
+
+	var plan PrunePlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("error parsing prune plan %s: %w", path, err)
+	}
+	return &plan, nil
+}
+
+// Keys returns the keys of the flags planned for deletion from the provider URL.
+// Returns false if the plan has no target with the provider URL.
+func (p *PrunePlan) Keys(providerURL string) (map[string]bool, bool) {
+	for _, target := range p.Targets {
+		if strings.TrimSuffix(target.ProviderURL, "/") != strings.TrimSuffix(providerURL, "/") {
+			continue
+		}
+		keys := make(map[string]bool, len(target.Flags))
+		for _, flag := range target.Flags {
+			keys[flag.Key] = true
+		}
+		return keys, true
+	}
+	return nil, false
+}
@@ -0,0 +1,52 @@
+package manifest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanPrune(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	local := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "kept", Type: flagset.BoolType, DefaultValue: true},
+	}}
+	remote := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "kept", Type: flagset.BoolType, DefaultValue: false},
+		{Key: "recent", Type: flagset.BoolType, DefaultValue: true, Metadata: map[string]any{"updatedAt": "2026-05-20T00:00:00Z"}},
+		{Key: "old", Type: flagset.StringType, DefaultValue: "a", Metadata: map[string]any{"createdAt": "2025-01-01T00:00:00Z"}},
+		{Key: "stale", Type: flagset.IntType, DefaultValue: int64(1), Metadata: map[string]any{"updatedAt": "2026-04-01T00:00:00Z"}},
+		{Key: "undated", Type: flagset.BoolType, DefaultValue: true},
+	}}
+
+	flags, err := PlanPrune(local, remote, now)
+	require.NoError(t, err)
+	require.Len(t, flags, 4)
+
+	assert.Equal(t, "old", flags[0].Key)
+	assert.Equal(t, "string", flags[0].Type)
+	assert.Equal(t, PruneAgeOld, flags[0].Age)
+	assert.Equal(t, "recent", flags[1].Key)
+	assert.Equal(t, PruneAgeRecent, flags[1].Age)
+	assert.Equal(t, "stale", flags[2].Key)
+	assert.Equal(t, PruneAgeStale, flags[2].Age)
+	assert.Equal(t, "undated", flags[3].Key)
+	assert.Equal(t, PruneAgeUnknown, flags[3].Age)
+	assert.Nil(t, flags[3].UpdatedAt)
+}
+
+func TestPrunePlanKeys(t *testing.T) {
+	plan := PrunePlan{Targets: []PrunePlanTarget{
+		{Name: "prod", ProviderURL: "https://flags.example.com/", Flags: []PrunePlanFlag{{Key: "a"}, {Key: "b"}}},
+	}}
+
+	keys, ok := plan.Keys("https://flags.example.com")
+	require.True(t, ok)
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, keys)
+
+	_, ok = plan.Keys("https://flags.staging.example.com")
+	assert.False(t, ok)
+}
@@ -0,0 +1,243 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// ObjectDefaultsRedaction is how the default values of object flags are redacted
+type ObjectDefaultsRedaction string
+
+// Redactions of the default values of object flags
+const (
+	// ObjectDefaultsMask keeps the members of object defaults and replaces their values with placeholders
+	ObjectDefaultsMask ObjectDefaultsRedaction = "mask"
+	// ObjectDefaultsStrip replaces object defaults with an empty object
+	ObjectDefaultsStrip ObjectDefaultsRedaction = "strip"
+	// ObjectDefaultsKeep leaves object defaults as they are
+	ObjectDefaultsKeep ObjectDefaultsRedaction = "keep"
+)
+
+// RedactedString replaces the strings of masked object defaults
+const RedactedString = "[redacted]"
+
+// RedactedURL replaces internal URLs in descriptions
+const RedactedURL = "[internal URL]"
+
+// internalHostSuffixes are the domain suffixes of hosts that are only reachable on internal networks
+var internalHostSuffixes = []string{".internal", ".local", ".localdomain", ".corp", ".lan", ".intranet", ".home.arpa"}
+
+// urlPattern matches URLs in free text
+var urlPattern = regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://[^\s<>"'()\[\]]+`)
+
+// requiredEntryFields are the fields of a manifest entry that can't be stripped
+var requiredEntryFields = []string{"flagType", "defaultValue"}
+
+// RedactOptions configures the redaction of a manifest shared outside the organization
+type RedactOptions struct {
+	// ObjectDefaults is how the default values of object flags are redacted. Defaults to mask.
+	ObjectDefaults ObjectDefaultsRedaction
+	// InternalDomains are the domains whose URLs, including those of their subdomains, are removed from
+	// descriptions, in addition to localhost, private addresses and hosts such as wiki.corp or jira.internal
+	InternalDomains []string
+	// Fields are the fields removed from every flag, as dot-separated paths of the manifest entry
+	// (e.g., compliance, metadata.owner)
+	Fields []string
+}
+
+// IsValidObjectDefaultsRedaction checks if the redaction of object defaults is supported
+func IsValidObjectDefaultsRedaction(redaction string) bool {
+	return slices.Contains(GetValidObjectDefaultsRedactions(), redaction)
+}
+
+// GetValidObjectDefaultsRedactions returns the supported redactions of object defaults
+func GetValidObjectDefaultsRedactions() []string {
+	return []string{string(ObjectDefaultsMask), string(ObjectDefaultsStrip), string(ObjectDefaultsKeep)}
+}
+
+// Export converts the flagset to the contents of a standalone manifest. Default values kept in
+// external files are inlined, so the manifest can be shared without them. With redact options,
+// sensitive fields are stripped or masked.
+func Export(fs *flagset.Flagset, redact *RedactOptions) ([]byte, error) {
+	if redact != nil {
+		opts := *redact
+		if opts.ObjectDefaults == "" {
+			opts.ObjectDefaults = ObjectDefaultsMask
+		}
+		redact = &opts
+		if !IsValidObjectDefaultsRedaction(string(redact.ObjectDefaults)) {
+			return nil, fmt.Errorf("invalid redaction of object defaults: %q. Valid redactions are: %s",
+				redact.ObjectDefaults, strings.Join(GetValidObjectDefaultsRedactions(), ", "))
+		}
+		for _, field := range redact.Fields {
+			if slices.Contains(requiredEntryFields, field) {
+				return nil, fmt.Errorf("cannot strip the required field %q of flags", field)
+			}
+		}
+	}
+
+	flags := make(map[string]any)
+	for _, flag := range fs.Flags {
+		flag.DefaultValueFile = ""
+		flag.DefaultValueSha256 = ""
+		if redact != nil {
+			flag = redactFlag(flag, *redact)
+		}
+
+		entry := Entry(flag)
+		if redact != nil {
+			for _, field := range redact.Fields {
+				entry = stripField(entry, strings.Split(field, "."))
+			}
+		}
+		flags[flag.Key] = entry
+	}
+
+	m := createInitManifest(flags)
+	m.Timezone = fs.Timezone
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling manifest: %w", err)
+	}
+	return data, nil
+}
+
+// redactFlag returns a copy of the flag with its object default and the internal URLs of its descriptions redacted
+func redactFlag(flag flagset.Flag, opts RedactOptions) flagset.Flag {
+	flag.Description = redactURLs(flag.Description, opts.InternalDomains)
+	if len(flag.Descriptions) > 0 {
+		descriptions := make(map[string]string, len(flag.Descriptions))
+		for locale, description := range flag.Descriptions {
+			descriptions[locale] = redactURLs(description, opts.InternalDomains)
+		}
+		flag.Descriptions = descriptions
+	}
+
+	if flag.Type == flagset.ObjectType {
+		switch opts.ObjectDefaults {
+		case ObjectDefaultsMask:
+			flag.DefaultValue = maskValue(flag.DefaultValue)
+		case ObjectDefaultsStrip:
+			flag.DefaultValue = map[string]any{}
+		}
+	}
+	return flag
+}
+
+// maskValue replaces the strings, numbers and booleans of a value with placeholders, keeping its structure
+func maskValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		masked := make(map[string]any, len(v))
+		for key, member := range v {
+			masked[key] = maskValue(member)
+		}
+		return masked
+	case []any:
+		masked := make([]any, len(v))
+		for i, item := range v {
+			masked[i] = maskValue(item)
+		}
+		return masked
+	case string:
+		return RedactedString
+	case bool:
+		return false
+	case nil:
+		return nil
+	default:
+		return 0
+	}
+}
+
+// redactURLs replaces the internal URLs of a text
+func redactURLs(text string, domains []string) string {
+	return urlPattern.ReplaceAllStringFunc(text, func(match string) string {
+		// Punctuation ending a sentence is not part of the URL
+		trimmed := strings.TrimRight(match, ".,;:!?")
+		if !isInternalURL(trimmed, domains) {
+			return match
+		}
+		return RedactedURL + match[len(trimmed):]
+	})
+}
+
+// isInternalURL checks if the host of a URL is only reachable on internal networks or belongs to one of the domains
+func isInternalURL(rawURL string, domains []string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return false
+	}
+
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimPrefix(domain, "."))
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast()
+	}
+	if host == "localhost" || !strings.Contains(host, ".") {
+		return true
+	}
+	return slices.ContainsFunc(internalHostSuffixes, func(suffix string) bool {
+		return strings.HasSuffix(host, suffix)
+	})
+}
+
+// stripField removes the field at the path from a manifest entry, copying the objects it changes
+// so that the values of the flag are left as they are
+func stripField(entry map[string]any, path []string) map[string]any {
+	value, ok := entry[path[0]]
+	if !ok {
+		return entry
+	}
+
+	stripped := maps.Clone(entry)
+	if len(path) == 1 {
+		delete(stripped, path[0])
+		return stripped
+	}
+
+	nested, ok := toObject(value)
+	if !ok {
+		return entry
+	}
+	nested = stripField(nested, path[1:])
+	if len(nested) == 0 {
+		delete(stripped, path[0])
+	} else {
+		stripped[path[0]] = nested
+	}
+	return stripped
+}
+
+// toObject converts a field of a manifest entry, such as compliance or experiment, to a JSON object
+func toObject(value any) (map[string]any, bool) {
+	if object, ok := value.(map[string]any); ok {
+		return object, true
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, false
+	}
+	var object map[string]any
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, false
+	}
+	return object, true
+}
@@ -0,0 +1,132 @@
+package manifest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	fs := &flagset.Flagset{Flags: []flagset.Flag{
+		{
+			Key:         "checkout",
+			Type:        flagset.ObjectType,
+			Description: "Checkout settings, see https://wiki.corp/checkout and https://docs.example.com/checkout.",
+			Descriptions: map[string]string{
+				"de": "Einstellungen, siehe http://10.0.4.2:8080/checkout",
+			},
+			DefaultValue:       map[string]any{"endpoint": "https://pay.internal", "retries": json.Number("3"), "beta": true, "regions": []any{"eu"}},
+			DefaultValueFile:   "checkout.json",
+			DefaultValueSha256: "abc",
+			Compliance:         &flagset.Compliance{PII: true, DPIA: "https://privacy.example.com/dpia/checkout"},
+			Metadata:           map[string]any{"owner": "payments", "jira": "PAY-1"},
+		},
+		{
+			Key:          "banner",
+			Type:         flagset.StringType,
+			Description:  "Banner text, tracked at https://jira.acme-corp.com/browse/WEB-1",
+			DefaultValue: "Welcome",
+		},
+	}}
+
+	tests := []struct {
+		name    string
+		redact  *RedactOptions
+		want    map[string]any
+		wantErr string
+	}{
+		{
+			name: "without redaction",
+			want: map[string]any{
+				"checkout": map[string]any{
+					"flagType":     "object",
+					"description":  "Checkout settings, see https://wiki.corp/checkout and https://docs.example.com/checkout.",
+					"descriptions": map[string]any{"de": "Einstellungen, siehe http://10.0.4.2:8080/checkout"},
+					"defaultValue": map[string]any{"endpoint": "https://pay.internal", "retries": float64(3), "beta": true, "regions": []any{"eu"}},
+					"compliance":   map[string]any{"pii": true, "dpia": "https://privacy.example.com/dpia/checkout"},
+					"metadata":     map[string]any{"owner": "payments", "jira": "PAY-1"},
+				},
+				"banner": map[string]any{
+					"flagType":     "string",
+					"description":  "Banner text, tracked at https://jira.acme-corp.com/browse/WEB-1",
+					"defaultValue": "Welcome",
+				},
+			},
+		},
+		{
+			name: "masks object defaults and internal URLs",
+			redact: &RedactOptions{
+				InternalDomains: []string{"acme-corp.com"},
+				Fields:          []string{"compliance", "metadata.owner"},
+			},
+			want: map[string]any{
+				"checkout": map[string]any{
+					"flagType":     "object",
+					"description":  "Checkout settings, see [internal URL] and https://docs.example.com/checkout.",
+					"descriptions": map[string]any{"de": "Einstellungen, siehe [internal URL]"},
+					"defaultValue": map[string]any{"endpoint": "[redacted]", "retries": float64(0), "beta": false, "regions": []any{"[redacted]"}},
+					"metadata":     map[string]any{"jira": "PAY-1"},
+				},
+				"banner": map[string]any{
+					"flagType":     "string",
+					"description":  "Banner text, tracked at [internal URL]",
+					"defaultValue": "Welcome",
+				},
+			},
+		},
+		{
+			name:   "strips object defaults",
+			redact: &RedactOptions{ObjectDefaults: ObjectDefaultsStrip, Fields: []string{"metadata.owner", "metadata.jira"}},
+			want: map[string]any{
+				"checkout": map[string]any{
+					"flagType":     "object",
+					"description":  "Checkout settings, see [internal URL] and https://docs.example.com/checkout.",
+					"descriptions": map[string]any{"de": "Einstellungen, siehe [internal URL]"},
+					"defaultValue": map[string]any{},
+					"compliance":   map[string]any{"pii": true, "dpia": "https://privacy.example.com/dpia/checkout"},
+				},
+				"banner": map[string]any{
+					"flagType":     "string",
+					"description":  "Banner text, tracked at https://jira.acme-corp.com/browse/WEB-1",
+					"defaultValue": "Welcome",
+				},
+			},
+		},
+		{
+			name:    "invalid redaction of object defaults",
+			redact:  &RedactOptions{ObjectDefaults: "hash"},
+			wantErr: `invalid redaction of object defaults: "hash"`,
+		},
+		{
+			name:    "required field",
+			redact:  &RedactOptions{Fields: []string{"defaultValue"}},
+			wantErr: `cannot strip the required field "defaultValue"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Export(fs, tt.redact)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var exported struct {
+				Flags map[string]any `json:"flags"`
+			}
+			require.NoError(t, json.Unmarshal(data, &exported))
+			assert.Equal(t, tt.want, exported.Flags)
+
+			_, err = ParseFlagSet(data)
+			assert.NoError(t, err, "the exported manifest must be valid")
+		})
+	}
+
+	assert.Equal(t, "https://pay.internal", fs.Flags[0].DefaultValue.(map[string]any)["endpoint"], "the flagset must not be changed")
+}
@@ -0,0 +1,109 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// RenamePrefix renames the flags of the manifest data whose key starts with from, replacing the
+// prefix with to. The entries of the flags and the other fields of the manifest are left as they
+// are. Returns the renamed manifest, formatted as the CLI writes manifests, and the renamed keys,
+// mapped from the old to the new key.
+func RenamePrefix(data []byte, from string, to string) ([]byte, map[string]string, error) {
+	if from == "" {
+		return nil, nil, errors.New("the prefix to rename can't be empty")
+	}
+	if from == to {
+		return nil, nil, fmt.Errorf("the prefix %q is renamed to itself", from)
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshaling JSON: %v", err)
+	}
+	flags, ok := m["flags"].(map[string]any)
+	if !ok {
+		return nil, nil, errors.New("manifest has no flags object")
+	}
+
+	renames := make(map[string]string)
+	for _, key := range slices.Sorted(maps.Keys(flags)) {
+		if !strings.HasPrefix(key, from) {
+			continue
+		}
+		newKey := to + strings.TrimPrefix(key, from)
+		// A flag with the new key only makes way if it is renamed too
+		if _, exists := flags[newKey]; exists && !strings.HasPrefix(newKey, from) {
+			return nil, nil, fmt.Errorf("cannot rename %q to %q: the flag already exists", key, newKey)
+		}
+		renames[key] = newKey
+	}
+	if len(renames) == 0 {
+		return data, renames, nil
+	}
+
+	renamed := make(map[string]any, len(flags))
+	for key, entry := range flags {
+		if newKey, ok := renames[key]; ok {
+			key = newKey
+		}
+		renamed[key] = entry
+	}
+	m["flags"] = renamed
+
+	result, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshaling manifest: %w", err)
+	}
+	if bytes.HasSuffix(data, []byte("\n")) {
+		result = append(result, '\n')
+	}
+
+	validationErrors, err := Validate(result)
+	if err != nil {
+		return nil, nil, err
+	} else if len(validationErrors) > 0 {
+		return nil, nil, fmt.Errorf("renamed manifest is invalid: %s", FormatValidationError(validationErrors))
+	}
+	return result, renames, nil
+}
+
+// RenameFlag renames the flag with the key from in the manifest data to the key to. Only the key
+// changes: the entry of the flag keeps its place, and the manifest its formatting.
+func RenameFlag(data []byte, from string, to string) ([]byte, error) {
+	if to == "" {
+		return nil, errors.New("the new key can't be empty")
+	}
+	if from == to {
+		return nil, fmt.Errorf("the flag %q is renamed to itself", from)
+	}
+	entry, err := findFlagEntry(data, from)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := findFlagEntry(data, to); err == nil {
+		return nil, fmt.Errorf("cannot rename %q to %q: the flag already exists", from, to)
+	}
+
+	key, err := json.Marshal(to)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling key: %w", err)
+	}
+	var result bytes.Buffer
+	result.Write(data[:entry.keyStart])
+	result.Write(key)
+	result.Write(data[entry.keyEnd:])
+
+	validationErrors, err := Validate(result.Bytes())
+	if err != nil {
+		return nil, err
+	} else if len(validationErrors) > 0 {
+		return nil, fmt.Errorf("renamed manifest is invalid: %s", FormatValidationError(validationErrors))
+	}
+	return result.Bytes(), nil
+}
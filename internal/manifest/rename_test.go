@@ -0,0 +1,101 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenamePrefix(t *testing.T) {
+	const data = `{
+  "$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v0/flag-manifest.json",
+  "flags": {
+    "exp-checkout": {"flagType": "boolean", "defaultValue": false, "description": "New checkout", "tags": ["checkout"]},
+    "exp-banner": {"flagType": "string", "defaultValue": "Hi", "description": "Banner"},
+    "experiment-search": {"flagType": "integer", "defaultValue": 1, "description": "Search"}
+  }
+}
+`
+
+	t.Run("renames matching keys", func(t *testing.T) {
+		renamed, renames, err := RenamePrefix([]byte(data), "exp-", "experiment-")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"exp-checkout": "experiment-checkout", "exp-banner": "experiment-banner"}, renames)
+		assert.Equal(t, `{
+  "$schema": "https://raw.githubusercontent.com/open-feature/cli/main/schema/v0/flag-manifest.json",
+  "flags": {
+    "experiment-banner": {
+      "defaultValue": "Hi",
+      "description": "Banner",
+      "flagType": "string"
+    },
+    "experiment-checkout": {
+      "defaultValue": false,
+      "description": "New checkout",
+      "flagType": "boolean",
+      "tags": [
+        "checkout"
+      ]
+    },
+    "experiment-search": {
+      "defaultValue": 1,
+      "description": "Search",
+      "flagType": "integer"
+    }
+  }
+}
+`, string(renamed))
+	})
+
+	t.Run("no matching keys", func(t *testing.T) {
+		renamed, renames, err := RenamePrefix([]byte(data), "beta-", "b-")
+		require.NoError(t, err)
+		assert.Empty(t, renames)
+		assert.Equal(t, data, string(renamed))
+	})
+
+	t.Run("new key already used", func(t *testing.T) {
+		_, _, err := RenamePrefix([]byte(`{"flags": {"a-x": {}, "b-x": {}}}`), "a-", "b-")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot rename "a-x" to "b-x": the flag already exists`)
+	})
+
+	t.Run("renamed keys make way", func(t *testing.T) {
+		manifest := `{"flags": {"a-x": {"flagType": "boolean", "defaultValue": true}, "a-a-x": {"flagType": "boolean", "defaultValue": false}}}`
+		_, renames, err := RenamePrefix([]byte(manifest), "a-", "a-a-")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"a-x": "a-a-x", "a-a-x": "a-a-a-x"}, renames)
+	})
+
+	t.Run("empty prefix", func(t *testing.T) {
+		_, _, err := RenamePrefix([]byte(data), "", "x-")
+		require.Error(t, err)
+	})
+}
+
+func TestRenameFlag(t *testing.T) {
+	const data = `{
+  "flags": {
+    "zeta": {"flagType": "integer", "defaultValue": 1},
+    "alpha": {"flagType": "boolean", "defaultValue": false}
+  }
+}
+`
+	renamed, err := RenameFlag([]byte(data), "zeta", "omega")
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "flags": {
+    "omega": {"flagType": "integer", "defaultValue": 1},
+    "alpha": {"flagType": "boolean", "defaultValue": false}
+  }
+}
+`, string(renamed))
+
+	_, err = RenameFlag([]byte(data), "zeta", "alpha")
+	assert.EqualError(t, err, `cannot rename "zeta" to "alpha": the flag already exists`)
+	_, err = RenameFlag([]byte(data), "zeta", "zeta")
+	assert.EqualError(t, err, `the flag "zeta" is renamed to itself`)
+	_, err = RenameFlag([]byte(data), "missing", "beta")
+	assert.EqualError(t, err, "flag 'missing' not found in manifest")
+}
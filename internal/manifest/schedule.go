@@ -0,0 +1,59 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// findScheduleIssues checks that the timezone of the manifest is known and that the activation
+// window of each flag ends after it starts.
+// Invalid timestamps are reported by the schema validation.
+func findScheduleIssues(data []byte) []ValidationError {
+	var manifest struct {
+		Timezone string `json:"timezone"`
+		Flags    map[string]struct {
+			ActiveFrom  string `json:"activeFrom"`
+			ActiveUntil string `json:"activeUntil"`
+		} `json:"flags"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil
+	}
+	loc, err := flagset.Location(manifest.Timezone)
+	if err != nil {
+		return []ValidationError{{
+			Type:    "schedule",
+			Path:    "timezone",
+			Message: err.Error(),
+		}}
+	}
+
+	keys := make([]string, 0, len(manifest.Flags))
+	for key := range manifest.Flags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var issues []ValidationError
+	for _, key := range keys {
+		flag := manifest.Flags[key]
+		from, fromErr := flagset.ParseScheduleTime(flag.ActiveFrom, loc)
+		until, untilErr := flagset.ParseScheduleTime(flag.ActiveUntil, loc)
+		if fromErr != nil || untilErr != nil {
+			continue
+		}
+
+		if !until.After(from) {
+			issues = append(issues, ValidationError{
+				Type:    "schedule",
+				Path:    fmt.Sprintf("flags.%s", key),
+				Message: fmt.Sprintf("flag '%s' has an activeUntil that is not after its activeFrom", key),
+			})
+		}
+	}
+
+	return issues
+}
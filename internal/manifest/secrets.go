@@ -0,0 +1,196 @@
+package manifest
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// secretEntropyMinLength is the length from which a word is checked for a high entropy
+const secretEntropyMinLength = 24
+
+// secretEntropyThreshold is the Shannon entropy, in bits per character, from which a word that mixes
+// letters and digits looks like a random token. Hex strings, whose entropy is at most 4, stay below it.
+const secretEntropyThreshold = 4.2
+
+// secretRule is a named regular expression matching a kind of secret
+type secretRule struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// builtinSecretRules match well-known credential formats
+var builtinSecretRules = []secretRule{
+	{"private key", regexp.MustCompile(`-----BEGIN[A-Z ]* PRIVATE KEY-----`)},
+	{"AWS access key", regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{"Stripe secret key", regexp.MustCompile(`\b[rs]k_(?:live|test)_[A-Za-z0-9]{16,}\b`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"JSON web token", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,}`)},
+	{"URL with credentials", regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://[^/\s:@]+:[^/\s:@]+@`)},
+	{"password assignment", regexp.MustCompile(`(?i)\b(?:password|passwd|pwd|secret|api[_-]?key|access[_-]?token)\s*[:=]\s*["']?[^\s"']{8,}`)},
+}
+
+// uuidPattern matches UUIDs, which are identifiers rather than secrets despite their entropy
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// SecretScanner finds secret-looking values in the descriptions and default values of flags,
+// so that credentials don't leak through flag payloads
+type SecretScanner struct {
+	rules   []secretRule
+	allow   []*regexp.Regexp
+	entropy bool
+}
+
+// NewSecretScanner creates a scanner with the built-in rules and the given named patterns.
+// Values matching one of the allow patterns are never reported. With entropy set, words that
+// look like random tokens are reported too.
+func NewSecretScanner(patterns map[string]string, allow []string, entropy bool) (*SecretScanner, error) {
+	scanner := &SecretScanner{rules: slices.Clone(builtinSecretRules), entropy: entropy}
+	for _, name := range slices.Sorted(maps.Keys(patterns)) {
+		pattern, err := regexp.Compile(patterns[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret pattern %q: %w", name, err)
+		}
+		scanner.rules = append(scanner.rules, secretRule{name: name, pattern: pattern})
+	}
+	for _, expr := range allow {
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed secret pattern %q: %w", expr, err)
+		}
+		scanner.allow = append(scanner.allow, pattern)
+	}
+	return scanner, nil
+}
+
+// Scan returns an issue for each description and default value of the flags that contains a
+// secret-looking value. The values are redacted in the messages.
+func (s *SecretScanner) Scan(flags []flagset.Flag) []ValidationError {
+	if s == nil {
+		return nil
+	}
+
+	var issues []ValidationError
+	for _, flag := range flags {
+		path := fmt.Sprintf("flags.%s", flag.Key)
+		if kind, value := s.find(flag.Description); kind != "" {
+			issues = append(issues, secretIssue(flag.Key, path+".description", "description", kind, value))
+		}
+		for _, locale := range slices.Sorted(maps.Keys(flag.Descriptions)) {
+			if kind, value := s.find(flag.Descriptions[locale]); kind != "" {
+				issues = append(issues, secretIssue(flag.Key, path+".descriptions."+locale, locale+" description", kind, value))
+			}
+		}
+		issues = append(issues, s.scanValue(flag.Key, path+".defaultValue", flag.DefaultValue)...)
+	}
+	return issues
+}
+
+// scanValue scans the strings of a default value, descending into objects and arrays
+func (s *SecretScanner) scanValue(key string, path string, value any) []ValidationError {
+	switch v := value.(type) {
+	case string:
+		if kind, found := s.find(v); kind != "" {
+			return []ValidationError{secretIssue(key, path, "default value", kind, found)}
+		}
+	case map[string]any:
+		var issues []ValidationError
+		for _, member := range slices.Sorted(maps.Keys(v)) {
+			issues = append(issues, s.scanValue(key, path+"."+member, v[member])...)
+		}
+		return issues
+	case []any:
+		var issues []ValidationError
+		for i, item := range v {
+			issues = append(issues, s.scanValue(key, fmt.Sprintf("%s[%d]", path, i), item)...)
+		}
+		return issues
+	}
+	return nil
+}
+
+// find returns the kind and the value of the first secret-looking value of the text
+func (s *SecretScanner) find(text string) (string, string) {
+	for _, rule := range s.rules {
+		for _, match := range rule.pattern.FindAllString(text, -1) {
+			if !s.allowed(match) {
+				return rule.name, match
+			}
+		}
+	}
+
+	if !s.entropy {
+		return "", ""
+	}
+	for _, word := range strings.FieldsFunc(text, isSecretSeparator) {
+		if looksRandom(word) && !s.allowed(word) {
+			return "high-entropy string", word
+		}
+	}
+	return "", ""
+}
+
+// allowed checks if a value matches one of the allow patterns
+func (s *SecretScanner) allowed(value string) bool {
+	return slices.ContainsFunc(s.allow, func(pattern *regexp.Regexp) bool {
+		return pattern.MatchString(value)
+	})
+}
+
+// isSecretSeparator splits text into the words checked for a high entropy
+func isSecretSeparator(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(`"'`+"`"+`,;()[]{}<>`, r)
+}
+
+// looksRandom checks if a word is long, mixes letters and digits and has a high entropy, like a token
+func looksRandom(word string) bool {
+	if len(word) < secretEntropyMinLength || strings.Contains(word, "://") || uuidPattern.MatchString(word) {
+		return false
+	}
+	if !strings.ContainsFunc(word, unicode.IsLetter) || !strings.ContainsFunc(word, unicode.IsDigit) {
+		return false
+	}
+	return shannonEntropy(word) >= secretEntropyThreshold
+}
+
+// shannonEntropy returns the Shannon entropy of the characters of a string, in bits per character
+func shannonEntropy(s string) float64 {
+	counts := map[rune]int{}
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// secretIssue returns the issue of a secret-looking value found in a field of a flag
+func secretIssue(key string, path string, field string, kind string, value string) ValidationError {
+	return ValidationError{
+		Type:    "secret",
+		Path:    path,
+		Message: fmt.Sprintf("flag '%s' %s contains a secret-looking value (%s: %s)", key, field, kind, redactSecret(value)),
+	}
+}
+
+// redactSecret keeps the first characters of a secret, enough to find it without disclosing it
+func redactSecret(value string) string {
+	runes := []rune(value)
+	if len(runes) <= 8 {
+		return "****"
+	}
+	return string(runes[:4]) + "****"
+}
@@ -0,0 +1,413 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pelletier/go-toml/v2/unstable"
+	"gopkg.in/yaml.v3"
+)
+
+// bareTOMLKey matches the keys TOML allows without quotes
+var bareTOMLKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// IsTOML reports whether the manifest at the path is written in TOML, by the extension of the path
+// (.toml)
+func IsTOML(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".toml")
+}
+
+// parseTOML parses TOML data into a tree of YAML nodes, which keeps the order of the keys and the line
+// and column of every key and value, as for YAML manifests. With comments, the comments of the data
+// are kept where the YAML parser keeps them: on the keys and tables they precede, and on the values
+// they follow on the same line.
+func parseTOML(data []byte, comments bool) (*yaml.Node, error) {
+	// The decoder checks what the parser doesn't, such as keys that are defined twice
+	if err := toml.Unmarshal(data, new(map[string]any)); err != nil {
+		return nil, err
+	}
+
+	p := &unstable.Parser{KeepComments: comments}
+	p.Reset(data)
+	root := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Line: 1, Column: 1}
+	doc := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}}
+	b := &tomlBuilder{parser: p, root: root, current: root}
+
+	var pending []string
+	pendingEnd, first := 0, true
+	for p.NextExpression() {
+		expr := p.Expression()
+		if expr.Kind == unstable.Comment {
+			pending = append(pending, strings.TrimRight(string(expr.Data), "\r"))
+			pendingEnd = int(expr.Raw.Offset + expr.Raw.Length)
+			continue
+		}
+
+		keys := tomlKeys(expr)
+		// Comments at the top, apart from the first key or table, are about the whole manifest
+		if first && len(pending) > 0 && bytes.Count(data[pendingEnd:keys[0].Raw.Offset], []byte("\n")) > 1 {
+			doc.HeadComment = strings.Join(pending, "\n")
+			pending = nil
+		}
+		first = false
+
+		key, value, err := b.expression(expr, keys)
+		if err != nil {
+			return nil, err
+		}
+		key.HeadComment = strings.Join(pending, "\n")
+		pending = nil
+		if next := expr.Next(); next != nil && next.Kind == unstable.Comment {
+			value.LineComment = strings.TrimRight(string(next.Data), "\r")
+		}
+	}
+	if err := p.Error(); err != nil {
+		return nil, err
+	}
+	doc.FootComment = strings.Join(pending, "\n")
+	return doc, nil
+}
+
+// tomlKeys returns the parts of the key of a table header or a key-value
+func tomlKeys(expr *unstable.Node) []*unstable.Node {
+	var keys []*unstable.Node
+	it := expr.Key()
+	for it.Next() {
+		keys = append(keys, it.Node())
+	}
+	return keys
+}
+
+// tomlBuilder builds the tree of YAML nodes of a TOML document, one expression at a time
+type tomlBuilder struct {
+	parser *unstable.Parser
+	root   *yaml.Node
+	// current is the table of the last header, which the key-values that follow it are in
+	current *yaml.Node
+}
+
+// expression adds a table header or a key-value to the tree, and returns the nodes of its last key
+// and of its table or value
+func (b *tomlBuilder) expression(expr *unstable.Node, keys []*unstable.Node) (*yaml.Node, *yaml.Node, error) {
+	if expr.Kind == unstable.KeyValue {
+		return b.keyValue(b.current, expr, keys)
+	}
+
+	parent := b.root
+	for _, k := range keys[:len(keys)-1] {
+		parent = b.subtable(parent, k)
+	}
+	last := keys[len(keys)-1]
+	if expr.Kind == unstable.Table {
+		key, table := b.entry(parent, last, yaml.MappingNode)
+		b.current = table
+		return key, table, nil
+	}
+
+	// An array table adds a table to the array at the key
+	key, array := b.entry(parent, last, yaml.SequenceNode)
+	table := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Line: key.Line, Column: key.Column}
+	array.Content = append(array.Content, table)
+	b.current = table
+	return key, table, nil
+}
+
+// keyValue adds a key-value to the table, creating the tables of a dotted key
+func (b *tomlBuilder) keyValue(table *yaml.Node, expr *unstable.Node, keys []*unstable.Node) (*yaml.Node, *yaml.Node, error) {
+	for _, k := range keys[:len(keys)-1] {
+		table = b.subtable(table, k)
+	}
+	last := keys[len(keys)-1]
+	value, err := b.value(expr.Value(), last)
+	if err != nil {
+		return nil, nil, err
+	}
+	key := b.key(last)
+	table.Content = append(table.Content, key, value)
+	return key, value, nil
+}
+
+// subtable returns the table at the key of the parent, creating it if it doesn't exist. The key of
+// an array of tables refers to its last table.
+func (b *tomlBuilder) subtable(parent *yaml.Node, k *unstable.Node) *yaml.Node {
+	_, table := b.entry(parent, k, yaml.MappingNode)
+	if table.Kind == yaml.SequenceNode && len(table.Content) > 0 {
+		return table.Content[len(table.Content)-1]
+	}
+	return table
+}
+
+// entry returns the key and value nodes of the key in the parent, adding them with an empty value of
+// the kind if the key isn't there
+func (b *tomlBuilder) entry(parent *yaml.Node, k *unstable.Node, kind yaml.Kind) (*yaml.Node, *yaml.Node) {
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i].Value == string(k.Data) {
+			return parent.Content[i], parent.Content[i+1]
+		}
+	}
+	key := b.key(k)
+	value := &yaml.Node{Kind: kind, Tag: "!!map", Line: key.Line, Column: key.Column}
+	if kind == yaml.SequenceNode {
+		value.Tag = "!!seq"
+	}
+	parent.Content = append(parent.Content, key, value)
+	return key, value
+}
+
+// key returns the node of a part of a key
+func (b *tomlBuilder) key(k *unstable.Node) *yaml.Node {
+	line, column := position(b.parser.Data(), int(k.Raw.Offset))
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: string(k.Data), Line: line, Column: column}
+}
+
+// value returns the node of a TOML value. Dates and times become timestamps, which are strings in the
+// JSON of the manifest; the other values keep their type.
+func (b *tomlBuilder) value(v *unstable.Node, k *unstable.Node) (*yaml.Node, error) {
+	offset := int(k.Raw.Offset)
+	switch {
+	case v.Raw.Length > 0:
+		offset = int(v.Raw.Offset)
+	case v.Kind == unstable.Bool, v.Kind == unstable.LocalDate, v.Kind == unstable.LocalTime,
+		v.Kind == unstable.LocalDateTime, v.Kind == unstable.DateTime:
+		// These values are slices of the data, without a range
+		offset = int(b.parser.Range(v.Data).Offset)
+	}
+	line, column := position(b.parser.Data(), offset)
+	node := &yaml.Node{Kind: yaml.ScalarNode, Line: line, Column: column}
+
+	switch v.Kind {
+	case unstable.String:
+		node.Tag, node.Value = "!!str", string(v.Data)
+		if raw := b.parser.Raw(v.Raw); len(raw) > 0 && raw[0] == '\'' {
+			node.Style = yaml.SingleQuotedStyle
+		}
+	case unstable.Bool:
+		node.Tag, node.Value = "!!bool", string(v.Data)
+	case unstable.Integer:
+		n, err := strconv.ParseInt(strings.ReplaceAll(string(v.Data), "_", ""), 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		node.Tag, node.Value = "!!int", strconv.FormatInt(n, 10)
+	case unstable.Float:
+		f, err := strconv.ParseFloat(strings.ReplaceAll(string(v.Data), "_", ""), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, fmt.Errorf("line %d: %s is not a JSON number", line, v.Data)
+		}
+		node.Tag, node.Value = "!!float", strconv.FormatFloat(f, 'g', -1, 64)
+	case unstable.LocalDate, unstable.LocalTime, unstable.LocalDateTime, unstable.DateTime:
+		node.Tag, node.Value = "!!timestamp", string(v.Data)
+	case unstable.Array:
+		node.Kind, node.Tag, node.Style = yaml.SequenceNode, "!!seq", yaml.FlowStyle
+		it := v.Children()
+		for it.Next() {
+			if it.Node().Kind == unstable.Comment {
+				continue
+			}
+			item, err := b.value(it.Node(), k)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, item)
+		}
+	case unstable.InlineTable:
+		node.Kind, node.Tag, node.Style = yaml.MappingNode, "!!map", yaml.FlowStyle
+		it := v.Children()
+		for it.Next() {
+			if it.Node().Kind != unstable.KeyValue {
+				continue
+			}
+			if _, _, err := b.keyValue(node, it.Node(), tomlKeys(it.Node())); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("line %d: unsupported TOML value", line)
+	}
+	return node, nil
+}
+
+// renderTOML converts the JSON manifest data to TOML, keeping the comments of the existing manifest
+// at the path like Render does for YAML
+func renderTOML(path string, data []byte) ([]byte, error) {
+	node, err := jsonToNode(json.NewDecoder(bytes.NewReader(data)))
+	if err != nil {
+		return nil, fmt.Errorf("error converting manifest to TOML: %w", err)
+	}
+	doc := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{node}}
+
+	if existing, err := filesystem.ReadFile(path); err == nil {
+		if old, err := parseTOML(existing, true); err == nil {
+			keepFormatting(old, doc)
+		}
+	} else if schema := mappingValue(node, "$schema"); schema != nil {
+		// Editors with the TOML language server validate the new manifest against the schema
+		doc.HeadComment = "#:schema " + schema.Value
+	}
+
+	var buf bytes.Buffer
+	if doc.HeadComment != "" {
+		buf.WriteString(doc.HeadComment + "\n\n")
+	}
+	if err := writeTOMLTable(&buf, nil, node); err != nil {
+		return nil, fmt.Errorf("error converting manifest to TOML: %w", err)
+	}
+	if doc.FootComment != "" {
+		buf.WriteString("\n" + doc.FootComment + "\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// writeTOMLTable writes the keys of a mapping node as the contents of the TOML table at the path:
+// its values first, then its mappings as tables under their own headers. Mappings that were inline
+// tables stay inline.
+func writeTOMLTable(buf *bytes.Buffer, path []string, node *yaml.Node) error {
+	var tables []int
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		if isTOMLTable(value) {
+			tables = append(tables, i)
+			continue
+		}
+		writeTOMLComment(buf, key.HeadComment)
+		buf.WriteString(tomlKey(key.Value) + " = ")
+		if err := writeTOMLValue(buf, value); err != nil {
+			return fmt.Errorf("%s: %w", strings.Join(append(slices.Clone(path), key.Value), "."), err)
+		}
+		if value.LineComment != "" {
+			buf.WriteString(" " + value.LineComment)
+		}
+		buf.WriteByte('\n')
+	}
+
+	for _, i := range tables {
+		key, value := node.Content[i], node.Content[i+1]
+		tablePath := append(slices.Clone(path), key.Value)
+		// A table with nothing but tables is implied by their headers
+		if key.HeadComment != "" || value.LineComment != "" || len(value.Content) == 0 || hasTOMLValues(value) {
+			if buf.Len() > 0 {
+				buf.WriteByte('\n')
+			}
+			writeTOMLComment(buf, key.HeadComment)
+			keys := make([]string, len(tablePath))
+			for i, k := range tablePath {
+				keys[i] = tomlKey(k)
+			}
+			buf.WriteString("[" + strings.Join(keys, ".") + "]")
+			if value.LineComment != "" {
+				buf.WriteString(" " + value.LineComment)
+			}
+			buf.WriteByte('\n')
+		}
+		if err := writeTOMLTable(buf, tablePath, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isTOMLTable reports whether the node is written as a table with its own header
+func isTOMLTable(node *yaml.Node) bool {
+	return node.Kind == yaml.MappingNode && node.Style&yaml.FlowStyle == 0
+}
+
+// hasTOMLValues reports whether the mapping node has keys that are not written as tables
+func hasTOMLValues(node *yaml.Node) bool {
+	for i := 1; i < len(node.Content); i += 2 {
+		if !isTOMLTable(node.Content[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeTOMLValue writes a node as an inline TOML value
+func writeTOMLValue(buf *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		switch node.ShortTag() {
+		case "!!null":
+			return errors.New("TOML has no null value")
+		case "!!str":
+			// Literal strings stay literal when they can
+			if node.Style == yaml.SingleQuotedStyle && !strings.ContainsFunc(node.Value, func(r rune) bool {
+				return r == '\'' || r != '\t' && unicode.IsControl(r)
+			}) {
+				buf.WriteString("'" + node.Value + "'")
+			} else {
+				buf.WriteString(tomlString(node.Value))
+			}
+		default:
+			// Booleans, numbers, and the dates and times of the existing manifest
+			buf.WriteString(node.Value)
+		}
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+		for i, item := range node.Content {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			if err := writeTOMLValue(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case yaml.MappingNode:
+		if len(node.Content) == 0 {
+			buf.WriteString("{}")
+			return nil
+		}
+		buf.WriteString("{ ")
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(tomlKey(node.Content[i].Value) + " = ")
+			if err := writeTOMLValue(buf, node.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		buf.WriteString(" }")
+	default:
+		return errors.New("unsupported value")
+	}
+	return nil
+}
+
+// writeTOMLComment writes the lines of a comment, if there is one
+func writeTOMLComment(buf *bytes.Buffer, comment string) {
+	if comment != "" {
+		buf.WriteString(comment + "\n")
+	}
+}
+
+// tomlKey returns a key as it is written in TOML: bare if it can be, in quotes otherwise
+func tomlKey(key string) string {
+	if bareTOMLKey.MatchString(key) {
+		return key
+	}
+	return tomlString(key)
+}
+
+// tomlString returns a string as a TOML basic string, whose escapes are those of JSON
+func tomlString(s string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
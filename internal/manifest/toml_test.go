@@ -0,0 +1,134 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tomlManifest = `# Flags of the checkout service
+
+"$schema" = "https://example.com/flag-manifest.json"
+
+# Rolled out in Q3
+[flags.new-checkout]
+flagType = "boolean"
+defaultValue = false # off until launch
+description = 'Checkout "v2"'
+activeUntil = 2026-12-31
+
+[flags.ratio]
+flagType = "float"
+defaultValue = 1_000.5
+
+[flags.theme]
+flagType = "object"
+defaultValue = { colors = ["blue", "green"], dark = true }
+
+[flags."checkout.banner"]
+flagType = "string"
+defaultValue = "hello"
+`
+
+func TestIsTOML(t *testing.T) {
+	assert.True(t, IsTOML("flags.toml"))
+	assert.True(t, IsTOML("config/Flags.TOML"))
+	assert.False(t, IsTOML("flags.json"))
+	assert.False(t, IsTOML("flags.yaml"))
+}
+
+func TestToJSONFromTOML(t *testing.T) {
+	data, err := ToJSON("flags.toml", []byte(tomlManifest))
+	require.NoError(t, err)
+	// The keys keep their order, and dates stay strings
+	assert.Equal(t, `{"$schema":"https://example.com/flag-manifest.json","flags":{"new-checkout":{"flagType":"boolean","defaultValue":false,"description":"Checkout \"v2\"","activeUntil":"2026-12-31"},"ratio":{"flagType":"float","defaultValue":1000.5},"theme":{"flagType":"object","defaultValue":{"colors":["blue","green"],"dark":true}},"checkout.banner":{"flagType":"string","defaultValue":"hello"}}}`, string(data))
+
+	_, err = ToJSON("flags.toml", []byte("[flags\n"))
+	assert.ErrorContains(t, err, "error parsing TOML manifest flags.toml")
+
+	_, err = ToJSON("flags.toml", []byte("a = 1\na = 2\n"))
+	assert.ErrorContains(t, err, "error parsing TOML manifest flags.toml")
+
+	_, err = ToJSON("flags.toml", []byte("ratio = inf\n"))
+	assert.ErrorContains(t, err, "inf is not a JSON number")
+}
+
+func TestRenderTOML(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.toml", []byte(tomlManifest), 0o644))
+
+	t.Run("writes the manifest as it was read", func(t *testing.T) {
+		data, err := ReadData("flags.toml")
+		require.NoError(t, err)
+		rendered, err := Render("flags.toml", data)
+		require.NoError(t, err)
+		assert.Equal(t, `# Flags of the checkout service
+
+"$schema" = "https://example.com/flag-manifest.json"
+
+# Rolled out in Q3
+[flags.new-checkout]
+flagType = "boolean"
+defaultValue = false # off until launch
+description = 'Checkout "v2"'
+activeUntil = 2026-12-31
+
+[flags.ratio]
+flagType = "float"
+defaultValue = 1000.5
+
+[flags.theme]
+flagType = "object"
+defaultValue = { colors = ["blue", "green"], dark = true }
+
+[flags."checkout.banner"]
+flagType = "string"
+defaultValue = "hello"
+`, string(rendered))
+	})
+
+	t.Run("keeps the comments of edited flags", func(t *testing.T) {
+		data, err := ReadData("flags.toml")
+		require.NoError(t, err)
+		edited, err := EditFlag(data, "new-checkout", []FieldEdit{
+			{Name: "defaultValue", Value: true},
+			{Name: "activeUntil", Value: "2027-01-31"},
+		})
+		require.NoError(t, err)
+
+		rendered, err := Render("flags.toml", edited)
+		require.NoError(t, err)
+		assert.Contains(t, string(rendered), `# Rolled out in Q3
+[flags.new-checkout]
+flagType = "boolean"
+defaultValue = true # off until launch
+description = 'Checkout "v2"'
+activeUntil = "2027-01-31"
+`)
+	})
+
+	t.Run("new manifests reference the schema", func(t *testing.T) {
+		require.NoError(t, Create("new.toml"))
+		data, err := afero.ReadFile(fs, "new.toml")
+		require.NoError(t, err)
+		assert.Equal(t, `#:schema `+flagManifestSchemaURL+`
+
+"$schema" = "`+flagManifestSchemaURL+`"
+
+[flags]
+`, string(data))
+
+		fs, err := LoadFlagSet("new.toml")
+		require.NoError(t, err)
+		assert.Empty(t, fs.Flags)
+	})
+
+	t.Run("null values are refused", func(t *testing.T) {
+		_, err := Render("flags.toml", []byte(`{"flags": {"a": {"defaultValue": null}}}`))
+		assert.ErrorContains(t, err, "flags.a.defaultValue: TOML has no null value")
+	})
+}
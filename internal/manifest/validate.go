@@ -15,6 +15,10 @@ type ValidationError struct {
 	Type    string `json:"type"`
 	Path    string `json:"path"`
 	Message string `json:"message"`
+	// Line and Column locate the value the issue is about in the manifest, starting at 1. Only
+	// set by ValidateDetailed.
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
 }
 
 func Validate(data []byte) ([]ValidationError, error) {
@@ -34,6 +38,9 @@ func Validate(data []byte) ([]ValidationError, error) {
 				Path:    err.Field(),
 				Message: "flagType must be 'boolean', 'string', 'integer', 'float', or 'object'",
 			})
+		} else if strings.HasPrefix(err.Field(), "flags") && err.Type() == "number_any_of" {
+			// Reported by the accompanying "defaultValue is required" error
+			continue
 		} else {
 			issues = append(issues, ValidationError{
 				Type:    err.Type(),
@@ -53,6 +60,9 @@ func Validate(data []byte) ([]ValidationError, error) {
 		})
 	}
 
+	issues = append(issues, findComplianceIssues(data)...)
+	issues = append(issues, findScheduleIssues(data)...)
+
 	return issues, nil
 }
 
@@ -0,0 +1,307 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// schemaRootPath is the path the schema validator gives the root of the manifest
+const schemaRootPath = "(root)"
+
+// yamlErrorLine finds the line in the errors of the YAML parser
+var yamlErrorLine = regexp.MustCompile(`line (\d+)`)
+
+// ValidateDetailed validates the contents of the manifest file at the path like Validate, and replaces
+// the schema errors that don't say what is wrong with a flag with specific ones: a default value that
+// doesn't match the flag type, an unknown flag type, or an activeFrom or activeUntil that is not a
+// date. Every issue carries the line and column of the value it is about, in the JSON, YAML or TOML
+// of the file. Issues are sorted by position.
+func ValidateDetailed(path string, raw []byte) ([]ValidationError, error) {
+	data, err := ToJSON(path, raw)
+	if err != nil {
+		issue := ValidationError{Type: "syntax", Path: schemaRootPath, Message: err.Error(), Line: 1, Column: 1}
+		var tomlErr *toml.DecodeError
+		if errors.As(err, &tomlErr) {
+			issue.Line, issue.Column = tomlErr.Position()
+		} else if match := yamlErrorLine.FindStringSubmatch(err.Error()); match != nil {
+			issue.Line, _ = strconv.Atoi(match[1])
+		}
+		return []ValidationError{issue}, nil
+	}
+
+	var syntaxErr *json.SyntaxError
+	if err := json.Unmarshal(data, new(any)); errors.As(err, &syntaxErr) {
+		issue := ValidationError{Type: "syntax", Path: schemaRootPath, Message: syntaxErr.Error()}
+		// The offset is after the byte the error is about
+		issue.Line, issue.Column = position(data, max(int(syntaxErr.Offset)-1, 0))
+		return []ValidationError{issue}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("error parsing manifest: %w", err)
+	}
+
+	issues, err := Validate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Flags with specific issues don't need the schema errors they cause
+	flagIssues := findFlagIssues(data)
+	replaced := make(map[string]bool)
+	for _, issue := range flagIssues {
+		replaced[issue.Path[:strings.LastIndexByte(issue.Path, '.')]] = true
+	}
+	issues = slices.DeleteFunc(issues, func(issue ValidationError) bool {
+		switch issue.Type {
+		case "number_one_of", "enum":
+			return replaced[issue.Path] || replaced[strings.TrimSuffix(issue.Path, ".flagType")]
+		case "pattern":
+			return strings.HasSuffix(issue.Path, ".activeFrom") || strings.HasSuffix(issue.Path, ".activeUntil")
+		}
+		return false
+	})
+	issues = append(issues, flagIssues...)
+
+	positions := issuePositions(path, raw, data)
+	for i := range issues {
+		issues[i].Line, issues[i].Column = 1, 1
+		if pos, ok := positions[issues[i].Path]; ok {
+			issues[i].Line, issues[i].Column = pos[0], pos[1]
+		}
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Column < issues[j].Column
+	})
+	return issues, nil
+}
+
+// ValuePosition returns the line and column of the value at the path, e.g. flags.new-checkout, in the
+// manifest file, or of its closest parent that is in the file. It is line 1, column 1 if the file can't
+// be read.
+func ValuePosition(file string, path string) (int, int) {
+	raw, err := filesystem.ReadFile(file)
+	if err != nil {
+		return 1, 1
+	}
+	data, err := ToJSON(file, raw)
+	if err != nil {
+		return 1, 1
+	}
+	positions := issuePositions(file, raw, data)
+	for {
+		if pos, ok := positions[path]; ok {
+			return pos[0], pos[1]
+		}
+		i := strings.LastIndexByte(path, '.')
+		if i < 0 {
+			return 1, 1
+		}
+		path = path[:i]
+	}
+}
+
+// issuePositions returns the line and column of every value of the manifest file at the path, whose
+// contents are raw, and data once converted to JSON
+func issuePositions(path string, raw []byte, data []byte) map[string][2]int {
+	var positions map[string][2]int
+	switch {
+	case IsYAML(path):
+		var doc yaml.Node
+		if yaml.Unmarshal(raw, &doc) == nil {
+			positions = nodePositions(&doc)
+		}
+	case IsTOML(path):
+		if doc, err := parseTOML(raw, false); err == nil {
+			positions = nodePositions(doc)
+		}
+	default:
+		positions = valuePositions(data)
+	}
+	return positions
+}
+
+// findFlagIssues checks the flag type, the type of the default value and the schedule of each flag
+func findFlagIssues(data []byte) []ValidationError {
+	var manifest struct {
+		Flags map[string]map[string]any `json:"flags"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil
+	}
+
+	var issues []ValidationError
+	for _, key := range slices.Sorted(maps.Keys(manifest.Flags)) {
+		flag := manifest.Flags[key]
+		path := "flags." + key
+
+		typeName, isString := flag["flagType"].(string)
+		flagType, err := flagset.ParseFlagType(typeName)
+		if _, ok := flag["flagType"]; ok && (!isString || err != nil) {
+			issues = append(issues, ValidationError{
+				Type:    "flag_type",
+				Path:    path + ".flagType",
+				Message: fmt.Sprintf("flagType must be 'boolean', 'string', 'integer', 'float', or 'object', got %s", formatJSONValue(flag["flagType"])),
+			})
+		} else if defaultValue, ok := flag["defaultValue"]; ok && err == nil && !conformsToType(defaultValue, flagType) {
+			issues = append(issues, ValidationError{
+				Type:    "type_mismatch",
+				Path:    path + ".defaultValue",
+				Message: fmt.Sprintf("defaultValue must be %s for a %s flag, got %s", article(flagType), flagType, formatJSONValue(defaultValue)),
+			})
+		}
+
+		for _, field := range []string{"activeFrom", "activeUntil"} {
+			value, ok := flag[field]
+			if !ok {
+				continue
+			}
+			if s, isString := value.(string); !isString || !isScheduleTime(s) {
+				issues = append(issues, ValidationError{
+					Type:    "schedule",
+					Path:    path + "." + field,
+					Message: fmt.Sprintf("%s must be a date (e.g., 2026-12-31) or an RFC 3339 timestamp (e.g., 2026-12-31T09:00:00Z), got %s", field, formatJSONValue(value)),
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// isScheduleTime reports whether the value is a valid activeFrom or activeUntil time
+func isScheduleTime(value string) bool {
+	_, err := flagset.ParseScheduleTime(value, time.UTC)
+	return err == nil
+}
+
+// article returns the kind of JSON value a flag type takes, with its article
+func article(flagType flagset.FlagType) string {
+	switch flagType {
+	case flagset.BoolType:
+		return "a boolean"
+	case flagset.IntType:
+		return "an integer"
+	case flagset.FloatType:
+		return "a number"
+	case flagset.ObjectType:
+		return "an object"
+	default:
+		return "a string"
+	}
+}
+
+// formatJSONValue formats a value of the manifest as it appears in the manifest
+func formatJSONValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// valuePositions returns the line and column of every value of the JSON data by its path, as the
+// schema validator writes it: the keys and array indexes joined with dots, e.g.
+// flags.new-checkout.defaultValue
+func valuePositions(data []byte) map[string][2]int {
+	positions := make(map[string][2]int)
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	var walk func(path string) error
+	walk = func(path string) error {
+		line, column := position(data, skipSeparators(data, int(dec.InputOffset())))
+		positions[path] = [2]int{line, column}
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'):
+			for dec.More() {
+				name, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				if err := walk(childPath(path, name.(string))); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		case json.Delim('['):
+			for i := 0; dec.More(); i++ {
+				if err := walk(childPath(path, strconv.Itoa(i))); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		}
+		return err
+	}
+	_ = walk(schemaRootPath)
+	return positions
+}
+
+// nodePositions returns the line and column of every value of a YAML document node, or of a TOML
+// document parsed into one, by its path, as valuePositions does for JSON
+func nodePositions(doc *yaml.Node) map[string][2]int {
+	positions := make(map[string][2]int)
+	if len(doc.Content) == 0 {
+		return positions
+	}
+
+	var walk func(path string, node *yaml.Node)
+	walk = func(path string, node *yaml.Node) {
+		positions[path] = [2]int{node.Line, node.Column}
+		switch node.Kind {
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				walk(childPath(path, node.Content[i].Value), node.Content[i+1])
+			}
+		case yaml.SequenceNode:
+			for i, item := range node.Content {
+				walk(childPath(path, strconv.Itoa(i)), item)
+			}
+		}
+	}
+	walk(schemaRootPath, doc.Content[0])
+	return positions
+}
+
+// childPath returns the path of a key or index of the value at the path
+func childPath(path string, name string) string {
+	if path == schemaRootPath {
+		return name
+	}
+	return path + "." + name
+}
+
+// skipSeparators returns the offset of the first byte from the offset that is not whitespace or a
+// separator between JSON tokens
+func skipSeparators(data []byte, offset int) int {
+	for offset < len(data) && strings.IndexByte(" \t\r\n:,", data[offset]) >= 0 {
+		offset++
+	}
+	return offset
+}
+
+// position returns the line and column, starting at 1, of the offset in the data
+func position(data []byte, offset int) (int, int) {
+	offset = min(offset, len(data))
+	line := bytes.Count(data[:offset], []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(data[:offset], '\n')
+	return line, column
+}
@@ -0,0 +1,68 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDetailed(t *testing.T) {
+	t.Run("specific issues with their position", func(t *testing.T) {
+		data := `{
+  "flags": {
+    "new-checkout": {"flagType": "boolean", "defaultValue": "yes"},
+    "max.items": {
+      "flagType": "integer",
+      "defaultValue": 10,
+      "activeUntil": "next week"
+    },
+    "theme": {"flagType": "colour", "defaultValue": "dark"},
+    "greeting": {"flagType": "string"}
+  }
+}`
+		issues, err := ValidateDetailed("flags.json", []byte(data))
+		require.NoError(t, err)
+		assert.Equal(t, []ValidationError{
+			{Type: "type_mismatch", Path: "flags.new-checkout.defaultValue", Message: `defaultValue must be a boolean for a boolean flag, got "yes"`, Line: 3, Column: 61},
+			{Type: "schedule", Path: "flags.max.items.activeUntil", Message: `activeUntil must be a date (e.g., 2026-12-31) or an RFC 3339 timestamp (e.g., 2026-12-31T09:00:00Z), got "next week"`, Line: 7, Column: 22},
+			{Type: "flag_type", Path: "flags.theme.flagType", Message: `flagType must be 'boolean', 'string', 'integer', 'float', or 'object', got "colour"`, Line: 9, Column: 27},
+			{Type: "required", Path: "flags.greeting", Message: "defaultValue is required", Line: 10, Column: 17},
+		}, issues)
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		issues, err := ValidateDetailed("flags.json", []byte("{\n  \"flags\": {,}\n}"))
+		require.NoError(t, err)
+		require.Len(t, issues, 1)
+		assert.Equal(t, "syntax", issues[0].Type)
+		assert.Equal(t, 2, issues[0].Line)
+		assert.Equal(t, 13, issues[0].Column)
+	})
+
+	t.Run("valid manifest", func(t *testing.T) {
+		issues, err := ValidateDetailed("flags.json", []byte(`{"flags": {"a": {"flagType": "float", "defaultValue": 1, "activeUntil": "2026-12-31"}}}`))
+		require.NoError(t, err)
+		assert.Empty(t, issues)
+	})
+}
+
+func TestValuePosition(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.yaml", []byte(`flags:
+  new-checkout:
+    flagType: object
+    defaultValue:
+      apiKey: secret
+`), 0o644))
+
+	line, column := ValuePosition("flags.yaml", "flags.new-checkout.defaultValue.apiKey")
+	assert.Equal(t, [2]int{5, 15}, [2]int{line, column})
+	line, column = ValuePosition("flags.yaml", "flags.removed-flag")
+	assert.Equal(t, [2]int{2, 3}, [2]int{line, column})
+	line, column = ValuePosition("missing.yaml", "flags.new-checkout")
+	assert.Equal(t, [2]int{1, 1}, [2]int{line, column})
+}
@@ -3,6 +3,8 @@ package manifest
 import (
 	"strings"
 	"testing"
+
+	"github.com/open-feature/cli/internal/flagset"
 )
 
 func TestValidate_DuplicateFlagKeys(t *testing.T) {
@@ -208,3 +210,176 @@ func TestFormatValidationError_SortsByPath(t *testing.T) {
 			alphaIdx, betaIdx, zetaIdx, output)
 	}
 }
+
+func TestValidate_Compliance(t *testing.T) {
+	tests := []struct {
+		name         string
+		compliance   string
+		wantMessages []string
+	}{
+		{
+			name:       "pii flag with dpia link",
+			compliance: `{"pii": true, "dpia": "https://example.com/dpia/42"}`,
+		},
+		{
+			name:         "pii flag without dpia link",
+			compliance:   `{"pii": true}`,
+			wantMessages: []string{"flag 'my-flag' affects PII and requires a 'compliance.dpia' link"},
+		},
+		{
+			name:       "data residency flag with regions",
+			compliance: `{"dataResidency": true, "regions": ["eu"]}`,
+		},
+		{
+			name:         "data residency flag without regions",
+			compliance:   `{"dataResidency": true, "pii": true}`,
+			wantMessages: []string{"requires a 'compliance.dpia' link", "requires a 'compliance.regions' list"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := `{"flags": {"my-flag": {"flagType": "boolean", "defaultValue": true, "compliance": ` + tt.compliance + `}}}`
+
+			issues, err := Validate([]byte(manifest))
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			if len(issues) != len(tt.wantMessages) {
+				t.Fatalf("expected %d issues, got %d: %v", len(tt.wantMessages), len(issues), issues)
+			}
+			for i, want := range tt.wantMessages {
+				if issues[i].Type != "compliance" || !strings.Contains(issues[i].Message, want) {
+					t.Errorf("expected compliance issue containing %q, got %+v", want, issues[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckTargetRegion(t *testing.T) {
+	fs := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "unrestricted"},
+		{Key: "eu-only", Compliance: &flagset.Compliance{DataResidency: true, Regions: []string{"eu"}}},
+	}}
+
+	if err := CheckTargetRegion(fs, "EU"); err != nil {
+		t.Errorf("expected push to eu to be allowed, got %v", err)
+	}
+
+	err := CheckTargetRegion(fs, "us")
+	if err == nil || !strings.Contains(err.Error(), `region "us": eu-only`) {
+		t.Errorf("expected push to us to be refused for eu-only, got %v", err)
+	}
+
+	err = CheckTargetRegion(fs, "")
+	if err == nil || !strings.Contains(err.Error(), "--target-region") {
+		t.Errorf("expected push without a region to be refused, got %v", err)
+	}
+
+	if err := CheckTargetRegion(&flagset.Flagset{Flags: fs.Flags[:1]}, ""); err != nil {
+		t.Errorf("expected unrestricted flags to be pushed anywhere, got %v", err)
+	}
+}
+
+func TestValidate_Schedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		timezone  string
+		schedule  string
+		wantIssue string
+	}{
+		{
+			name:     "window that ends after it starts",
+			schedule: `"activeFrom": "2026-01-01T00:00:00Z", "activeUntil": "2026-02-01T00:00:00Z"`,
+		},
+		{
+			name:     "open ended window",
+			schedule: `"activeFrom": "2026-01-01T00:00:00Z"`,
+		},
+		{
+			name:      "window that ends before it starts",
+			schedule:  `"activeFrom": "2026-02-01T00:00:00Z", "activeUntil": "2026-01-01T00:00:00Z"`,
+			wantIssue: "flag 'my-flag' has an activeUntil that is not after its activeFrom",
+		},
+		{
+			name:     "window of dates",
+			timezone: "Europe/Berlin",
+			schedule: `"activeFrom": "2026-01-01", "activeUntil": "2026-02-01"`,
+		},
+		{
+			name:      "date that ends before the timestamp it starts at",
+			timezone:  "Europe/Berlin",
+			schedule:  `"activeFrom": "2026-01-01T00:00:00Z", "activeUntil": "2026-01-01"`,
+			wantIssue: "flag 'my-flag' has an activeUntil that is not after its activeFrom",
+		},
+		{
+			name:      "unknown timezone",
+			timezone:  "Mars/Olympus",
+			schedule:  `"activeFrom": "2026-01-01"`,
+			wantIssue: `invalid timezone "Mars/Olympus"`,
+		},
+		{
+			name:      "timestamp that is neither RFC 3339 nor a date",
+			schedule:  `"activeFrom": "next tuesday"`,
+			wantIssue: "Does not match pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := `{"timezone": "` + tt.timezone + `", "flags": {"my-flag": {"flagType": "boolean", "defaultValue": true, ` + tt.schedule + `}}}`
+
+			issues, err := Validate([]byte(manifest))
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+
+			if tt.wantIssue == "" {
+				if len(issues) != 0 {
+					t.Errorf("expected no issues, got %v", issues)
+				}
+				return
+			}
+			var found bool
+			for _, issue := range issues {
+				found = found || strings.Contains(issue.Message, tt.wantIssue)
+			}
+			if !found {
+				t.Errorf("expected an issue containing %q, got %v", tt.wantIssue, issues)
+			}
+		})
+	}
+}
+
+func TestValidate_Cache(t *testing.T) {
+	tests := []struct {
+		name    string
+		cache   string
+		wantErr bool
+	}{
+		{name: "ttl and stale while revalidate", cache: `{"ttl": "30s", "staleWhileRevalidate": "5m"}`},
+		{name: "compound duration", cache: `{"ttl": "1m30s"}`},
+		{name: "missing ttl", cache: `{"staleWhileRevalidate": "5m"}`, wantErr: true},
+		{name: "duration without unit", cache: `{"ttl": "30"}`, wantErr: true},
+		{name: "unknown hint", cache: `{"ttl": "30s", "maxAge": "1m"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := `{"flags": {"my-flag": {"flagType": "boolean", "defaultValue": true, "cache": ` + tt.cache + `}}}`
+
+			issues, err := Validate([]byte(manifest))
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if tt.wantErr && len(issues) == 0 {
+				t.Errorf("expected issues, got none")
+			}
+			if !tt.wantErr && len(issues) != 0 {
+				t.Errorf("expected no issues, got %v", issues)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package manifest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/open-feature/cli/internal/flagset"
+)
+
+// Types of the flag events sent by provider webhooks
+const (
+	WebhookFlagCreated = "flag.created"
+	WebhookFlagChanged = "flag.changed"
+	WebhookFlagDeleted = "flag.deleted"
+)
+
+// WebhookSignatureHeader is the header holding the HMAC-SHA256 signature of a webhook payload,
+// as sha256=<hex digest>
+const WebhookSignatureHeader = "X-OpenFeature-Signature"
+
+// WebhookEvent is a change of a flag reported by a provider webhook
+type WebhookEvent struct {
+	Type string
+	Flag flagset.Flag
+}
+
+// WebhookDecoder decodes the payload of a provider webhook into flag events
+type WebhookDecoder func(header http.Header, body []byte) ([]WebhookEvent, error)
+
+// DecodeWebhookEvents decodes a webhook payload in the openfeature format: an event, or a list of
+// events, with a type and the flag as returned by the sync API. Deleted flags only need a key.
+//
+//	{"type": "flag.changed", "flag": {"key": "new-checkout", "type": "boolean", "defaultValue": true}}
+func DecodeWebhookEvents(_ http.Header, body []byte) ([]WebhookEvent, error) {
+	type payloadEvent struct {
+		Type string                     `json:"type"`
+		Flag map[string]json.RawMessage `json:"flag"`
+	}
+
+	var events []payloadEvent
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &events); err != nil {
+			return nil, fmt.Errorf("invalid webhook payload: %w", err)
+		}
+	} else {
+		var event payloadEvent
+		if err := json.Unmarshal(trimmed, &event); err != nil {
+			return nil, fmt.Errorf("invalid webhook payload: %w", err)
+		}
+		events = []payloadEvent{event}
+	}
+
+	decoded := make([]WebhookEvent, 0, len(events))
+	for _, event := range events {
+		var key, typeName, description string
+		_ = json.Unmarshal(event.Flag["key"], &key)
+		_ = json.Unmarshal(event.Flag["type"], &typeName)
+		_ = json.Unmarshal(event.Flag["description"], &description)
+		if key == "" {
+			return nil, fmt.Errorf("invalid webhook payload: %s event without a flag key", event.Type)
+		}
+		flag := flagset.Flag{Key: key}
+
+		switch event.Type {
+		case WebhookFlagCreated, WebhookFlagChanged:
+			flagType, err := flagset.ParseFlagType(typeName)
+			if err != nil {
+				return nil, fmt.Errorf("invalid webhook payload: flag %s: %w", key, err)
+			}
+			flag.Type = flagType
+
+			flag.DefaultValue, err = flagset.DecodeDefaultValue(flagType, event.Flag["defaultValue"])
+			if err != nil {
+				return nil, fmt.Errorf("invalid webhook payload: default value of flag %s: %w", key, err)
+			}
+			if flag.DefaultValue == nil {
+				return nil, fmt.Errorf("invalid webhook payload: flag %s has no default value", key)
+			}
+			if !conformsToType(flag.DefaultValue, flagType) {
+				return nil, fmt.Errorf("invalid webhook payload: default value of flag %s is not a %s", key, flagType)
+			}
+			flag.Description = description
+		case WebhookFlagDeleted:
+		default:
+			return nil, fmt.Errorf("invalid webhook payload: unknown event type %q. Valid types are: %s",
+				event.Type, strings.Join([]string{WebhookFlagCreated, WebhookFlagChanged, WebhookFlagDeleted}, ", "))
+		}
+		decoded = append(decoded, WebhookEvent{Type: event.Type, Flag: flag})
+	}
+	return decoded, nil
+}
+
+// VerifyWebhookSignature checks that the signature header of a webhook payload is the HMAC-SHA256
+// of the payload with the shared secret
+func VerifyWebhookSignature(secret string, header http.Header, body []byte) error {
+	signature, ok := strings.CutPrefix(header.Get(WebhookSignatureHeader), "sha256=")
+	if !ok {
+		return fmt.Errorf("missing %s header", WebhookSignatureHeader)
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid %s header: %w", WebhookSignatureHeader, err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return fmt.Errorf("the %s header does not match the payload", WebhookSignatureHeader)
+	}
+	return nil
+}
+
+// ApplyWebhookEvents applies flag events to the flags of a manifest and returns a description of
+// each change. Created and changed flags take the type, default value and description reported by
+// the provider, keeping the fields only the manifest has, such as tags or expiry dates. Events that
+// match the manifest already are skipped.
+func ApplyWebhookEvents(fs *flagset.Flagset, events []WebhookEvent) []string {
+	var changes []string
+	for _, event := range events {
+		index := slices.IndexFunc(fs.Flags, func(flag flagset.Flag) bool {
+			return flag.Key == event.Flag.Key
+		})
+
+		if event.Type == WebhookFlagDeleted {
+			if index >= 0 {
+				fs.Flags = slices.Delete(fs.Flags, index, index+1)
+				changes = append(changes, fmt.Sprintf("deleted %s", event.Flag.Key))
+			}
+			continue
+		}
+
+		if index < 0 {
+			fs.Flags = append(fs.Flags, event.Flag)
+			changes = append(changes, fmt.Sprintf("added %s", event.Flag.Key))
+			continue
+		}
+
+		flag := &fs.Flags[index]
+		if flag.Type == event.Flag.Type && reflect.DeepEqual(flag.DefaultValue, event.Flag.DefaultValue) &&
+			(event.Flag.Description == "" || flag.Description == event.Flag.Description) {
+			continue
+		}
+		flag.Type = event.Flag.Type
+		flag.DefaultValue = event.Flag.DefaultValue
+		// Keep the default value inline, since the file it was read from no longer holds it
+		flag.DefaultValueFile = ""
+		flag.DefaultValueSha256 = ""
+		if event.Flag.Description != "" {
+			flag.Description = event.Flag.Description
+		}
+		changes = append(changes, fmt.Sprintf("updated %s", event.Flag.Key))
+	}
+	return changes
+}
@@ -0,0 +1,84 @@
+package manifest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+
+	"github.com/open-feature/cli/internal/flagset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeWebhookEvents(t *testing.T) {
+	t.Run("single event", func(t *testing.T) {
+		events, err := DecodeWebhookEvents(nil, []byte(`{"type": "flag.changed", "flag": {"key": "retries", "type": "integer", "defaultValue": 3, "description": "Retries"}}`))
+		require.NoError(t, err)
+		assert.Equal(t, []WebhookEvent{{
+			Type: WebhookFlagChanged,
+			Flag: flagset.Flag{Key: "retries", Type: flagset.IntType, DefaultValue: int64(3), Description: "Retries"},
+		}}, events)
+	})
+
+	t.Run("list of events", func(t *testing.T) {
+		events, err := DecodeWebhookEvents(nil, []byte(`[
+			{"type": "flag.created", "flag": {"key": "banner", "type": "string", "defaultValue": "Hi"}},
+			{"type": "flag.deleted", "flag": {"key": "old-checkout"}}
+		]`))
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, flagset.StringType, events[0].Flag.Type)
+		assert.Equal(t, WebhookEvent{Type: WebhookFlagDeleted, Flag: flagset.Flag{Key: "old-checkout"}}, events[1])
+	})
+
+	for name, payload := range map[string]string{
+		"unknown type":       `{"type": "flag.renamed", "flag": {"key": "banner"}}`,
+		"missing key":        `{"type": "flag.deleted", "flag": {}}`,
+		"mismatched default": `{"type": "flag.created", "flag": {"key": "banner", "type": "boolean", "defaultValue": "yes"}}`,
+		"missing default":    `{"type": "flag.created", "flag": {"key": "banner", "type": "string"}}`,
+		"not JSON":           `flag changed`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := DecodeWebhookEvents(nil, []byte(payload))
+			assert.ErrorContains(t, err, "invalid webhook payload")
+		})
+	}
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(`{"type": "flag.deleted", "flag": {"key": "banner"}}`)
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(body)
+	signed := http.Header{}
+	signed.Set(WebhookSignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	assert.NoError(t, VerifyWebhookSignature("s3cret", signed, body))
+	assert.ErrorContains(t, VerifyWebhookSignature("other", signed, body), "does not match")
+	assert.ErrorContains(t, VerifyWebhookSignature("s3cret", signed, append(body, ' ')), "does not match")
+	assert.ErrorContains(t, VerifyWebhookSignature("s3cret", http.Header{}, body), "missing")
+}
+
+func TestApplyWebhookEvents(t *testing.T) {
+	fs := &flagset.Flagset{Flags: []flagset.Flag{
+		{Key: "banner", Type: flagset.StringType, DefaultValue: "Hi", Description: "Banner", Tags: []string{"ui"}},
+		{Key: "old-checkout", Type: flagset.BoolType, DefaultValue: false},
+		{Key: "retries", Type: flagset.IntType, DefaultValue: int64(3)},
+	}}
+
+	changes := ApplyWebhookEvents(fs, []WebhookEvent{
+		{Type: WebhookFlagChanged, Flag: flagset.Flag{Key: "banner", Type: flagset.StringType, DefaultValue: "Hello"}},
+		{Type: WebhookFlagDeleted, Flag: flagset.Flag{Key: "old-checkout"}},
+		{Type: WebhookFlagDeleted, Flag: flagset.Flag{Key: "missing"}},
+		{Type: WebhookFlagChanged, Flag: flagset.Flag{Key: "retries", Type: flagset.IntType, DefaultValue: int64(3)}},
+		{Type: WebhookFlagCreated, Flag: flagset.Flag{Key: "dark-mode", Type: flagset.BoolType, DefaultValue: true}},
+	})
+
+	assert.Equal(t, []string{"updated banner", "deleted old-checkout", "added dark-mode"}, changes)
+	assert.Equal(t, []flagset.Flag{
+		{Key: "banner", Type: flagset.StringType, DefaultValue: "Hello", Description: "Banner", Tags: []string{"ui"}},
+		{Key: "retries", Type: flagset.IntType, DefaultValue: int64(3)},
+		{Key: "dark-mode", Type: flagset.BoolType, DefaultValue: true},
+	}, fs.Flags)
+}
@@ -0,0 +1,279 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"gopkg.in/yaml.v3"
+)
+
+// IsYAML reports whether the manifest at the path is written in YAML rather than JSON, by the
+// extension of the path (.yaml or .yml)
+func IsYAML(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// ReadData reads the manifest file at the path as JSON. YAML and TOML manifests are converted to
+// JSON, keeping the order of their keys, so that the rest of the CLI handles every format alike.
+func ReadData(path string) ([]byte, error) {
+	data, err := filesystem.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ToJSON(path, data)
+}
+
+// ToJSON converts the contents of the manifest file at the path to JSON if the file is in YAML or
+// TOML, and returns JSON contents as they are
+func ToJSON(path string, data []byte) ([]byte, error) {
+	var doc *yaml.Node
+	switch {
+	case IsYAML(path):
+		doc = new(yaml.Node)
+		if err := yaml.Unmarshal(data, doc); err != nil {
+			return nil, fmt.Errorf("error parsing YAML manifest %s: %w", path, err)
+		}
+	case IsTOML(path):
+		var err error
+		if doc, err = parseTOML(data, false); err != nil {
+			return nil, fmt.Errorf("error parsing TOML manifest %s: %w", path, err)
+		}
+	default:
+		return data, nil
+	}
+	if len(doc.Content) == 0 {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	if err := writeJSONNode(&buf, doc.Content[0]); err != nil {
+		return nil, fmt.Errorf("error converting manifest %s: %w", path, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Render returns the contents WriteData writes for the JSON manifest data to the file at the path:
+// the data itself for JSON manifests, and the data converted to YAML or TOML for YAML and TOML
+// manifests. The comments of an existing manifest are kept on the keys and values that are still in
+// the data.
+func Render(path string, data []byte) ([]byte, error) {
+	if IsTOML(path) {
+		return renderTOML(path, data)
+	}
+	if !IsYAML(path) {
+		return data, nil
+	}
+
+	node, err := jsonToNode(json.NewDecoder(bytes.NewReader(data)))
+	if err != nil {
+		return nil, fmt.Errorf("error converting manifest to YAML: %w", err)
+	}
+	doc := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{node}}
+
+	if existing, err := filesystem.ReadFile(path); err == nil {
+		var old yaml.Node
+		if yaml.Unmarshal(existing, &old) == nil {
+			keepFormatting(&old, doc)
+		}
+	} else if schema := mappingValue(node, "$schema"); schema != nil {
+		// Editors with the YAML language server validate the new manifest against the schema
+		doc.HeadComment = "yaml-language-server: $schema=" + schema.Value
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("error converting manifest to YAML: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("error converting manifest to YAML: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// writeJSONNode writes a YAML node as JSON
+func writeJSONNode(buf *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return writeJSONNode(buf, node.Alias)
+	case yaml.MappingNode:
+		buf.WriteByte('{')
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			key, err := json.Marshal(node.Content[i].Value)
+			if err != nil {
+				return err
+			}
+			buf.Write(key)
+			buf.WriteByte(':')
+			if err := writeJSONNode(buf, node.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+		for i, item := range node.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSONNode(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	case yaml.ScalarNode:
+		var value any
+		switch node.ShortTag() {
+		case "!!null":
+			value = nil
+		case "!!bool", "!!int":
+			if err := node.Decode(&value); err != nil {
+				return err
+			}
+		case "!!float":
+			var f float64
+			if err := node.Decode(&f); err != nil {
+				return err
+			}
+			if math.IsInf(f, 0) || math.IsNaN(f) {
+				return fmt.Errorf("line %d: %s is not a JSON number", node.Line, node.Value)
+			}
+			value = f
+		default:
+			// Strings, and timestamps such as activeUntil dates, which the manifest holds as strings
+			value = node.Value
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		return nil
+	}
+	return fmt.Errorf("line %d: unsupported YAML node", node.Line)
+}
+
+// jsonToNode reads the next JSON value of the decoder as a YAML node, keeping the order of the keys
+func jsonToNode(dec *json.Decoder) (*yaml.Node, error) {
+	dec.UseNumber()
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token := token.(type) {
+	case json.Delim:
+		switch token {
+		case '{':
+			node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := jsonToNode(dec)
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key.(string)}, value)
+			}
+			_, err := dec.Token()
+			return node, err
+		case '[':
+			node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			for dec.More() {
+				item, err := jsonToNode(dec)
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, item)
+			}
+			_, err := dec.Token()
+			return node, err
+		}
+	case string:
+		node := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: token}
+		if strings.Contains(token, "\n") {
+			node.Style = yaml.LiteralStyle
+		}
+		return node, nil
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(token.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: token.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprint(token)}, nil
+	case nil:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+	return nil, errors.New("unexpected JSON token")
+}
+
+// keepFormatting copies the comments of the old YAML node to the new one, and to the keys and values
+// of the new node found at the same path in the old one. Unchanged scalars keep their style, so that
+// a date written without quotes stays without quotes, and mappings and sequences written in flow
+// style stay in flow style.
+func keepFormatting(old *yaml.Node, node *yaml.Node) {
+	if old.Kind == yaml.DocumentNode && node.Kind == yaml.DocumentNode {
+		node.HeadComment, node.FootComment = old.HeadComment, old.FootComment
+		if len(old.Content) > 0 && len(node.Content) > 0 {
+			keepFormatting(old.Content[0], node.Content[0])
+		}
+		return
+	}
+	if old.Kind == yaml.AliasNode {
+		old = old.Alias
+	}
+	node.HeadComment, node.LineComment, node.FootComment = old.HeadComment, old.LineComment, old.FootComment
+
+	switch {
+	case old.Kind == yaml.ScalarNode && node.Kind == yaml.ScalarNode && old.Value == node.Value &&
+		(old.ShortTag() == node.ShortTag() || old.ShortTag() == "!!timestamp" && node.ShortTag() == "!!str"):
+		node.Tag, node.Style = old.Tag, old.Style
+	case old.Kind == yaml.MappingNode && node.Kind == yaml.MappingNode:
+		node.Style = old.Style
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			for j := 0; j+1 < len(old.Content); j += 2 {
+				if old.Content[j].Value == node.Content[i].Value {
+					keepFormatting(old.Content[j], node.Content[i])
+					keepFormatting(old.Content[j+1], node.Content[i+1])
+					break
+				}
+			}
+		}
+	case old.Kind == yaml.SequenceNode && node.Kind == yaml.SequenceNode:
+		node.Style = old.Style
+		for i := 0; i < len(node.Content) && i < len(old.Content); i++ {
+			keepFormatting(old.Content[i], node.Content[i])
+		}
+	}
+}
+
+// mappingValue returns the value of the key in a YAML mapping node, or nil
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
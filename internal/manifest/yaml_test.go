@@ -0,0 +1,101 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const yamlManifest = `# Flags of the checkout service
+flags:
+  # Rolled out in Q3
+  new-checkout:
+    flagType: boolean
+    defaultValue: false # off until launch
+    description: "yes"
+    activeUntil: 2026-12-31
+  ratio:
+    flagType: float
+    defaultValue: 1.5
+  theme:
+    flagType: object
+    defaultValue:
+      colors: [blue, green]
+`
+
+func TestIsYAML(t *testing.T) {
+	assert.True(t, IsYAML("flags.yaml"))
+	assert.True(t, IsYAML("config/Flags.YML"))
+	assert.False(t, IsYAML("flags.json"))
+	assert.False(t, IsYAML("flags"))
+}
+
+func TestToJSON(t *testing.T) {
+	data, err := ToJSON("flags.yaml", []byte(yamlManifest))
+	require.NoError(t, err)
+	// The keys keep their order, and dates stay strings
+	assert.Equal(t, `{"flags":{"new-checkout":{"flagType":"boolean","defaultValue":false,"description":"yes","activeUntil":"2026-12-31"},"ratio":{"flagType":"float","defaultValue":1.5},"theme":{"flagType":"object","defaultValue":{"colors":["blue","green"]}}}}`, string(data))
+
+	data, err = ToJSON("flags.json", []byte(`{"flags": {}}`))
+	require.NoError(t, err)
+	assert.Equal(t, `{"flags": {}}`, string(data))
+
+	_, err = ToJSON("flags.yaml", []byte("flags: [unclosed"))
+	assert.ErrorContains(t, err, "error parsing YAML manifest flags.yaml")
+}
+
+func TestRenderYAML(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+	require.NoError(t, afero.WriteFile(fs, "flags.yaml", []byte(yamlManifest), 0o644))
+
+	t.Run("keeps the comments and style of the existing manifest", func(t *testing.T) {
+		data, err := ReadData("flags.yaml")
+		require.NoError(t, err)
+		edited, err := EditFlag(data, "new-checkout", []FieldEdit{{Name: "defaultValue", Value: true}})
+		require.NoError(t, err)
+
+		rendered, err := Render("flags.yaml", edited)
+		require.NoError(t, err)
+		assert.Equal(t, `# Flags of the checkout service
+flags:
+  # Rolled out in Q3
+  new-checkout:
+    flagType: boolean
+    defaultValue: true # off until launch
+    description: "yes"
+    activeUntil: 2026-12-31
+  ratio:
+    flagType: float
+    defaultValue: 1.5
+  theme:
+    flagType: object
+    defaultValue:
+      colors: [blue, green]
+`, string(rendered))
+	})
+
+	t.Run("new manifests reference the schema", func(t *testing.T) {
+		require.NoError(t, Create("new.yaml"))
+		data, err := afero.ReadFile(fs, "new.yaml")
+		require.NoError(t, err)
+		assert.Equal(t, `# yaml-language-server: $schema=`+flagManifestSchemaURL+`
+
+$schema: `+flagManifestSchemaURL+`
+flags: {}
+`, string(data))
+
+		fs, err := LoadFlagSet("new.yaml")
+		require.NoError(t, err)
+		assert.Empty(t, fs.Flags)
+	})
+
+	t.Run("JSON manifests are written as they are", func(t *testing.T) {
+		rendered, err := Render("flags.json", []byte(`{"flags": {}}`))
+		require.NoError(t, err)
+		assert.Equal(t, `{"flags": {}}`, string(rendered))
+	})
+}
@@ -0,0 +1,197 @@
+// Package report converts findings from manifest checks into the report
+// formats understood by CI platforms.
+package report
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// Format represents the available report formats
+type Format string
+
+const (
+	// FormatGitLab represents the GitLab Code Quality report format
+	FormatGitLab Format = "gitlab"
+	// FormatBitbucket represents the Bitbucket Code Insights report format
+	FormatBitbucket Format = "bitbucket"
+)
+
+// IsValidFormat checks if the given format is a valid report format
+func IsValidFormat(format string) bool {
+	switch Format(format) {
+	case FormatGitLab, FormatBitbucket:
+		return true
+	default:
+		return false
+	}
+}
+
+// GetValidFormats returns a list of all valid report formats
+func GetValidFormats() []string {
+	return []string{
+		string(FormatGitLab),
+		string(FormatBitbucket),
+	}
+}
+
+// Severity represents how serious a finding is
+type Severity string
+
+const (
+	// SeverityInfo is used for findings that do not fail a check
+	SeverityInfo Severity = "info"
+	// SeverityError is used for findings that fail a check
+	SeverityError Severity = "error"
+)
+
+// Finding is a single problem reported by a check
+type Finding struct {
+	// Check is the name of the check that produced the finding (e.g., validate, compare)
+	Check string `json:"check"`
+	// Severity is how serious the finding is
+	Severity Severity `json:"severity"`
+	// File is the file the finding applies to
+	File string `json:"file"`
+	// Line is the line in File the finding applies to, or 0 if unknown
+	Line int `json:"line,omitempty"`
+	// Path is the location of the finding inside the manifest (e.g., flags.my-flag)
+	Path string `json:"path"`
+	// Message describes the finding
+	Message string `json:"message"`
+}
+
+// Fingerprint returns a stable identifier for the finding, so that CI platforms
+// can track the same finding across runs.
+func (f Finding) Fingerprint() string {
+	sum := sha256.Sum256([]byte(f.Check + "|" + f.File + "|" + f.Path + "|" + f.Message))
+	return hex.EncodeToString(sum[:])
+}
+
+// line returns the line of the finding, defaulting to the first line when unknown
+func (f Finding) line() int {
+	if f.Line > 0 {
+		return f.Line
+	}
+	return 1
+}
+
+// Render renders the findings in the given format.
+// The title and passed values describe the overall run and are used by formats
+// that report a summary alongside the individual findings.
+func Render(format Format, title string, passed bool, findings []Finding) ([]byte, error) {
+	switch format {
+	case FormatGitLab:
+		return GitLabCodeQuality(findings)
+	case FormatBitbucket:
+		return BitbucketCodeInsights(title, passed, findings)
+	default:
+		return nil, fmt.Errorf("unsupported report format: %s", format)
+	}
+}
+
+type gitLabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitLabLocation `json:"location"`
+}
+
+type gitLabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitLabLines `json:"lines"`
+}
+
+type gitLabLines struct {
+	Begin int `json:"begin"`
+}
+
+// GitLabCodeQuality renders the findings as a GitLab Code Quality report.
+// Ref: https://docs.gitlab.com/ci/testing/code_quality/#code-quality-report-format
+func GitLabCodeQuality(findings []Finding) ([]byte, error) {
+	issues := make([]gitLabIssue, 0, len(findings))
+	for _, finding := range findings {
+		severity := "major"
+		if finding.Severity == SeverityInfo {
+			severity = "info"
+		}
+
+		issues = append(issues, gitLabIssue{
+			Description: fmt.Sprintf("%s: %s", finding.Path, finding.Message),
+			CheckName:   "openfeature/" + finding.Check,
+			Fingerprint: finding.Fingerprint(),
+			Severity:    severity,
+			Location: gitLabLocation{
+				Path:  finding.File,
+				Lines: gitLabLines{Begin: finding.line()},
+			},
+		})
+	}
+
+	return json.MarshalIndent(issues, "", "  ")
+}
+
+type bitbucketReport struct {
+	Report      bitbucketSummary      `json:"report"`
+	Annotations []bitbucketAnnotation `json:"annotations"`
+}
+
+type bitbucketSummary struct {
+	Title      string `json:"title"`
+	Details    string `json:"details"`
+	ReportType string `json:"report_type"`
+	Reporter   string `json:"reporter"`
+	Result     string `json:"result"`
+}
+
+type bitbucketAnnotation struct {
+	ExternalID     string `json:"external_id"`
+	AnnotationType string `json:"annotation_type"`
+	Summary        string `json:"summary"`
+	Severity       string `json:"severity"`
+	Path           string `json:"path"`
+	Line           int    `json:"line"`
+}
+
+// BitbucketCodeInsights renders the findings as a Bitbucket Code Insights report.
+// The output contains the report in the "report" field and its annotations in the
+// "annotations" field, matching the payloads of the two Code Insights API calls.
+// Ref: https://support.atlassian.com/bitbucket-cloud/docs/code-insights/
+func BitbucketCodeInsights(title string, passed bool, findings []Finding) ([]byte, error) {
+	result := "PASSED"
+	if !passed {
+		result = "FAILED"
+	}
+
+	report := bitbucketReport{
+		Report: bitbucketSummary{
+			Title:      title,
+			Details:    fmt.Sprintf("%d finding(s) reported by the OpenFeature CLI", len(findings)),
+			ReportType: "TEST",
+			Reporter:   "OpenFeature CLI",
+			Result:     result,
+		},
+		Annotations: make([]bitbucketAnnotation, 0, len(findings)),
+	}
+
+	for _, finding := range findings {
+		severity := "HIGH"
+		if finding.Severity == SeverityInfo {
+			severity = "LOW"
+		}
+
+		report.Annotations = append(report.Annotations, bitbucketAnnotation{
+			ExternalID:     finding.Fingerprint(),
+			AnnotationType: "BUG",
+			Summary:        fmt.Sprintf("%s: %s", finding.Path, finding.Message),
+			Severity:       severity,
+			Path:           finding.File,
+			Line:           finding.line(),
+		})
+	}
+
+	return json.MarshalIndent(report, "", "  ")
+}
@@ -0,0 +1,82 @@
+package report
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testFindings = []Finding{
+	{
+		Check:    "validate",
+		Severity: SeverityError,
+		File:     "flags.json",
+		Path:     "flags.broken-flag",
+		Message:  "defaultValue is required",
+	},
+	{
+		Check:    "compare",
+		Severity: SeverityInfo,
+		File:     "flags.json",
+		Line:     12,
+		Path:     "flags.new-flag",
+		Message:  "flag added compared to main.json",
+	},
+}
+
+func TestGitLabCodeQuality(t *testing.T) {
+	data, err := Render(FormatGitLab, "checks", false, testFindings)
+	require.NoError(t, err)
+
+	var issues []map[string]any
+	require.NoError(t, json.Unmarshal(data, &issues))
+	require.Len(t, issues, 2)
+
+	assert.Equal(t, "openfeature/validate", issues[0]["check_name"])
+	assert.Equal(t, "major", issues[0]["severity"])
+	assert.Equal(t, "flags.broken-flag: defaultValue is required", issues[0]["description"])
+	assert.Equal(t, testFindings[0].Fingerprint(), issues[0]["fingerprint"])
+	assert.Equal(t, map[string]any{"path": "flags.json", "lines": map[string]any{"begin": float64(1)}}, issues[0]["location"])
+
+	assert.Equal(t, "info", issues[1]["severity"])
+	assert.Equal(t, float64(12), issues[1]["location"].(map[string]any)["lines"].(map[string]any)["begin"])
+}
+
+func TestGitLabCodeQuality_NoFindings(t *testing.T) {
+	data, err := GitLabCodeQuality(nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, "[]", string(data), "GitLab expects an empty array when there are no findings")
+}
+
+func TestBitbucketCodeInsights(t *testing.T) {
+	data, err := Render(FormatBitbucket, "OpenFeature manifest checks", false, testFindings)
+	require.NoError(t, err)
+
+	var out struct {
+		Report      map[string]any   `json:"report"`
+		Annotations []map[string]any `json:"annotations"`
+	}
+	require.NoError(t, json.Unmarshal(data, &out))
+
+	assert.Equal(t, "OpenFeature manifest checks", out.Report["title"])
+	assert.Equal(t, "FAILED", out.Report["result"])
+	assert.Equal(t, "TEST", out.Report["report_type"])
+
+	require.Len(t, out.Annotations, 2)
+	assert.Equal(t, "HIGH", out.Annotations[0]["severity"])
+	assert.Equal(t, "LOW", out.Annotations[1]["severity"])
+	assert.Equal(t, "flags.json", out.Annotations[0]["path"])
+	assert.Equal(t, testFindings[1].Fingerprint(), out.Annotations[1]["external_id"])
+}
+
+func TestRender_UnsupportedFormat(t *testing.T) {
+	_, err := Render(Format("sarif"), "checks", true, nil)
+	assert.EqualError(t, err, "unsupported report format: sarif")
+}
+
+func TestFingerprintIsStable(t *testing.T) {
+	assert.Equal(t, testFindings[0].Fingerprint(), testFindings[0].Fingerprint())
+	assert.NotEqual(t, testFindings[0].Fingerprint(), testFindings[1].Fingerprint())
+}
@@ -0,0 +1,86 @@
+// Package terminal provides output helpers for interactive terminals: copying results to the
+// clipboard and making file references clickable.
+package terminal
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// clipboardCommand is a command that copies its standard input to the clipboard
+type clipboardCommand struct {
+	name string
+	args []string
+}
+
+// osc52MaxLength is the length from which some terminals ignore OSC 52 sequences
+const osc52MaxLength = 100_000
+
+// Copy copies the text to the clipboard with the first clipboard command available: pbcopy on
+// macOS, PowerShell or clip on Windows, and wl-copy, xclip, xsel or clip.exe (WSL) elsewhere.
+// Without one, the text is sent to the terminal as an OSC 52 sequence, which most terminals
+// support, including over SSH.
+func Copy(text string) error {
+	for _, command := range clipboardCommands(runtime.GOOS, os.Getenv) {
+		path, err := exec.LookPath(command.name)
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(path, command.args...)
+		cmd.Stdin = strings.NewReader(text)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("error copying to the clipboard with %s: %w: %s", command.name, err, strings.TrimSpace(string(out)))
+		}
+		return nil
+	}
+
+	if !term.IsTerminal(int(os.Stderr.Fd())) {
+		return errors.New("no clipboard available: install wl-copy, xclip or xsel, or run in a terminal")
+	}
+	return writeOSC52(os.Stderr, text)
+}
+
+// clipboardCommands returns the clipboard commands to try on the operating system, in order
+func clipboardCommands(goos string, getenv func(string) string) []clipboardCommand {
+	switch goos {
+	case "darwin":
+		return []clipboardCommand{{name: "pbcopy"}}
+	case "windows":
+		return []clipboardCommand{
+			// clip mangles non-ASCII text, so PowerShell reads the text as UTF-8 first
+			{name: "powershell", args: []string{"-NoProfile", "-Command",
+				"[Console]::InputEncoding = [Text.Encoding]::UTF8; Set-Clipboard -Value ([Console]::In.ReadToEnd())"}},
+			{name: "clip"},
+		}
+	}
+
+	var commands []clipboardCommand
+	if getenv("WAYLAND_DISPLAY") != "" {
+		commands = append(commands, clipboardCommand{name: "wl-copy"})
+	}
+	if getenv("DISPLAY") != "" {
+		commands = append(commands,
+			clipboardCommand{name: "xclip", args: []string{"-selection", "clipboard"}},
+			clipboardCommand{name: "xsel", args: []string{"--clipboard", "--input"}},
+		)
+	}
+	return append(commands, clipboardCommand{name: "clip.exe"})
+}
+
+// writeOSC52 asks the terminal to copy the text to the clipboard
+func writeOSC52(w io.Writer, text string) error {
+	encoded := base64.StdEncoding.EncodeToString([]byte(text))
+	if len(encoded) > osc52MaxLength {
+		return fmt.Errorf("no clipboard available and the text is too long to copy through the terminal")
+	}
+	_, err := fmt.Fprintf(w, "\x1b]52;c;%s\a", encoded)
+	return err
+}
@@ -0,0 +1,43 @@
+package terminal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClipboardCommands(t *testing.T) {
+	tests := []struct {
+		name string
+		goos string
+		env  map[string]string
+		want []string
+	}{
+		{name: "macOS", goos: "darwin", want: []string{"pbcopy"}},
+		{name: "Windows", goos: "windows", want: []string{"powershell", "clip"}},
+		{name: "Wayland", goos: "linux", env: map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, want: []string{"wl-copy", "clip.exe"}},
+		{name: "X11", goos: "freebsd", env: map[string]string{"DISPLAY": ":0"}, want: []string{"xclip", "xsel", "clip.exe"}},
+		{name: "headless or WSL", goos: "linux", want: []string{"clip.exe"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var names []string
+			for _, command := range clipboardCommands(tt.goos, func(key string) string { return tt.env[key] }) {
+				names = append(names, command.name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestWriteOSC52(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, writeOSC52(&out, "new-checkout"))
+	assert.Equal(t, "\x1b]52;c;bmV3LWNoZWNrb3V0\a", out.String())
+
+	err := writeOSC52(&out, string(make([]byte, osc52MaxLength)))
+	assert.ErrorContains(t, err, "too long")
+}
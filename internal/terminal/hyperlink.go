@@ -0,0 +1,62 @@
+package terminal
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// ForceHyperlinkEnvVar turns terminal hyperlinks on (1) or off (0) regardless of the terminal
+const ForceHyperlinkEnvVar = "FORCE_HYPERLINK"
+
+// hyperlinks is whether file references are printed as terminal hyperlinks
+var hyperlinks = hyperlinksSupported(os.Getenv, term.IsTerminal(int(os.Stdout.Fd())))
+
+// SetHyperlinks turns terminal hyperlinks on or off
+func SetHyperlinks(enabled bool) {
+	hyperlinks = enabled
+}
+
+// hyperlinksSupported checks if hyperlinks can be printed: standard output is a terminal that
+// is not dumb, and colors are not turned off with NO_COLOR. FORCE_HYPERLINK overrides the check.
+func hyperlinksSupported(getenv func(string) string, isTerminal bool) bool {
+	if force := getenv(ForceHyperlinkEnvVar); force != "" {
+		enabled, err := strconv.ParseBool(force)
+		return err == nil && enabled
+	}
+	return isTerminal && getenv("TERM") != "dumb" && getenv("NO_COLOR") == ""
+}
+
+// Hyperlink wraps the text in an OSC 8 hyperlink to the URL, which terminals that support it
+// make clickable. Other terminals show the text as is.
+func Hyperlink(target string, text string) string {
+	return fmt.Sprintf("\x1b]8;;%s\x1b\\%s\x1b]8;;\x1b\\", target, text)
+}
+
+// FileLink formats a file:line reference, as a hyperlink to the file when hyperlinks are enabled
+func FileLink(path string, line int) string {
+	text := fmt.Sprintf("%s:%d", path, line)
+	if !hyperlinks {
+		return text
+	}
+	return Hyperlink(FileURL(path), text)
+}
+
+// FileURL returns the file URL of a path, made absolute relative to the working directory
+func FileURL(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		// Windows paths start with a drive letter
+		path = "/" + path
+	}
+	host, _ := os.Hostname()
+	return (&url.URL{Scheme: "file", Host: host, Path: path}).String()
+}
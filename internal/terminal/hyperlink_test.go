@@ -0,0 +1,44 @@
+package terminal
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHyperlinksSupported(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        map[string]string
+		isTerminal bool
+		want       bool
+	}{
+		{name: "terminal", env: map[string]string{"TERM": "xterm-256color"}, isTerminal: true, want: true},
+		{name: "piped output", env: map[string]string{"TERM": "xterm-256color"}},
+		{name: "dumb terminal", env: map[string]string{"TERM": "dumb"}, isTerminal: true},
+		{name: "NO_COLOR", env: map[string]string{"NO_COLOR": "1"}, isTerminal: true},
+		{name: "forced on", env: map[string]string{ForceHyperlinkEnvVar: "1"}, want: true},
+		{name: "forced off", env: map[string]string{ForceHyperlinkEnvVar: "0"}, isTerminal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hyperlinksSupported(func(key string) string { return tt.env[key] }, tt.isTerminal))
+		})
+	}
+}
+
+func TestFileLink(t *testing.T) {
+	defer SetHyperlinks(hyperlinks)
+
+	SetHyperlinks(false)
+	assert.Equal(t, "src/main.go:12", FileLink("src/main.go", 12))
+
+	SetHyperlinks(true)
+	link := FileLink("src/main.go", 12)
+	abs, _ := filepath.Abs("src/main.go")
+	assert.True(t, strings.HasPrefix(link, "\x1b]8;;file://"), link)
+	assert.Contains(t, link, filepath.ToSlash(abs)+"\x1b\\src/main.go:12\x1b]8;;\x1b\\")
+}
@@ -0,0 +1,88 @@
+package usage
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+// UnownedArea is the name of the area of references that no team mapping matches
+const UnownedArea = "(unowned)"
+
+// Area aggregates the references in one part of the codebase
+type Area struct {
+	// Name is the directory or team the references belong to
+	Name string `json:"name"`
+	// References is the number of references in the area
+	References int `json:"references"`
+	// Flags are the distinct flag keys referenced in the area, sorted
+	Flags []string `json:"flags"`
+}
+
+// ByDirectory groups references by their directory, truncated to the given depth
+// (e.g., depth 1 groups src/api/client.go under src). Files at the root are grouped under ".".
+func ByDirectory(refs []Reference, depth int) []Area {
+	return aggregate(refs, func(ref Reference) string {
+		dir := path.Dir(ref.File)
+		if dir == "." || depth <= 0 {
+			return dir
+		}
+
+		parts := strings.Split(dir, "/")
+		if len(parts) > depth {
+			parts = parts[:depth]
+		}
+		return strings.Join(parts, "/")
+	})
+}
+
+// ByTeam groups references by the team owning their file. Teams map a team name to the
+// directories it owns; the longest matching directory wins. References in files no team
+// owns are grouped under UnownedArea.
+func ByTeam(refs []Reference, teams map[string][]string) []Area {
+	return aggregate(refs, func(ref Reference) string {
+		owner, longest := UnownedArea, -1
+		for team, dirs := range teams {
+			for _, dir := range dirs {
+				dir = strings.TrimSuffix(path.Clean(dir), "/")
+				if (ref.File == dir || strings.HasPrefix(ref.File, dir+"/")) && len(dir) > longest {
+					owner, longest = team, len(dir)
+				}
+			}
+		}
+		return owner
+	})
+}
+
+// aggregate groups references by the area returned by areaOf, sorted by the number of references,
+// most referenced first
+func aggregate(refs []Reference, areaOf func(Reference) string) []Area {
+	areas := map[string]*Area{}
+	flags := map[string]map[string]bool{}
+	for _, ref := range refs {
+		name := areaOf(ref)
+		if areas[name] == nil {
+			areas[name] = &Area{Name: name}
+			flags[name] = map[string]bool{}
+		}
+		areas[name].References++
+		if !flags[name][ref.Key] {
+			flags[name][ref.Key] = true
+			areas[name].Flags = append(areas[name].Flags, ref.Key)
+		}
+	}
+
+	result := make([]Area, 0, len(areas))
+	for _, area := range areas {
+		sort.Strings(area.Flags)
+		result = append(result, *area)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].References != result[j].References {
+			return result[i].References > result[j].References
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
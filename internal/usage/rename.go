@@ -0,0 +1,80 @@
+package usage
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"github.com/open-feature/cli/internal/filesystem"
+)
+
+// RenamedFile is a file whose references were renamed
+type RenamedFile struct {
+	// File is the path of the file, relative to the scanned directory
+	File string
+	// Original and Renamed are the contents of the file before and after the renames
+	Original []byte
+	Renamed  []byte
+}
+
+// Rename replaces the references found by Scan in the directory at root with the new keys of the
+// renamed flags, mapped from the old to the new key. Only the quoted literals on the lines of the
+// references are replaced. Returns the files that changed, relative to root.
+func Rename(root string, refs []Reference, renames map[string]string) ([]string, error) {
+	renamed, err := PreviewRename(root, refs, renames)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(renamed))
+	for _, file := range renamed {
+		if err := filesystem.WriteFile(filepath.Join(root, filepath.FromSlash(file.File)), file.Renamed); err != nil {
+			return nil, err
+		}
+		files = append(files, file.File)
+	}
+	return files, nil
+}
+
+// PreviewRename returns the files of the directory at root as Rename would change them, without
+// writing them
+func PreviewRename(root string, refs []Reference, renames map[string]string) ([]RenamedFile, error) {
+	lines := make(map[string]map[int][]string)
+	var files []string
+	for _, ref := range refs {
+		if _, ok := renames[ref.Key]; !ok {
+			continue
+		}
+		if lines[ref.File] == nil {
+			lines[ref.File] = make(map[int][]string)
+			files = append(files, ref.File)
+		}
+		lines[ref.File][ref.Line] = append(lines[ref.File][ref.Line], ref.Key)
+	}
+
+	renamed := make([]RenamedFile, 0, len(files))
+	for _, file := range files {
+		data, err := filesystem.ReadFile(filepath.Join(root, filepath.FromSlash(file)))
+		if err != nil {
+			return nil, err
+		}
+
+		// The line endings are kept, so the rest of the file is left as it is
+		content := bytes.SplitAfter(data, []byte("\n"))
+		for line, keys := range lines[file] {
+			if line > len(content) {
+				continue
+			}
+			text := string(content[line-1])
+			for _, key := range keys {
+				for _, quote := range quotes {
+					text = strings.ReplaceAll(text, quote+key+quote, quote+renames[key]+quote)
+				}
+			}
+			content[line-1] = []byte(text)
+		}
+
+		renamed = append(renamed, RenamedFile{File: file, Original: data, Renamed: bytes.Join(content, nil)})
+	}
+	return renamed, nil
+}
@@ -0,0 +1,141 @@
+// Package usage finds references to flag keys in source code and aggregates them
+// into a view of which parts of a codebase depend most on flags.
+package usage
+
+import (
+	"bufio"
+	"bytes"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+)
+
+// maxFileSize is the size above which files are not scanned, as they are unlikely to be source code
+const maxFileSize = 1 << 20
+
+// skippedDirs are directories that never contain first party source code
+var skippedDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+}
+
+// quotes are the characters a flag key is enclosed in when it is referenced as a string literal
+var quotes = []string{`"`, `'`, "`"}
+
+// Reference is a single reference to a flag key in a file
+type Reference struct {
+	// Key is the referenced flag key
+	Key string `json:"key"`
+	// File is the path of the file, relative to the scanned directory
+	File string `json:"file"`
+	// Line is the line of the reference, starting at 1
+	Line int `json:"line"`
+}
+
+// Options holds options for scanning a directory
+type Options struct {
+	// Exclude lists paths, relative to the scanned directory, that are not scanned (e.g., the manifest itself)
+	Exclude []string
+	// SkipGenerated skips the files generated by the CLI, recognized by their header, which reference
+	// every flag of the manifest they were generated from
+	SkipGenerated bool
+}
+
+// generatedMarker is in the header of the files generated by the CLI, in any case
+const generatedMarker = "generated by openfeature cli"
+
+// isGenerated reports whether the file was generated by the CLI, based on its first lines
+func isGenerated(data []byte) bool {
+	header := data[:min(len(data), 512)]
+	return bytes.Contains(bytes.ToLower(header), []byte(generatedMarker))
+}
+
+// Scan walks the directory at root and returns the references to the given flag keys,
+// sorted by file and line. A reference is a flag key enclosed in quotes, as in a string literal.
+// Hidden directories, dependency directories, binary files and large files are skipped.
+func Scan(root string, keys []string, opts Options) ([]Reference, error) {
+	excluded := make(map[string]bool, len(opts.Exclude))
+	for _, path := range opts.Exclude {
+		excluded[filepath.Clean(path)] = true
+	}
+
+	var refs []Reference
+	err := afero.Walk(filesystem.FileSystem(), root, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if rel != "." && (strings.HasPrefix(info.Name(), ".") || skippedDirs[info.Name()]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if excluded[rel] || info.Size() > maxFileSize {
+			return nil
+		}
+
+		data, err := filesystem.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if opts.SkipGenerated && isGenerated(data) {
+			return nil
+		}
+		refs = append(refs, scanFile(filepath.ToSlash(rel), data, keys)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].File != refs[j].File {
+			return refs[i].File < refs[j].File
+		}
+		return refs[i].Line < refs[j].Line
+	})
+
+	return refs, nil
+}
+
+// scanFile returns the references to the given flag keys in the content of a file
+func scanFile(file string, data []byte, keys []string) []Reference {
+	// Skip binary files
+	if bytes.IndexByte(data[:min(len(data), 8000)], 0) >= 0 {
+		return nil
+	}
+
+	var refs []Reference
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), maxFileSize)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		for _, key := range keys {
+			if containsLiteral(text, key) {
+				refs = append(refs, Reference{Key: key, File: file, Line: line})
+			}
+		}
+	}
+
+	return refs
+}
+
+// containsLiteral reports whether the text contains the key as a quoted string literal
+func containsLiteral(text string, key string) bool {
+	for _, quote := range quotes {
+		if strings.Contains(text, quote+key+quote) {
+			return true
+		}
+	}
+	return false
+}
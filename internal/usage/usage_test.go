@@ -0,0 +1,112 @@
+package usage
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/internal/filesystem"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCodebase(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	filesystem.SetFileSystem(fs)
+
+	files := map[string]string{
+		"flags.json":                  `{"flags": {"new-checkout": {}}}`,
+		"main.go":                     "client.Boolean(ctx, \"new-checkout\", false, nil)\n",
+		"services/payments/pay.go":    "a := client.Boolean(ctx, \"new-checkout\", false, nil)\nb := client.String(ctx, \"greeting\", \"\", nil)\n",
+		"services/search/find.ts":     "client.getBooleanValue('new-checkout', false)\n// new-checkout is not a literal here\n",
+		"web/app.js":                  "useFlag(`greeting`)\n",
+		"node_modules/lib/index.js":   "useFlag('greeting')\n",
+		".git/config":                 "\"greeting\"\n",
+		"services/search/binary.data": "\x00\"greeting\"",
+		"openfeature/openfeature.go":  "// Code generated by OpenFeature CLI. DO NOT EDIT.\n\nconst Greeting = \"greeting\"\n",
+	}
+	for path, content := range files {
+		require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0o644))
+	}
+}
+
+func TestScan(t *testing.T) {
+	setupCodebase(t)
+
+	refs, err := Scan(".", []string{"new-checkout", "greeting"}, Options{Exclude: []string{"flags.json"}, SkipGenerated: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Reference{
+		{Key: "new-checkout", File: "main.go", Line: 1},
+		{Key: "new-checkout", File: "services/payments/pay.go", Line: 1},
+		{Key: "greeting", File: "services/payments/pay.go", Line: 2},
+		{Key: "new-checkout", File: "services/search/find.ts", Line: 1},
+		{Key: "greeting", File: "web/app.js", Line: 1},
+	}, refs)
+
+	refs, err = Scan(".", []string{"greeting"}, Options{Exclude: []string{"flags.json"}})
+	require.NoError(t, err)
+	assert.Contains(t, refs, Reference{Key: "greeting", File: "openfeature/openfeature.go", Line: 3}, "generated files are scanned unless skipped")
+}
+
+func TestByDirectory(t *testing.T) {
+	refs := []Reference{
+		{Key: "a", File: "main.go"},
+		{Key: "a", File: "services/payments/pay.go"},
+		{Key: "b", File: "services/payments/pay.go"},
+		{Key: "a", File: "services/search/find.go"},
+	}
+
+	assert.Equal(t, []Area{
+		{Name: "services", References: 3, Flags: []string{"a", "b"}},
+		{Name: ".", References: 1, Flags: []string{"a"}},
+	}, ByDirectory(refs, 1))
+
+	assert.Equal(t, []Area{
+		{Name: "services/payments", References: 2, Flags: []string{"a", "b"}},
+		{Name: ".", References: 1, Flags: []string{"a"}},
+		{Name: "services/search", References: 1, Flags: []string{"a"}},
+	}, ByDirectory(refs, 2))
+}
+
+func TestByTeam(t *testing.T) {
+	refs := []Reference{
+		{Key: "a", File: "main.go"},
+		{Key: "a", File: "services/payments/pay.go"},
+		{Key: "b", File: "services/payments/refunds/refund.go"},
+		{Key: "a", File: "services/search/find.go"},
+	}
+	teams := map[string][]string{
+		"platform": {"services"},
+		"payments": {"services/payments/"},
+	}
+
+	assert.Equal(t, []Area{
+		{Name: "payments", References: 2, Flags: []string{"a", "b"}},
+		{Name: UnownedArea, References: 1, Flags: []string{"a"}},
+		{Name: "platform", References: 1, Flags: []string{"a"}},
+	}, ByTeam(refs, teams))
+}
+
+func TestRename(t *testing.T) {
+	setupCodebase(t)
+	renames := map[string]string{"new-checkout": "checkout-v2"}
+
+	refs, err := Scan(".", []string{"new-checkout"}, Options{Exclude: []string{"flags.json"}})
+	require.NoError(t, err)
+	files, err := Rename(".", refs, renames)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main.go", "services/payments/pay.go", "services/search/find.ts"}, files)
+
+	content, err := filesystem.ReadFile("services/payments/pay.go")
+	require.NoError(t, err)
+	assert.Equal(t, "a := client.Boolean(ctx, \"checkout-v2\", false, nil)\nb := client.String(ctx, \"greeting\", \"\", nil)\n", string(content))
+
+	// Only literals are renamed
+	content, err = filesystem.ReadFile("services/search/find.ts")
+	require.NoError(t, err)
+	assert.Equal(t, "client.getBooleanValue('checkout-v2', false)\n// new-checkout is not a literal here\n", string(content))
+
+	content, err = filesystem.ReadFile("flags.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"flags": {"new-checkout": {}}}`, string(content))
+}
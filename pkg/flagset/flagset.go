@@ -0,0 +1,52 @@
+// Package flagset exposes the in-memory representation of the flags defined in
+// a flag manifest. It is the type shared by the manifest and generate packages.
+package flagset
+
+import "github.com/open-feature/cli/internal/flagset"
+
+// Flag is a single feature flag.
+type Flag = flagset.Flag
+
+// Flagset is a collection of feature flags, sorted by key when read from a manifest.
+type Flagset = flagset.Flagset
+
+// Experiment holds optional metadata about an experiment that a flag is part of.
+type Experiment = flagset.Experiment
+
+// Compliance holds optional data protection metadata about a flag.
+type Compliance = flagset.Compliance
+
+// Cache holds optional hints on how long generated clients may cache evaluations of a flag.
+type Cache = flagset.Cache
+
+// FlagType is the primitive type of a flag.
+type FlagType = flagset.FlagType
+
+// Collection of the different kinds of flag types
+const (
+	UnknownFlagType = flagset.UnknownFlagType
+	IntType         = flagset.IntType
+	FloatType       = flagset.FloatType
+	BoolType        = flagset.BoolType
+	StringType      = flagset.StringType
+	ObjectType      = flagset.ObjectType
+)
+
+// Visibilities of a flag, set in Flag.Visibility
+const (
+	VisibilityClient = flagset.VisibilityClient
+	VisibilityServer = flagset.VisibilityServer
+	VisibilityBoth   = flagset.VisibilityBoth
+)
+
+// Risks of changing a flag, set in Flag.Risk
+const (
+	RiskLow    = flagset.RiskLow
+	RiskMedium = flagset.RiskMedium
+	RiskHigh   = flagset.RiskHigh
+)
+
+// ParseFlagType converts a flag type as written in a manifest (e.g., boolean) to a FlagType.
+func ParseFlagType(typeStr string) (FlagType, error) {
+	return flagset.ParseFlagType(typeStr)
+}
@@ -0,0 +1,185 @@
+// Package generate produces typesafe flag accessors from a flagset, using the
+// same generators and templates as the 'openfeature generate' command.
+//
+// Generated files are written to the local file system.
+package generate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/open-feature/cli/internal/config"
+	"github.com/open-feature/cli/internal/generators"
+	"github.com/open-feature/cli/internal/generators/angular"
+	"github.com/open-feature/cli/internal/generators/csharp"
+	"github.com/open-feature/cli/internal/generators/edge"
+	"github.com/open-feature/cli/internal/generators/env"
+	"github.com/open-feature/cli/internal/generators/golang"
+	"github.com/open-feature/cli/internal/generators/java"
+	"github.com/open-feature/cli/internal/generators/nestjs"
+	"github.com/open-feature/cli/internal/generators/nodejs"
+	"github.com/open-feature/cli/internal/generators/python"
+	"github.com/open-feature/cli/internal/generators/react"
+	"github.com/open-feature/cli/internal/generators/reactnative"
+	"github.com/open-feature/cli/internal/generators/snapshot"
+	"github.com/open-feature/cli/internal/generators/spreadsheet"
+	"github.com/open-feature/cli/internal/generators/vue"
+	"github.com/open-feature/cli/pkg/flagset"
+)
+
+// Language is a target language of the generated code
+type Language string
+
+const (
+	Angular     Language = "angular"
+	CSharp      Language = "csharp"
+	Go          Language = "go"
+	Java        Language = "java"
+	NestJS      Language = "nestjs"
+	NodeJS      Language = "nodejs"
+	Python      Language = "python"
+	React       Language = "react"
+	ReactNative Language = "react-native"
+	Vue         Language = "vue"
+	Edge        Language = "edge"
+	// Snapshot is a provider serving the default values of the manifest, in Options.ProviderLanguage
+	Snapshot Language = "snapshot"
+	// Env is a provider reading the flag values from environment variables, in Options.ProviderLanguage
+	Env Language = "env"
+	// CSV and XLSX are inventories of the flags as a CSV file and an Excel workbook
+	CSV  Language = "csv"
+	XLSX Language = "xlsx"
+)
+
+// Languages returns all supported languages
+func Languages() []Language {
+	return []Language{Angular, CSharp, Go, Java, NestJS, NodeJS, Python, React, ReactNative, Vue, Edge, Snapshot, Env, CSV, XLSX}
+}
+
+// Options holds the options for generating code
+type Options struct {
+	// OutputPath is the directory the generated files are written to
+	OutputPath string
+	// TemplatePath is an optional path to a custom template replacing the default one
+	TemplatePath string
+	// Package is the Go package, Java package or C# namespace of the generated code.
+	// The defaults of the CLI are used when empty. It is ignored for other languages.
+	Package string
+	// Version is the version of the CLI written in the headers of the generated files. It is left
+	// out of the headers when empty.
+	Version string
+	// ManifestHash is the hex-encoded SHA-256 of the manifest, written in the headers of the generated
+	// files. It is left out of the headers when empty.
+	ManifestHash string
+	// DeprecateAsOf is the time DeprecateWithin is counted from. When zero, the accessors of every
+	// flag with an activeUntil time are marked deprecated.
+	DeprecateAsOf time.Time
+	// DeprecateWithin is how long before their activeUntil time, as of DeprecateAsOf, the accessors
+	// of flags are marked deprecated. With 0, only the flags expired as of DeprecateAsOf are.
+	DeprecateWithin time.Duration
+	// ProviderLanguage is the language of the Snapshot and Env providers: go (the default), nodejs,
+	// or web for Snapshot. It is ignored for other languages.
+	ProviderLanguage string
+	// EnvPrefix is the prefix of the environment variables read by the Env provider. The default of
+	// the CLI is used when empty.
+	EnvPrefix string
+	// ExcludeTags are the tags of the flags to leave out of the generated code. It is ignored for the
+	// CSV and XLSX inventories.
+	ExcludeTags []string
+}
+
+// Generate generates the flag accessors for the given language. Like the CLI, it leaves out the
+// flags with "codegen": false or one of Options.ExcludeTags, and the server-only flags from code
+// running on the client. The CSV and XLSX inventories list every flag.
+func Generate(language Language, fs *flagset.Flagset, opts Options) error {
+	if language != CSV && language != XLSX {
+		fs = fs.ForCodegen(opts.ExcludeTags)
+	}
+	switch language {
+	case Angular:
+		return angular.NewGenerator(fs).Generate(newParams(opts, angular.Params{}))
+	case CSharp:
+		return csharp.NewGenerator(fs).Generate(newParams(opts, csharp.Params{
+			Namespace:    valueOrDefault(opts.Package, config.DefaultCSharpNamespace),
+			DIExtensions: true,
+			Nullable:     true,
+			XMLDocs:      true,
+		}))
+	case Go:
+		return golang.NewGenerator(fs).Generate(newParams(opts, golang.Params{
+			GoPackage:  valueOrDefault(opts.Package, config.DefaultGoPackageName),
+			CLIVersion: opts.Version,
+		}))
+	case Java:
+		return java.NewGenerator(fs).Generate(newParams(opts, java.Params{JavaPackage: valueOrDefault(opts.Package, config.DefaultJavaPackageName)}))
+	case NestJS:
+		// The NestJS decorators depend on the generated Node.js client
+		if err := nestjs.NewGenerator(fs).Generate(newParams(opts, nestjs.Params{})); err != nil {
+			return err
+		}
+		// The custom template replaces the NestJS decorators, not the Node.js client
+		nodejsParams := newParams(opts, nodejs.Params{})
+		nodejsParams.TemplatePath = ""
+		return nodejs.NewGenerator(fs).Generate(nodejsParams)
+	case NodeJS:
+		return nodejs.NewGenerator(fs).Generate(newParams(opts, nodejs.Params{}))
+	case Python:
+		return python.NewGenerator(fs).Generate(newParams(opts, python.Params{}))
+	case React:
+		return react.NewGenerator(fs).Generate(newParams(opts, react.Params{}))
+	case ReactNative:
+		return reactnative.NewGenerator(fs).Generate(newParams(opts, reactnative.Params{}))
+	case Vue:
+		return vue.NewGenerator(fs).Generate(newParams(opts, vue.Params{}))
+	case Edge:
+		return edge.NewGenerator(fs).Generate(newParams(opts, edge.Params{}))
+	case Snapshot:
+		providerLanguage := valueOrDefault(opts.ProviderLanguage, snapshot.LanguageGo)
+		if !slices.Contains(snapshot.GetValidLanguages(), providerLanguage) {
+			return fmt.Errorf("invalid provider language: %q. Valid languages are: %s", providerLanguage, strings.Join(snapshot.GetValidLanguages(), ", "))
+		}
+		return snapshot.NewGenerator(fs).Generate(newParams(opts, snapshot.Params{
+			Language:  providerLanguage,
+			GoPackage: valueOrDefault(opts.Package, config.DefaultGoPackageName),
+		}))
+	case Env:
+		providerLanguage := valueOrDefault(opts.ProviderLanguage, env.LanguageGo)
+		if !slices.Contains(env.GetValidLanguages(), providerLanguage) {
+			return fmt.Errorf("invalid provider language: %q. Valid languages are: %s", providerLanguage, strings.Join(env.GetValidLanguages(), ", "))
+		}
+		return env.NewGenerator(fs).Generate(newParams(opts, env.Params{
+			Language:  providerLanguage,
+			GoPackage: valueOrDefault(opts.Package, config.DefaultGoPackageName),
+			Prefix:    valueOrDefault(opts.EnvPrefix, config.DefaultEnvPrefix),
+		}))
+	case CSV, XLSX:
+		return spreadsheet.NewGenerator(fs).Generate(newParams(opts, spreadsheet.Params{Format: string(language)}))
+	default:
+		languages := make([]string, 0, len(Languages()))
+		for _, l := range Languages() {
+			languages = append(languages, string(l))
+		}
+		return fmt.Errorf("unsupported language: %s. Supported languages are: %s", language, strings.Join(languages, ", "))
+	}
+}
+
+// newParams returns the parameters of a generator for the given options
+func newParams[T any](opts Options, custom T) *generators.Params[T] {
+	return &generators.Params[T]{
+		OutputPath:   opts.OutputPath,
+		TemplatePath: opts.TemplatePath,
+		Provenance:   generators.Provenance{CLIVersion: opts.Version, ManifestHash: opts.ManifestHash},
+		Deprecation:  generators.Deprecation{AsOf: opts.DeprecateAsOf, Window: opts.DeprecateWithin},
+		Custom:       custom,
+	}
+}
+
+// valueOrDefault returns the option, or the default when it is empty
+func valueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
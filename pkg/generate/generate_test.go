@@ -0,0 +1,127 @@
+package generate_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/open-feature/cli/pkg/flagset"
+	"github.com/open-feature/cli/pkg/generate"
+	"github.com/open-feature/cli/pkg/manifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `{
+	"flags": {
+		"enableFeatureA": {
+			"flagType": "boolean",
+			"defaultValue": false,
+			"description": "Controls whether Feature A is enabled."
+		}
+	}
+}`
+
+func TestGenerate(t *testing.T) {
+	fs, err := manifest.Parse([]byte(testManifest))
+	require.NoError(t, err)
+	require.Len(t, fs.Flags, 1)
+	assert.Equal(t, flagset.BoolType, fs.Flags[0].Type)
+
+	outputPath := t.TempDir()
+	err = generate.Generate(generate.Go, fs, generate.Options{
+		OutputPath: outputPath,
+		Package:    "flags",
+		Version:    "test",
+	})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(outputPath, "flags_gen.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "package flags")
+	assert.Contains(t, string(content), "var EnableFeatureA")
+}
+
+func TestGenerate_UnsupportedLanguage(t *testing.T) {
+	err := generate.Generate("cobol", &flagset.Flagset{}, generate.Options{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported language: cobol")
+}
+
+func TestGenerate_AllLanguages(t *testing.T) {
+	fs, err := manifest.Parse([]byte(testManifest))
+	require.NoError(t, err)
+
+	for _, language := range generate.Languages() {
+		t.Run(string(language), func(t *testing.T) {
+			outputPath := t.TempDir()
+			require.NoError(t, generate.Generate(language, fs, generate.Options{OutputPath: outputPath}))
+
+			entries, err := os.ReadDir(outputPath)
+			require.NoError(t, err)
+			assert.NotEmpty(t, entries)
+		})
+	}
+}
+
+func TestGenerate_LeavesOutExcludedFlags(t *testing.T) {
+	fs, err := manifest.Parse([]byte(`{
+	"flags": {
+		"enableFeatureA": {
+			"flagType": "boolean",
+			"defaultValue": false,
+			"description": "Controls whether Feature A is enabled."
+		},
+		"databaseTimeout": {
+			"flagType": "integer",
+			"defaultValue": 30,
+			"description": "Timeout of the database queries.",
+			"visibility": "server"
+		},
+		"internalKillSwitch": {
+			"flagType": "boolean",
+			"defaultValue": false,
+			"description": "Not generated.",
+			"codegen": false
+		}
+	}
+}`))
+	require.NoError(t, err)
+	require.Len(t, fs.Flags, 3)
+
+	outputPath := t.TempDir()
+	require.NoError(t, generate.Generate(generate.React, fs, generate.Options{OutputPath: outputPath}))
+
+	content, err := os.ReadFile(filepath.Join(outputPath, "openfeature.ts"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "useEnableFeatureA")
+	assert.NotContains(t, string(content), "DatabaseTimeout")
+	assert.NotContains(t, string(content), "InternalKillSwitch")
+}
+
+func TestGenerate_ProvenanceAndDeprecation(t *testing.T) {
+	fs, err := manifest.Parse([]byte(`{
+	"flags": {
+		"legacyCheckout": {
+			"flagType": "boolean",
+			"defaultValue": false,
+			"description": "Uses the legacy checkout.",
+			"activeUntil": "2020-01-01T00:00:00Z"
+		}
+	}
+}`))
+	require.NoError(t, err)
+
+	outputPath := t.TempDir()
+	require.NoError(t, generate.Generate(generate.Go, fs, generate.Options{
+		OutputPath:   outputPath,
+		Package:      "flags",
+		Version:      "1.2.3",
+		ManifestHash: "abc123",
+	}))
+
+	content, err := os.ReadFile(filepath.Join(outputPath, "flags_gen.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "// Manifest SHA-256: abc123")
+	assert.Contains(t, string(content), "// Deprecated: The flag")
+}
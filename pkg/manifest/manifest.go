@@ -0,0 +1,47 @@
+// Package manifest reads, validates, compares and writes flag manifests.
+//
+// Functions that take a path use the local file system.
+package manifest
+
+import (
+	"github.com/open-feature/cli/internal/manifest"
+	"github.com/open-feature/cli/pkg/flagset"
+)
+
+// Manifest is the raw structure of a flag manifest, as used by Compare.
+type Manifest = manifest.Manifest
+
+// ValidationError is a single schema violation found in a manifest.
+type ValidationError = manifest.ValidationError
+
+// Change is a single difference between two manifests.
+type Change = manifest.Change
+
+// CompareOptions holds options for comparing manifests.
+type CompareOptions = manifest.CompareOptions
+
+// Load reads, validates and parses the manifest at the given path.
+func Load(path string) (*flagset.Flagset, error) {
+	return manifest.LoadFlagSet(path)
+}
+
+// Parse validates and parses manifest data.
+func Parse(data []byte) (*flagset.Flagset, error) {
+	return manifest.ParseFlagSet(data)
+}
+
+// Validate validates manifest data against the flag manifest schema.
+// The returned error is only set if validation could not be performed.
+func Validate(data []byte) ([]ValidationError, error) {
+	return manifest.Validate(data)
+}
+
+// Compare returns the changes needed to turn oldManifest into newManifest.
+func Compare(oldManifest, newManifest *Manifest, opts CompareOptions) ([]Change, error) {
+	return manifest.Compare(oldManifest, newManifest, opts)
+}
+
+// Write writes the flagset as a manifest to the given path.
+func Write(path string, fs *flagset.Flagset) error {
+	return manifest.Write(path, *fs)
+}
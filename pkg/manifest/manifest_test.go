@@ -0,0 +1,19 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/open-feature/cli/pkg/manifest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	fs, err := manifest.Parse([]byte(`{"flags": {"enabled": {"flagType": "boolean", "defaultValue": true}}}`))
+	require.NoError(t, err)
+	require.Len(t, fs.Flags, 1)
+	assert.Equal(t, "enabled", fs.Flags[0].Key)
+
+	_, err = manifest.Parse([]byte(`{"flags": {"broken": {"flagType": "boolean"}}}`))
+	assert.ErrorContains(t, err, "defaultValue is required")
+}